@@ -70,3 +70,15 @@ func (dao *AssetDAO) ListByEnvironmentAndPipeline(ctx context.Context, db *gorm.
 	}
 	return assets, nil
 }
+
+// ListByFileIDs returns the assets with the given file IDs; unknown IDs are skipped.
+func (dao *AssetDAO) ListByFileIDs(ctx context.Context, db *gorm.DB, fileIDs []string) ([]model.Asset, error) {
+	var assets []model.Asset
+	if len(fileIDs) == 0 {
+		return assets, nil
+	}
+	if err := db.WithContext(ctx).Where("file_id IN ?", fileIDs).Find(&assets).Error; err != nil {
+		return nil, err
+	}
+	return assets, nil
+}
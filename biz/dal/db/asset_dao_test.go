@@ -0,0 +1,42 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+)
+
+func TestAssetDAO_ListByFileIDs(t *testing.T) {
+	db := SetupTestDB(t)
+	defer CleanupTestDB(t, db)
+	dao := NewAssetDAO()
+	ctx := context.Background()
+
+	for _, asset := range []*model.Asset{
+		{FileID: "a1", EnvironmentKey: "prod", PipelineKey: "main", FileName: "logo.png"},
+		{FileID: "a2", EnvironmentKey: "prod", PipelineKey: "main", FileName: "banner.png"},
+		{FileID: "a3", EnvironmentKey: "test", PipelineKey: "main", FileName: "logo.png"},
+	} {
+		if err := dao.Create(ctx, db, asset); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	assets, err := dao.ListByFileIDs(ctx, db, []string{"a1", "a3", "missing"})
+	if err != nil {
+		t.Fatalf("ListByFileIDs failed: %v", err)
+	}
+	found := make(map[string]bool)
+	for _, asset := range assets {
+		found[asset.FileID] = true
+	}
+	if len(assets) != 2 || !found["a1"] || !found["a3"] {
+		t.Fatalf("unexpected assets: %+v", assets)
+	}
+
+	assets, err = dao.ListByFileIDs(ctx, db, nil)
+	if err != nil || len(assets) != 0 {
+		t.Fatalf("ListByFileIDs(nil) = %+v, %v", assets, err)
+	}
+}
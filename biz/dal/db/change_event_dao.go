@@ -0,0 +1,78 @@
+package db
+
+import (
+	"context"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"gorm.io/gorm"
+)
+
+// ChangeEventDAO persists the change events streamed to clients.
+type ChangeEventDAO struct{}
+
+func NewChangeEventDAO() *ChangeEventDAO { return &ChangeEventDAO{} }
+
+// Create stores a change event; it gets the next event ID. Called within the
+// transaction of the change, event IDs follow the commit order; see
+// model.ChangeSequence.
+func (dao *ChangeEventDAO) Create(ctx context.Context, db *gorm.DB, event *model.ChangeEvent) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		id, err := reserveSequence(ctx, tx, model.ChangeEvent{}.TableName(), 1)
+		if err != nil {
+			return err
+		}
+		event.ID = uint(id)
+		return tx.Create(event).Error
+	})
+}
+
+// LatestID returns the ID of the last event of an environment and any of
+// pipelineKeys, or 0 when there is none.
+func (dao *ChangeEventDAO) LatestID(ctx context.Context, db *gorm.DB, environmentKey string, pipelineKeys []string) (int64, error) {
+	var id int64
+	err := db.WithContext(ctx).
+		Model(&model.ChangeEvent{}).
+		Where("environment_key = ? AND pipeline_key IN ?", environmentKey, pipelineKeys).
+		Select("COALESCE(MAX(id), 0)").
+		Scan(&id).Error
+	return id, err
+}
+
+// ListAfter returns up to limit events of an environment and any of
+// pipelineKeys after the event with the given ID, oldest first.
+func (dao *ChangeEventDAO) ListAfter(ctx context.Context, db *gorm.DB, environmentKey string, pipelineKeys []string, id int64, limit int) ([]model.ChangeEvent, error) {
+	var events []model.ChangeEvent
+	if err := db.WithContext(ctx).
+		Where("environment_key = ? AND pipeline_key IN ? AND id > ?", environmentKey, pipelineKeys, id).
+		Order("id ASC").
+		Limit(limit).
+		Find(&events).Error; err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// Bounds returns the IDs of the oldest and the last event kept, or zeros when
+// there is none.
+func (dao *ChangeEventDAO) Bounds(ctx context.Context, db *gorm.DB) (int64, int64, error) {
+	var bounds struct {
+		Oldest int64
+		Latest int64
+	}
+	err := db.WithContext(ctx).
+		Model(&model.ChangeEvent{}).
+		Select("COALESCE(MIN(id), 0) AS oldest, COALESCE(MAX(id), 0) AS latest").
+		Scan(&bounds).Error
+	return bounds.Oldest, bounds.Latest, err
+}
+
+// Prune keeps only the last keep events.
+func (dao *ChangeEventDAO) Prune(ctx context.Context, db *gorm.DB, keep int64) error {
+	_, latest, err := dao.Bounds(ctx, db)
+	if err != nil || latest <= keep {
+		return err
+	}
+	return db.WithContext(ctx).
+		Where("id <= ?", latest-keep).
+		Delete(&model.ChangeEvent{}).Error
+}
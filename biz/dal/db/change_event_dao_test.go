@@ -0,0 +1,47 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+)
+
+func TestChangeEventDAO(t *testing.T) {
+	db := SetupTestDB(t)
+	defer CleanupTestDB(t, db)
+	dao := NewChangeEventDAO()
+	ctx := context.Background()
+	scopes := []string{"main", model.BasePipelineKey}
+
+	events := []*model.ChangeEvent{
+		{EnvironmentKey: "prod", PipelineKey: "main", Kind: model.ChangeEventKindConfig, Action: model.ChangeEventCreated, Alias: "title"},
+		{EnvironmentKey: "prod", PipelineKey: "other", Kind: model.ChangeEventKindConfig, Action: model.ChangeEventCreated, Alias: "title"},
+		{EnvironmentKey: "prod", PipelineKey: model.BasePipelineKey, Kind: model.ChangeEventKindAsset, Action: model.ChangeEventDeleted, FileID: "f1"},
+	}
+	for _, event := range events {
+		if err := dao.Create(ctx, db, event); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	latest, err := dao.LatestID(ctx, db, "prod", scopes)
+	if err != nil || latest != int64(events[2].ID) {
+		t.Fatalf("LatestID = %d, %v, want %d", latest, err, events[2].ID)
+	}
+	listed, err := dao.ListAfter(ctx, db, "prod", scopes, 0, 10)
+	if err != nil {
+		t.Fatalf("ListAfter failed: %v", err)
+	}
+	if len(listed) != 2 || listed[0].Alias != "title" || listed[1].FileID != "f1" {
+		t.Fatalf("unexpected events: %+v", listed)
+	}
+
+	if err := dao.Prune(ctx, db, 1); err != nil {
+		t.Fatalf("Prune failed: %v", err)
+	}
+	oldest, latest, err := dao.Bounds(ctx, db)
+	if err != nil || oldest != int64(events[2].ID) || latest != int64(events[2].ID) {
+		t.Fatalf("Bounds = %d, %d, %v", oldest, latest, err)
+	}
+}
@@ -0,0 +1,60 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// reserveSequence reserves n consecutive IDs of the change log stored in table
+// and returns the first. It must run in the transaction appending the entries,
+// which holds the lock of the sequence until it commits; see
+// model.ChangeSequence. The sequence starts after the highest ID already in
+// table.
+func reserveSequence(ctx context.Context, tx *gorm.DB, table string, n int) (int64, error) {
+	advance := func() (int64, error) {
+		result := tx.WithContext(ctx).
+			Model(&model.ChangeSequence{}).
+			Where("name = ?", table).
+			Update("value", gorm.Expr("value + ?", n))
+		return result.RowsAffected, result.Error
+	}
+	affected, err := advance()
+	if err != nil {
+		return 0, err
+	}
+	if affected == 0 {
+		var latest int64
+		if err := tx.WithContext(ctx).
+			Table(table).
+			Select("COALESCE(MAX(id), 0)").
+			Scan(&latest).Error; err != nil {
+			return 0, err
+		}
+		// 并发创建时沿用已创建的序列
+		if err := tx.WithContext(ctx).
+			Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&model.ChangeSequence{Name: table, Value: latest}).Error; err != nil {
+			return 0, err
+		}
+		if affected, err = advance(); err != nil {
+			return 0, err
+		}
+		if affected == 0 {
+			return 0, fmt.Errorf("change sequence %s not found", table)
+		}
+	}
+
+	var value int64
+	if err := tx.WithContext(ctx).
+		Model(&model.ChangeSequence{}).
+		Where("name = ?", table).
+		Select("value").
+		Scan(&value).Error; err != nil {
+		return 0, err
+	}
+	return value - int64(n) + 1, nil
+}
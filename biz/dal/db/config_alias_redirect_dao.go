@@ -0,0 +1,71 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"gorm.io/gorm"
+)
+
+// ConfigAliasRedirectDAO persists the deprecated aliases kept for renamed configs.
+type ConfigAliasRedirectDAO struct{}
+
+func NewConfigAliasRedirectDAO() *ConfigAliasRedirectDAO { return &ConfigAliasRedirectDAO{} }
+
+// Put stores a redirect, replacing an existing one from the same old alias.
+func (dao *ConfigAliasRedirectDAO) Put(ctx context.Context, db *gorm.DB, entity *model.ConfigAliasRedirect) error {
+	if entity == nil {
+		return errors.New("config alias redirect must not be nil")
+	}
+	entity.ExpiresAt = entity.ExpiresAt.UTC()
+	if err := dao.DeleteByOldAlias(ctx, db, entity.EnvironmentKey, entity.PipelineKey, entity.OldAlias); err != nil {
+		return err
+	}
+	return db.WithContext(ctx).Create(entity).Error
+}
+
+// DeleteByOldAlias removes the redirect from an alias, if any.
+func (dao *ConfigAliasRedirectDAO) DeleteByOldAlias(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey, oldAlias string) error {
+	return db.WithContext(ctx).
+		Where("environment_key = ? AND pipeline_key = ? AND old_alias = ?", environmentKey, pipelineKey, oldAlias).
+		Delete(&model.ConfigAliasRedirect{}).Error
+}
+
+// Retarget points the redirects to alias from at alias to instead, so that a
+// config renamed twice stays reachable under its first alias.
+func (dao *ConfigAliasRedirectDAO) Retarget(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey, from, to string) error {
+	return db.WithContext(ctx).
+		Model(&model.ConfigAliasRedirect{}).
+		Where("environment_key = ? AND pipeline_key = ? AND new_alias = ?", environmentKey, pipelineKey, from).
+		Update("new_alias", to).Error
+}
+
+// ListActive returns the redirects of an environment that have not expired at
+// now, ordered by pipeline and old alias. An empty pipelineKey lists every pipeline.
+func (dao *ConfigAliasRedirectDAO) ListActive(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey string, now time.Time) ([]model.ConfigAliasRedirect, error) {
+	// Expiry times are stored in UTC
+	tx := db.WithContext(ctx).Where("environment_key = ? AND expires_at > ?", environmentKey, now.UTC())
+	if pipelineKey != "" {
+		tx = tx.Where("pipeline_key = ?", pipelineKey)
+	}
+	var entities []model.ConfigAliasRedirect
+	if err := tx.Order("pipeline_key ASC, old_alias ASC").Find(&entities).Error; err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+// ListByPipeline returns every redirect of an environment/pipeline, expired
+// ones included, ordered by old alias.
+func (dao *ConfigAliasRedirectDAO) ListByPipeline(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey string) ([]model.ConfigAliasRedirect, error) {
+	var entities []model.ConfigAliasRedirect
+	if err := db.WithContext(ctx).
+		Where("environment_key = ? AND pipeline_key = ?", environmentKey, pipelineKey).
+		Order("old_alias ASC").
+		Find(&entities).Error; err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
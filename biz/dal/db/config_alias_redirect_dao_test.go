@@ -0,0 +1,69 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+)
+
+func TestConfigAliasRedirectDAO(t *testing.T) {
+	db := SetupTestDB(t)
+	defer CleanupTestDB(t, db)
+	dao := NewConfigAliasRedirectDAO()
+	ctx := context.Background()
+
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	redirects := []*model.ConfigAliasRedirect{
+		{EnvironmentKey: "prod", PipelineKey: "main", OldAlias: "cdn", NewAlias: "asset_cdn", ExpiresAt: now.Add(24 * time.Hour)},
+		{EnvironmentKey: "prod", PipelineKey: "main", OldAlias: "logo", NewAlias: "brand_logo", ExpiresAt: now.Add(-time.Hour)},
+		{EnvironmentKey: "prod", PipelineKey: model.BasePipelineKey, OldAlias: "theme", NewAlias: "skin", ExpiresAt: now.Add(time.Hour)},
+		{EnvironmentKey: "test", PipelineKey: "main", OldAlias: "cdn", NewAlias: "asset_cdn", ExpiresAt: now.Add(time.Hour)},
+	}
+	for _, redirect := range redirects {
+		if err := dao.Put(ctx, db, redirect); err != nil {
+			t.Fatalf("Put failed: %v", err)
+		}
+	}
+
+	list, err := dao.ListActive(ctx, db, "prod", "", now)
+	if err != nil {
+		t.Fatalf("ListActive failed: %v", err)
+	}
+	if len(list) != 2 || list[0].PipelineKey != model.BasePipelineKey || list[1].OldAlias != "cdn" {
+		t.Fatalf("unexpected redirects: %+v", list)
+	}
+
+	// 再次重命名：旧别名仍指向最新别名，同一旧别名只保留一条
+	if err := dao.Retarget(ctx, db, "prod", "main", "asset_cdn", "static_cdn"); err != nil {
+		t.Fatalf("Retarget failed: %v", err)
+	}
+	if err := dao.Put(ctx, db, &model.ConfigAliasRedirect{EnvironmentKey: "prod", PipelineKey: "main", OldAlias: "cdn", NewAlias: "static_cdn", ExpiresAt: now.Add(48 * time.Hour)}); err != nil {
+		t.Fatalf("Put failed: %v", err)
+	}
+	list, err = dao.ListActive(ctx, db, "prod", "main", now)
+	if err != nil {
+		t.Fatalf("ListActive failed: %v", err)
+	}
+	if len(list) != 1 || list[0].NewAlias != "static_cdn" || !list[0].ExpiresAt.Equal(now.Add(48*time.Hour)) {
+		t.Fatalf("unexpected redirects after retarget: %+v", list)
+	}
+	all, err := dao.ListByPipeline(ctx, db, "prod", "main")
+	if err != nil {
+		t.Fatalf("ListByPipeline failed: %v", err)
+	}
+	if len(all) != 2 || all[0].OldAlias != "cdn" || all[1].OldAlias != "logo" {
+		t.Fatalf("expected expired redirects to be listed, got %+v", all)
+	}
+
+	if err := dao.DeleteByOldAlias(ctx, db, "prod", "main", "cdn"); err != nil {
+		t.Fatalf("DeleteByOldAlias failed: %v", err)
+	}
+	if list, _ := dao.ListActive(ctx, db, "prod", "main", now); len(list) != 0 {
+		t.Fatalf("expected no redirects, got %+v", list)
+	}
+	if list, _ := dao.ListActive(ctx, db, "test", "main", now); len(list) != 1 {
+		t.Fatalf("expected redirects of other environments to be kept, got %+v", list)
+	}
+}
@@ -0,0 +1,184 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"gorm.io/gorm"
+)
+
+// ConfigChangeRequestDAO persists change requests with their items and comments.
+type ConfigChangeRequestDAO struct{}
+
+func NewConfigChangeRequestDAO() *ConfigChangeRequestDAO { return &ConfigChangeRequestDAO{} }
+
+// ChangeRequestFilter narrows ConfigChangeRequestDAO.List; empty fields match everything.
+type ChangeRequestFilter struct {
+	// EnvironmentKey matches requests with at least one item in the environment.
+	EnvironmentKey string
+	Status         string
+}
+
+// Create persists a new change request together with its items.
+func (dao *ConfigChangeRequestDAO) Create(ctx context.Context, db *gorm.DB, entity *model.ConfigChangeRequest, items []model.ConfigChangeRequestItem) error {
+	if entity == nil {
+		return errors.New("change request must not be nil")
+	}
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(entity).Error; err != nil {
+			return err
+		}
+		for i := range items {
+			items[i].ID = 0
+			items[i].ChangeRequestID = entity.ID
+		}
+		if len(items) == 0 {
+			return nil
+		}
+		return tx.Create(&items).Error
+	})
+}
+
+// GetByID fetches a change request by its primary key.
+func (dao *ConfigChangeRequestDAO) GetByID(ctx context.Context, db *gorm.DB, id uint) (*model.ConfigChangeRequest, error) {
+	var entity model.ConfigChangeRequest
+	if err := db.WithContext(ctx).First(&entity, id).Error; err != nil {
+		return nil, err
+	}
+	return &entity, nil
+}
+
+// List returns change requests matching filter, newest first, together with the total count.
+func (dao *ConfigChangeRequestDAO) List(ctx context.Context, db *gorm.DB, filter ChangeRequestFilter, page, pageSize int) ([]model.ConfigChangeRequest, int64, error) {
+	tx := db.WithContext(ctx).Model(&model.ConfigChangeRequest{})
+	if filter.Status != "" {
+		tx = tx.Where("status = ?", filter.Status)
+	}
+	if filter.EnvironmentKey != "" {
+		items := db.Model(&model.ConfigChangeRequestItem{}).
+			Select("change_request_id").
+			Where("environment_key = ?", filter.EnvironmentKey)
+		tx = tx.Where("id IN (?)", items)
+	}
+
+	var total int64
+	if err := tx.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if page > 0 && pageSize > 0 {
+		tx = tx.Limit(pageSize).Offset((page - 1) * pageSize)
+	}
+
+	var entities []model.ConfigChangeRequest
+	if err := tx.Order("id DESC").Find(&entities).Error; err != nil {
+		return nil, 0, err
+	}
+	return entities, total, nil
+}
+
+// ListItems returns the items of a change request in the order they are applied.
+func (dao *ConfigChangeRequestDAO) ListItems(ctx context.Context, db *gorm.DB, changeRequestID uint) ([]model.ConfigChangeRequestItem, error) {
+	var items []model.ConfigChangeRequestItem
+	if err := db.WithContext(ctx).
+		Where("change_request_id = ?", changeRequestID).
+		Order("id ASC").
+		Find(&items).Error; err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// ListByPayloadContent returns the change requests whose payload contains the given text.
+func (dao *ConfigChangeRequestDAO) ListByPayloadContent(ctx context.Context, db *gorm.DB, text string) ([]model.ConfigChangeRequest, error) {
+	var entities []model.ConfigChangeRequest
+	if err := db.WithContext(ctx).Where("payload LIKE ?", "%"+text+"%").Order("id ASC").Find(&entities).Error; err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+// UpdatePayload overwrites the payload of a change request. Only used to
+// re-encode content in place (e.g. secret key rotation).
+func (dao *ConfigChangeRequestDAO) UpdatePayload(ctx context.Context, db *gorm.DB, id uint, payload string) error {
+	return db.WithContext(ctx).
+		Model(&model.ConfigChangeRequest{}).
+		Where("id = ?", id).
+		UpdateColumn("payload", payload).Error
+}
+
+// ListItemsByContent returns the change request items whose before or after snapshot contains the given text.
+func (dao *ConfigChangeRequestDAO) ListItemsByContent(ctx context.Context, db *gorm.DB, text string) ([]model.ConfigChangeRequestItem, error) {
+	var entities []model.ConfigChangeRequestItem
+	pattern := "%" + text + "%"
+	if err := db.WithContext(ctx).Where("before_content LIKE ? OR after_content LIKE ?", pattern, pattern).Order("id ASC").Find(&entities).Error; err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+// UpdateItemContents overwrites the before/after snapshots of a change request
+// item. Only used to re-encode content in place (e.g. secret key rotation).
+func (dao *ConfigChangeRequestDAO) UpdateItemContents(ctx context.Context, db *gorm.DB, id uint, before, after string) error {
+	return db.WithContext(ctx).
+		Model(&model.ConfigChangeRequestItem{}).
+		Where("id = ?", id).
+		UpdateColumns(map[string]any{"before_content": before, "after_content": after}).Error
+}
+
+// Transition moves a change request from one status to another. It reports
+// false when the request is not in status from, e.g. because another reviewer
+// claimed it first.
+func (dao *ConfigChangeRequestDAO) Transition(ctx context.Context, db *gorm.DB, id uint, from, to string) (bool, error) {
+	result := db.WithContext(ctx).
+		Model(&model.ConfigChangeRequest{}).
+		Where("id = ? AND status = ?", id, from).
+		UpdateColumn("status", to)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// Review records the decision on a change request in status from. It reports
+// false when the request is no longer in that status, e.g. because another
+// reviewer was faster.
+func (dao *ConfigChangeRequestDAO) Review(ctx context.Context, db *gorm.DB, entity *model.ConfigChangeRequest, from string) (bool, error) {
+	if entity == nil || entity.ID == 0 {
+		return false, errors.New("change request must not be nil")
+	}
+	result := db.WithContext(ctx).
+		Model(&model.ConfigChangeRequest{}).
+		Where("id = ? AND status = ?", entity.ID, from).
+		Updates(map[string]any{
+			"status":         entity.Status,
+			"reviewer_id":    entity.ReviewerID,
+			"reviewer_name":  entity.ReviewerName,
+			"review_comment": entity.ReviewComment,
+			"reviewed_at":    entity.ReviewedAt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// CreateComment adds a comment to a change request.
+func (dao *ConfigChangeRequestDAO) CreateComment(ctx context.Context, db *gorm.DB, entity *model.ConfigChangeRequestComment) error {
+	if entity == nil {
+		return errors.New("change request comment must not be nil")
+	}
+	return db.WithContext(ctx).Create(entity).Error
+}
+
+// ListComments returns the comments of a change request, oldest first.
+func (dao *ConfigChangeRequestDAO) ListComments(ctx context.Context, db *gorm.DB, changeRequestID uint) ([]model.ConfigChangeRequestComment, error) {
+	var comments []model.ConfigChangeRequestComment
+	if err := db.WithContext(ctx).
+		Where("change_request_id = ?", changeRequestID).
+		Order("id ASC").
+		Find(&comments).Error; err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
@@ -0,0 +1,78 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+)
+
+func TestConfigChangeRequestDAO(t *testing.T) {
+	db := SetupTestDB(t)
+	defer CleanupTestDB(t, db)
+	dao := NewConfigChangeRequestDAO()
+	ctx := context.Background()
+
+	requests := []struct {
+		request *model.ConfigChangeRequest
+		envs    []string
+	}{
+		{&model.ConfigChangeRequest{Operation: model.ChangeRequestConfigUpdate, Status: model.ConfigChangeRequestPending, RequesterName: "alice"}, []string{"prod"}},
+		{&model.ConfigChangeRequest{Operation: model.ChangeRequestConfigImport, Status: model.ConfigChangeRequestPending, RequesterName: "bob"}, []string{"prod", "staging"}},
+		{&model.ConfigChangeRequest{Operation: model.ChangeRequestConfigDelete, Status: model.ConfigChangeRequestPending, RequesterName: "alice"}, []string{"staging"}},
+	}
+	for _, tc := range requests {
+		items := make([]model.ConfigChangeRequestItem, 0, len(tc.envs))
+		for _, env := range tc.envs {
+			items = append(items, model.ConfigChangeRequestItem{EnvironmentKey: env, PipelineKey: "default", Alias: "title", Action: "update", Before: `{"content":"a"}`, After: `{"content":"b"}`})
+		}
+		if err := dao.Create(ctx, db, tc.request, items); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	list, total, err := dao.List(ctx, db, ChangeRequestFilter{EnvironmentKey: "prod"}, 1, 10)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if total != 2 || len(list) != 2 || list[0].Operation != model.ChangeRequestConfigImport {
+		t.Fatalf("unexpected prod requests: total=%d %+v", total, list)
+	}
+	items, err := dao.ListItems(ctx, db, list[0].ID)
+	if err != nil || len(items) != 2 || items[0].EnvironmentKey != "prod" || items[1].EnvironmentKey != "staging" {
+		t.Fatalf("ListItems = %+v, %v", items, err)
+	}
+
+	now := time.Now()
+	review := *requests[0].request
+	review.Status = model.ConfigChangeRequestApproved
+	review.ReviewerName = "bob"
+	review.ReviewedAt = &now
+	if ok, err := dao.Review(ctx, db, &review, model.ConfigChangeRequestPending); err != nil || !ok {
+		t.Fatalf("Review = %v, %v", ok, err)
+	}
+	review.Status = model.ConfigChangeRequestRejected
+	if ok, err := dao.Review(ctx, db, &review, model.ConfigChangeRequestPending); err != nil || ok {
+		t.Fatalf("expected second review to be refused, got %v, %v", ok, err)
+	}
+	reviewed, err := dao.GetByID(ctx, db, review.ID)
+	if err != nil || reviewed.Status != model.ConfigChangeRequestApproved || reviewed.ReviewerName != "bob" || reviewed.ReviewedAt == nil {
+		t.Fatalf("GetByID = %+v, %v", reviewed, err)
+	}
+
+	if _, total, _ := dao.List(ctx, db, ChangeRequestFilter{Status: model.ConfigChangeRequestPending}, 0, 0); total != 2 {
+		t.Errorf("expected 2 pending requests, got %d", total)
+	}
+
+	for _, content := range []string{"why?", "typo fix"} {
+		comment := &model.ConfigChangeRequestComment{ChangeRequestID: review.ID, AuthorName: "carol", Content: content}
+		if err := dao.CreateComment(ctx, db, comment); err != nil {
+			t.Fatalf("CreateComment failed: %v", err)
+		}
+	}
+	comments, err := dao.ListComments(ctx, db, review.ID)
+	if err != nil || len(comments) != 2 || comments[0].Content != "why?" {
+		t.Fatalf("ListComments = %+v, %v", comments, err)
+	}
+}
@@ -3,9 +3,12 @@ package db
 import (
 	"context"
 	"errors"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"github.com/yi-nology/rainbow_bridge/pkg/util"
 
 	"gorm.io/gorm"
 )
@@ -23,30 +26,172 @@ func (dao *ConfigDAO) Create(ctx context.Context, db *gorm.DB, entity *model.Con
 	if entity.ResourceKey == "" {
 		entity.ResourceKey = uuid.NewString()
 	}
-	return db.WithContext(ctx).Create(entity).Error
+	entity.Revision = 1
+	if err := db.WithContext(ctx).Create(entity).Error; err != nil {
+		return err
+	}
+	return dao.replaceLabels(ctx, db, entity.ID, entity.Labels)
 }
 
 // UpdateByEnvironmentAndPipeline updates an existing configuration identified by environment_key + pipeline_key + resource_key.
-// Note: alias field is immutable and will not be updated.
+// Note: alias field is not updated (use RenameAlias), and labels are only
+// replaced when entity carries some (use SetLabels to clear them). The revision
+// is not taken from entity; callers advance it with AdvanceRevision.
 func (dao *ConfigDAO) UpdateByEnvironmentAndPipeline(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey string, entity *model.Config) error {
 	if entity == nil {
 		return errors.New("config must not be nil")
 	}
-	return db.WithContext(ctx).
+	if err := db.WithContext(ctx).
 		Model(&model.Config{}).
 		Where("environment_key = ? AND pipeline_key = ? AND resource_key = ?", environmentKey, pipelineKey, entity.ResourceKey).
-		Omit("alias"). // Alias is immutable after creation
+		Omit("alias", "revision"). // Alias only changes through RenameAlias
 		Updates(entity).
-		Error
+		Error; err != nil {
+		return err
+	}
+	// Version bounds, options and the schedule may be cleared, which Updates skips for zero values
+	if err := db.WithContext(ctx).
+		Model(&model.Config{}).
+		Where("environment_key = ? AND pipeline_key = ? AND resource_key = ?", environmentKey, pipelineKey, entity.ResourceKey).
+		UpdateColumns(map[string]any{
+			"min_version":       entity.MinVersion,
+			"max_version":       entity.MaxVersion,
+			"options":           entity.Options,
+			"effective_at":      entity.EffectiveAt,
+			"expires_at":        entity.ExpiresAt,
+			"schedule_timezone": entity.ScheduleTimezone,
+		}).
+		Error; err != nil {
+		return err
+	}
+	if entity.Labels == "" {
+		return nil
+	}
+	var id uint
+	if err := db.WithContext(ctx).
+		Model(&model.Config{}).
+		Select("id").
+		Where("environment_key = ? AND pipeline_key = ? AND resource_key = ?", environmentKey, pipelineKey, entity.ResourceKey).
+		Scan(&id).Error; err != nil {
+		return err
+	}
+	return dao.replaceLabels(ctx, db, id, entity.Labels)
+}
+
+// AdvanceRevision increments the revision of a configuration. When expected is
+// non-zero the revision only advances from expected, and false is returned if
+// the configuration is at another revision (or gone).
+func (dao *ConfigDAO) AdvanceRevision(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey, resourceKey string, expected int64) (bool, error) {
+	tx := db.WithContext(ctx).
+		Model(&model.Config{}).
+		Where("environment_key = ? AND pipeline_key = ? AND resource_key = ?", environmentKey, pipelineKey, resourceKey)
+	if expected != 0 {
+		tx = tx.Where("revision = ?", expected)
+	}
+	result := tx.UpdateColumn("revision", gorm.Expr("revision + 1"))
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// RenameAlias moves every variant of an alias to a new alias and advances
+// their revisions. It returns the number of variants renamed.
+func (dao *ConfigDAO) RenameAlias(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey, from, to string) (int64, error) {
+	result := db.WithContext(ctx).
+		Model(&model.Config{}).
+		Where("environment_key = ? AND pipeline_key = ? AND alias = ?", environmentKey, pipelineKey, from).
+		Updates(map[string]any{
+			"alias":    to,
+			"revision": gorm.Expr("revision + 1"),
+		})
+	return result.RowsAffected, result.Error
+}
+
+// SetLabels replaces the labels of a configuration; an empty string clears them.
+func (dao *ConfigDAO) SetLabels(ctx context.Context, db *gorm.DB, id uint, labels string) error {
+	if err := db.WithContext(ctx).Model(&model.Config{}).Where("id = ?", id).Updates(map[string]any{
+		"labels":   labels,
+		"revision": gorm.Expr("revision + 1"),
+	}).Error; err != nil {
+		return err
+	}
+	return dao.replaceLabels(ctx, db, id, labels)
+}
+
+// replaceLabels rewrites the label index rows of a configuration.
+func (dao *ConfigDAO) replaceLabels(ctx context.Context, db *gorm.DB, configID uint, raw string) error {
+	labels, err := util.ParseLabels(raw)
+	if err != nil {
+		return err
+	}
+	if err := db.WithContext(ctx).Where("config_id = ?", configID).Delete(&model.ConfigLabel{}).Error; err != nil {
+		return err
+	}
+	if len(labels) == 0 {
+		return nil
+	}
+	rows := make([]model.ConfigLabel, 0, len(labels))
+	for key, value := range labels {
+		rows = append(rows, model.ConfigLabel{ConfigID: configID, LabelKey: key, LabelValue: value})
+	}
+	return db.WithContext(ctx).Create(&rows).Error
 }
 
 // ClearAll removes all configuration entries.
 func (dao *ConfigDAO) ClearAll(ctx context.Context, db *gorm.DB) error {
+	if err := db.WithContext(ctx).Where("1 = 1").Delete(&model.ConfigLabel{}).Error; err != nil {
+		return err
+	}
 	return db.WithContext(ctx).Where("1 = 1").Unscoped().Delete(&model.Config{}).Error
 }
 
+// ListAll returns every configuration entry across all environments and pipelines.
+func (dao *ConfigDAO) ListAll(ctx context.Context, db *gorm.DB) ([]model.Config, error) {
+	var entities []model.Config
+	if err := db.WithContext(ctx).Order("id ASC").Find(&entities).Error; err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+// ListByEnvironment returns every configuration entry of an environment across its pipelines.
+func (dao *ConfigDAO) ListByEnvironment(ctx context.Context, db *gorm.DB, environmentKey string) ([]model.Config, error) {
+	var entities []model.Config
+	if err := db.WithContext(ctx).Where("environment_key = ?", environmentKey).Order("pipeline_key ASC, id ASC").Find(&entities).Error; err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+// ListByType returns every configuration entry of a type across all environments and pipelines.
+func (dao *ConfigDAO) ListByType(ctx context.Context, db *gorm.DB, configType string) ([]model.Config, error) {
+	var entities []model.Config
+	if err := db.WithContext(ctx).Where("type = ?", configType).Order("id ASC").Find(&entities).Error; err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+// UpdateContent overwrites the stored content of a configuration without touching other columns.
+func (dao *ConfigDAO) UpdateContent(ctx context.Context, db *gorm.DB, id uint, content string) error {
+	return db.WithContext(ctx).Model(&model.Config{}).Where("id = ?", id).UpdateColumn("content", content).Error
+}
+
+// UpdateTranslations overwrites the translations of a configuration; an empty string clears them.
+func (dao *ConfigDAO) UpdateTranslations(ctx context.Context, db *gorm.DB, id uint, translations string) error {
+	return db.WithContext(ctx).Model(&model.Config{}).Where("id = ?", id).UpdateColumn("translations", translations).Error
+}
+
 // DeleteByEnvironmentPipelineAndResourceKey performs a hard delete by composite key.
 func (dao *ConfigDAO) DeleteByEnvironmentPipelineAndResourceKey(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey, resourceKey string) error {
+	ids := db.Model(&model.Config{}).
+		Unscoped().
+		Select("id").
+		Where("environment_key = ? AND pipeline_key = ? AND resource_key = ?", environmentKey, pipelineKey, resourceKey)
+	if err := db.WithContext(ctx).Where("config_id IN (?)", ids).Delete(&model.ConfigLabel{}).Error; err != nil {
+		return err
+	}
 	return db.WithContext(ctx).
 		Unscoped().
 		Where("environment_key = ? AND pipeline_key = ? AND resource_key = ?", environmentKey, pipelineKey, resourceKey).
@@ -87,52 +232,204 @@ func (dao *ConfigDAO) GetByAlias(ctx context.Context, db *gorm.DB, environmentKe
 	return &entity, nil
 }
 
-// ListByEnvironmentAndPipeline returns the most recently updated configs per alias.
-func (dao *ConfigDAO) ListByEnvironmentAndPipeline(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey string, _ string, page, pageSize int) ([]model.Config, error) {
+// ListByAlias returns every version variant of an alias.
+func (dao *ConfigDAO) ListByAlias(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey, alias string) ([]model.Config, error) {
+	var entities []model.Config
+	if err := db.WithContext(ctx).
+		Where("environment_key = ? AND pipeline_key = ? AND alias = ?", environmentKey, pipelineKey, alias).
+		Order("id ASC").
+		Find(&entities).Error; err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+// GetVariant fetches the variant of an alias with exactly the given client-version range.
+func (dao *ConfigDAO) GetVariant(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey, alias, minVersion, maxVersion string) (*model.Config, error) {
+	var entity model.Config
+	if err := db.WithContext(ctx).
+		Where("environment_key = ? AND pipeline_key = ? AND alias = ? AND min_version = ? AND max_version = ?",
+			environmentKey, pipelineKey, alias, minVersion, maxVersion).
+		Order("updated_at DESC").
+		First(&entity).Error; err != nil {
+		return nil, err
+	}
+	return &entity, nil
+}
+
+// ListByEnvironmentAndPipeline returns the most recently updated config per alias variant.
+// When clientVersion is set, the variants are reduced to the one serving that version.
+func (dao *ConfigDAO) ListByEnvironmentAndPipeline(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey string, clientVersion string, page, pageSize int) ([]model.Config, error) {
 	var entities []model.Config
-	// 使用子查询获取每个alias的最新记录
+	// 使用子查询获取每个alias版本变体的最新记录
 	subQuery := db.Model(&model.Config{}).
 		Select("MAX(id) as id").
 		Where("environment_key = ? AND pipeline_key = ?", environmentKey, pipelineKey).
-		Group("alias")
+		Group("alias, min_version, max_version")
 
 	tx := db.WithContext(ctx).
 		Where("id IN (?) AND environment_key = ? AND pipeline_key = ?", subQuery, environmentKey, pipelineKey).
 		Order("updated_at DESC")
 
-	// 添加分页支持
-	if page > 0 && pageSize > 0 {
-		offset := (page - 1) * pageSize
-		tx = tx.Limit(pageSize).Offset(offset)
-	}
-
 	if err := tx.Find(&entities).Error; err != nil {
 		return nil, err
 	}
-	return entities, nil
+	if clientVersion != "" {
+		entities = model.SelectConfigVariants(entities, clientVersion)
+	}
+	return paginateConfigs(entities, page, pageSize), nil
 }
 
-// ListByEnvironmentAndPipelineWithFilter retrieves configs optionally filtering by type.
-func (dao *ConfigDAO) ListByEnvironmentAndPipelineWithFilter(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey string, _ string, _ string, resourceType string, page, pageSize int) ([]model.Config, error) {
+// ListByEnvironmentAndPipelineWithFilter retrieves configs optionally filtering by type and
+// by client-version window: only variants whose range overlaps [minVersion, maxVersion) are kept.
+func (dao *ConfigDAO) ListByEnvironmentAndPipelineWithFilter(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey string, minVersion string, maxVersion string, resourceType string, page, pageSize int) ([]model.Config, error) {
 	tx := db.WithContext(ctx).Where("environment_key = ? AND pipeline_key = ?", environmentKey, pipelineKey)
 
 	if resourceType != "" {
 		tx = tx.Where("type = ?", resourceType)
 	}
 
-	// 添加分页支持
-	if page > 0 && pageSize > 0 {
-		offset := (page - 1) * pageSize
-		tx = tx.Limit(pageSize).Offset(offset)
+	var entities []model.Config
+	if minVersion == "" && maxVersion == "" {
+		// 添加分页支持
+		if page > 0 && pageSize > 0 {
+			offset := (page - 1) * pageSize
+			tx = tx.Limit(pageSize).Offset(offset)
+		}
+		if err := tx.Order("updated_at DESC").Find(&entities).Error; err != nil {
+			return nil, err
+		}
+		return entities, nil
 	}
 
-	var entities []model.Config
+	// 版本区间无法在 SQL 中比较，过滤后再分页
 	if err := tx.Order("updated_at DESC").Find(&entities).Error; err != nil {
 		return nil, err
 	}
+	filtered := make([]model.Config, 0, len(entities))
+	for _, cfg := range entities {
+		if util.VersionRangesOverlap(cfg.MinVersion, cfg.MaxVersion, minVersion, maxVersion) {
+			filtered = append(filtered, cfg)
+		}
+	}
+	return paginateConfigs(filtered, page, pageSize), nil
+}
+
+// ListScheduled returns the configs of an environment (and pipeline, when set)
+// that are activated or expire within (after, until]; a zero until is unbounded.
+func (dao *ConfigDAO) ListScheduled(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey string, after, until time.Time) ([]model.Config, error) {
+	// Schedule bounds are stored in UTC
+	after, until = after.UTC(), until.UTC()
+	tx := db.WithContext(ctx).Where("environment_key = ?", environmentKey)
+	if pipelineKey != "" {
+		tx = tx.Where("pipeline_key = ?", pipelineKey)
+	}
+	if until.IsZero() {
+		tx = tx.Where("(effective_at > ? OR expires_at > ?)", after, after)
+	} else {
+		tx = tx.Where("((effective_at > ? AND effective_at <= ?) OR (expires_at > ? AND expires_at <= ?))", after, until, after, until)
+	}
+	var entities []model.Config
+	if err := tx.Order("id ASC").Find(&entities).Error; err != nil {
+		return nil, err
+	}
 	return entities, nil
 }
 
+// ConfigSearchFilter narrows a config search. Zero fields do not filter.
+type ConfigSearchFilter struct {
+	EnvironmentKey string
+	PipelineKey    string
+	Type           string
+	// Keyword matches a case-insensitive substring of the name or alias.
+	Keyword string
+	Labels  []util.LabelRequirement
+	// UpdatedAfter (inclusive) and UpdatedBefore (exclusive) bound updated_at.
+	UpdatedAfter  time.Time
+	UpdatedBefore time.Time
+	// ExcludePerm leaves out configs marked is_perm.
+	ExcludePerm bool
+}
+
+// Search returns the configs matching filter across environments and pipelines,
+// most recently updated first, together with the total count.
+func (dao *ConfigDAO) Search(ctx context.Context, db *gorm.DB, filter ConfigSearchFilter, page, pageSize int) ([]model.Config, int64, error) {
+	tx := db.WithContext(ctx).Model(&model.Config{})
+	if filter.EnvironmentKey != "" {
+		tx = tx.Where("environment_key = ?", filter.EnvironmentKey)
+	}
+	if filter.PipelineKey != "" {
+		tx = tx.Where("pipeline_key = ?", filter.PipelineKey)
+	}
+	if filter.Type != "" {
+		tx = tx.Where("type = ?", filter.Type)
+	}
+	if keyword := strings.TrimSpace(filter.Keyword); keyword != "" {
+		pattern := "%" + escapeLikePattern(strings.ToLower(keyword)) + "%"
+		tx = tx.Where("(LOWER(name) LIKE ? ESCAPE '!' OR LOWER(alias) LIKE ? ESCAPE '!')", pattern, pattern)
+	}
+	if !filter.UpdatedAfter.IsZero() {
+		// updated_at is written in local time; SQLite compares the stored text
+		tx = tx.Where("updated_at >= ?", filter.UpdatedAfter.Local())
+	}
+	if !filter.UpdatedBefore.IsZero() {
+		tx = tx.Where("updated_at < ?", filter.UpdatedBefore.Local())
+	}
+	if filter.ExcludePerm {
+		tx = tx.Where("is_perm = ?", false)
+	}
+	for _, req := range filter.Labels {
+		// 每个条件对应一次 (label_key, label_value) 索引查找
+		labeled := db.Model(&model.ConfigLabel{}).Select("config_id").Where("label_key = ?", req.Key)
+		switch req.Operator {
+		case util.LabelOpEquals:
+			tx = tx.Where("id IN (?)", labeled.Where("label_value = ?", req.Value))
+		case util.LabelOpNotEquals:
+			tx = tx.Where("id NOT IN (?)", labeled.Where("label_value = ?", req.Value))
+		case util.LabelOpExists:
+			tx = tx.Where("id IN (?)", labeled)
+		case util.LabelOpNotExists:
+			tx = tx.Where("id NOT IN (?)", labeled)
+		default:
+			return nil, 0, errors.New("unsupported label operator: " + req.Operator)
+		}
+	}
+
+	var total int64
+	if err := tx.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if page > 0 && pageSize > 0 {
+		tx = tx.Limit(pageSize).Offset((page - 1) * pageSize)
+	}
+	var entities []model.Config
+	if err := tx.Order("updated_at DESC, id DESC").Find(&entities).Error; err != nil {
+		return nil, 0, err
+	}
+	return entities, total, nil
+}
+
+// escapeLikePattern escapes LIKE wildcards with "!", which every supported
+// database accepts as ESCAPE character without extra quoting.
+func escapeLikePattern(value string) string {
+	return strings.NewReplacer("!", "!!", "%", "!%", "_", "!_").Replace(value)
+}
+
+func paginateConfigs(entities []model.Config, page, pageSize int) []model.Config {
+	if page <= 0 || pageSize <= 0 {
+		return entities
+	}
+	offset := (page - 1) * pageSize
+	if offset >= len(entities) {
+		return []model.Config{}
+	}
+	end := offset + pageSize
+	if end > len(entities) {
+		end = len(entities)
+	}
+	return entities[offset:end]
+}
+
 // ListAllBusinessKeys is deprecated, use environment/pipeline queries instead.
 // Kept for backward compatibility during migration.
 func (dao *ConfigDAO) ListAllBusinessKeys(ctx context.Context, db *gorm.DB) ([]string, error) {
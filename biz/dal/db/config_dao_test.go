@@ -0,0 +1,407 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"github.com/yi-nology/rainbow_bridge/pkg/util"
+)
+
+func TestConfigDAO_VersionVariants(t *testing.T) {
+	db := SetupTestDB(t)
+	defer CleanupTestDB(t, db)
+	dao := NewConfigDAO()
+	ctx := context.Background()
+
+	variants := []*model.Config{
+		{EnvironmentKey: "env", PipelineKey: "pipe", Alias: "banner", Content: "default"},
+		{EnvironmentKey: "env", PipelineKey: "pipe", Alias: "banner", Content: "legacy", MaxVersion: "5.2.0"},
+		{EnvironmentKey: "env", PipelineKey: "pipe", Alias: "banner", Content: "modern", MinVersion: "5.2.0"},
+	}
+	for _, cfg := range variants {
+		if err := dao.Create(ctx, db, cfg); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	t.Run("GetVariant", func(t *testing.T) {
+		cfg, err := dao.GetVariant(ctx, db, "env", "pipe", "banner", "5.2.0", "")
+		if err != nil {
+			t.Fatalf("GetVariant failed: %v", err)
+		}
+		if cfg.Content != "modern" {
+			t.Errorf("Expected 'modern', got '%s'", cfg.Content)
+		}
+	})
+
+	t.Run("ResolveByClientVersion", func(t *testing.T) {
+		cases := map[string]string{"5.1.9": "legacy", "5.2.0": "modern", "not-a-version": "default"}
+		for version, want := range cases {
+			list, err := dao.ListByEnvironmentAndPipeline(ctx, db, "env", "pipe", version, 0, 0)
+			if err != nil {
+				t.Fatalf("ListByEnvironmentAndPipeline failed: %v", err)
+			}
+			if len(list) != 1 || list[0].Content != want {
+				t.Errorf("Client %s: expected '%s', got %+v", version, want, list)
+			}
+		}
+	})
+
+	t.Run("AllVariantsWithoutClientVersion", func(t *testing.T) {
+		list, err := dao.ListByEnvironmentAndPipeline(ctx, db, "env", "pipe", "", 0, 0)
+		if err != nil {
+			t.Fatalf("ListByEnvironmentAndPipeline failed: %v", err)
+		}
+		if len(list) != 3 {
+			t.Errorf("Expected 3 variants, got %d", len(list))
+		}
+	})
+
+	t.Run("FilterByVersionWindow", func(t *testing.T) {
+		list, err := dao.ListByEnvironmentAndPipelineWithFilter(ctx, db, "env", "pipe", "6.0.0", "", "", 0, 0)
+		if err != nil {
+			t.Fatalf("ListByEnvironmentAndPipelineWithFilter failed: %v", err)
+		}
+		if len(list) != 2 {
+			t.Errorf("Expected default and modern variants, got %d", len(list))
+		}
+	})
+
+	t.Run("UpdateClearsRange", func(t *testing.T) {
+		legacy := variants[1]
+		legacy.MaxVersion = ""
+		legacy.Content = "unbounded"
+		if err := dao.UpdateByEnvironmentAndPipeline(ctx, db, "env", "pipe", legacy); err != nil {
+			t.Fatalf("UpdateByEnvironmentAndPipeline failed: %v", err)
+		}
+		got, err := dao.GetByResourceKey(ctx, db, "env", "pipe", legacy.ResourceKey)
+		if err != nil {
+			t.Fatalf("GetByResourceKey failed: %v", err)
+		}
+		if got.MaxVersion != "" || got.Content != "unbounded" {
+			t.Errorf("Expected cleared max_version, got %+v", got)
+		}
+	})
+}
+
+func TestConfigDAO_BaseConfigs(t *testing.T) {
+	db := SetupTestDB(t)
+	defer CleanupTestDB(t, db)
+	dao := NewConfigDAO()
+	ctx := context.Background()
+
+	configs := []*model.Config{
+		{EnvironmentKey: "env", PipelineKey: model.BasePipelineKey, Alias: "title", Content: "base title"},
+		{EnvironmentKey: "env", PipelineKey: model.BasePipelineKey, Alias: "banner", Content: "base banner"},
+		{EnvironmentKey: "env", PipelineKey: model.BasePipelineKey, Alias: "banner", Content: "base legacy banner", MaxVersion: "5.0.0"},
+		{EnvironmentKey: "env", PipelineKey: "pipe", Alias: "banner", Content: "pipe banner"},
+		{EnvironmentKey: "env", PipelineKey: "pipe", Alias: "footer", Content: "pipe footer"},
+	}
+	for _, cfg := range configs {
+		if err := dao.Create(ctx, db, cfg); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	base, err := dao.ListByEnvironmentAndPipelineWithFilter(ctx, db, "env", model.BasePipelineKey, "", "", "", 0, 0)
+	if err != nil {
+		t.Fatalf("ListByEnvironmentAndPipelineWithFilter failed: %v", err)
+	}
+	own, err := dao.ListByEnvironmentAndPipelineWithFilter(ctx, db, "env", "pipe", "", "", "", 0, 0)
+	if err != nil {
+		t.Fatalf("ListByEnvironmentAndPipelineWithFilter failed: %v", err)
+	}
+
+	merged := model.MergeBaseConfigs(base, own)
+	if len(merged) != 3 {
+		t.Fatalf("Expected 3 merged configs, got %d", len(merged))
+	}
+	byAlias := make(map[string]model.Config, len(merged))
+	for _, cfg := range merged {
+		if _, ok := byAlias[cfg.Alias]; ok {
+			t.Errorf("Alias %s merged more than once", cfg.Alias)
+		}
+		byAlias[cfg.Alias] = cfg
+	}
+	if got := byAlias["banner"]; got.Content != "pipe banner" || got.Origin != model.ConfigOriginPipeline || !got.OverridesBase {
+		t.Errorf("Expected pipeline banner overriding base, got %+v", got)
+	}
+	if got := byAlias["footer"]; got.Origin != model.ConfigOriginPipeline || got.OverridesBase {
+		t.Errorf("Expected pipeline-only footer, got %+v", got)
+	}
+	if got := byAlias["title"]; got.Content != "base title" || got.Origin != model.ConfigOriginEnvironment {
+		t.Errorf("Expected inherited title, got %+v", got)
+	}
+}
+
+func TestConfigDAO_Search(t *testing.T) {
+	db := SetupTestDB(t)
+	defer CleanupTestDB(t, db)
+	dao := NewConfigDAO()
+	ctx := context.Background()
+
+	configs := []*model.Config{
+		{EnvironmentKey: "prod", PipelineKey: "ios", Alias: "home_banner", Name: "Home Banner", Type: "image", Labels: `{"team":"growth","tier":"gold"}`},
+		{EnvironmentKey: "prod", PipelineKey: "android", Alias: "home_banner", Name: "Home Banner", Type: "image", Labels: `{"team":"growth"}`},
+		{EnvironmentKey: "test", PipelineKey: "ios", Alias: "checkout_flag", Name: "Checkout 50%", Type: "boolean", Labels: `{"team":"payments"}`},
+		{EnvironmentKey: "test", PipelineKey: "ios", Alias: "theme", Name: "Theme", Type: "object", IsPerm: true},
+	}
+	for _, cfg := range configs {
+		if err := dao.Create(ctx, db, cfg); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	search := func(t *testing.T, filter ConfigSearchFilter, page, pageSize int) ([]model.Config, int64) {
+		t.Helper()
+		list, total, err := dao.Search(ctx, db, filter, page, pageSize)
+		if err != nil {
+			t.Fatalf("Search failed: %v", err)
+		}
+		return list, total
+	}
+	selector := func(t *testing.T, s string) []util.LabelRequirement {
+		t.Helper()
+		reqs, err := util.ParseLabelSelector(s)
+		if err != nil {
+			t.Fatalf("ParseLabelSelector failed: %v", err)
+		}
+		return reqs
+	}
+
+	t.Run("LabelSelectors", func(t *testing.T) {
+		cases := map[string]int64{
+			"team=growth":            2,
+			"team=growth,tier=gold":  1,
+			"team!=growth":           2,
+			"team":                   3,
+			"!team":                  1,
+			"team=growth,tier!=gold": 1,
+			"team=payments,!tier":    1,
+			"team=marketing":         0,
+		}
+		for s, want := range cases {
+			if _, total := search(t, ConfigSearchFilter{Labels: selector(t, s)}, 0, 0); total != want {
+				t.Errorf("Selector %q: expected %d configs, got %d", s, want, total)
+			}
+		}
+	})
+
+	t.Run("ScopeTypeAndKeyword", func(t *testing.T) {
+		if _, total := search(t, ConfigSearchFilter{EnvironmentKey: "prod"}, 0, 0); total != 2 {
+			t.Errorf("Expected 2 prod configs, got %d", total)
+		}
+		if _, total := search(t, ConfigSearchFilter{PipelineKey: "ios"}, 0, 0); total != 3 {
+			t.Errorf("Expected 3 ios configs across environments, got %d", total)
+		}
+		if _, total := search(t, ConfigSearchFilter{Type: "image", Keyword: "BANNER"}, 0, 0); total != 2 {
+			t.Errorf("Expected 2 banner images, got %d", total)
+		}
+		if list, total := search(t, ConfigSearchFilter{Keyword: "50%"}, 0, 0); total != 1 || list[0].Alias != "checkout_flag" {
+			t.Errorf("Expected literal %% match, got %d %+v", total, list)
+		}
+		if _, total := search(t, ConfigSearchFilter{Keyword: "_"}, 0, 0); total != 3 {
+			t.Errorf("Expected literal _ match on 3 aliases, got %d", total)
+		}
+		if list, total := search(t, ConfigSearchFilter{PipelineKey: "ios", ExcludePerm: true}, 0, 0); total != 2 || len(list) != 2 {
+			t.Errorf("Expected 2 ios configs without is_perm, got %d", total)
+		}
+	})
+
+	t.Run("UpdatedRangeAndPagination", func(t *testing.T) {
+		if _, total := search(t, ConfigSearchFilter{UpdatedAfter: time.Now().Add(-time.Hour), UpdatedBefore: time.Now().Add(time.Hour)}, 0, 0); total != 4 {
+			t.Errorf("Expected 4 configs updated within the hour, got %d", total)
+		}
+		if _, total := search(t, ConfigSearchFilter{UpdatedAfter: time.Now().Add(time.Hour)}, 0, 0); total != 0 {
+			t.Errorf("Expected no configs updated in the future, got %d", total)
+		}
+		list, total := search(t, ConfigSearchFilter{}, 2, 3)
+		if total != 4 || len(list) != 1 {
+			t.Errorf("Expected second page with 1 of 4 configs, got %d of %d", len(list), total)
+		}
+	})
+
+	t.Run("LabelIndexFollowsWrites", func(t *testing.T) {
+		theme := configs[3]
+		if err := dao.SetLabels(ctx, db, theme.ID, `{"team":"design"}`); err != nil {
+			t.Fatalf("SetLabels failed: %v", err)
+		}
+		if _, total := search(t, ConfigSearchFilter{Labels: selector(t, "team=design")}, 0, 0); total != 1 {
+			t.Errorf("Expected labelled theme, got %d", total)
+		}
+
+		theme.Labels = ""
+		theme.Content = "{}"
+		if err := dao.UpdateByEnvironmentAndPipeline(ctx, db, "test", "ios", theme); err != nil {
+			t.Fatalf("Update failed: %v", err)
+		}
+		if _, total := search(t, ConfigSearchFilter{Labels: selector(t, "team=design")}, 0, 0); total != 1 {
+			t.Errorf("Expected update without labels to keep them, got %d", total)
+		}
+
+		if err := dao.DeleteByEnvironmentPipelineAndResourceKey(ctx, db, "test", "ios", theme.ResourceKey); err != nil {
+			t.Fatalf("Delete failed: %v", err)
+		}
+		var rows int64
+		if err := db.Model(&model.ConfigLabel{}).Where("config_id = ?", theme.ID).Count(&rows).Error; err != nil {
+			t.Fatalf("Count failed: %v", err)
+		}
+		if rows != 0 {
+			t.Errorf("Expected label rows to be deleted with the config, got %d", rows)
+		}
+	})
+}
+
+func TestConfigDAO_ListScheduled(t *testing.T) {
+	db := SetupTestDB(t)
+	defer CleanupTestDB(t, db)
+	dao := NewConfigDAO()
+	ctx := context.Background()
+
+	now := time.Now()
+	at := func(d time.Duration) *time.Time {
+		v := now.Add(d).UTC()
+		return &v
+	}
+	configs := []*model.Config{
+		{EnvironmentKey: "env", PipelineKey: "pipe", Alias: "always"},
+		{EnvironmentKey: "env", PipelineKey: "pipe", Alias: "launch", EffectiveAt: at(time.Hour)},
+		{EnvironmentKey: "env", PipelineKey: "pipe", Alias: "promo", EffectiveAt: at(-time.Hour), ExpiresAt: at(48 * time.Hour)},
+		{EnvironmentKey: "env", PipelineKey: "pipe", Alias: "ended", EffectiveAt: at(-2 * time.Hour), ExpiresAt: at(-time.Hour)},
+		{EnvironmentKey: "env", PipelineKey: "other", Alias: "launch", EffectiveAt: at(time.Hour)},
+	}
+	for _, cfg := range configs {
+		if err := dao.Create(ctx, db, cfg); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	aliases := func(list []model.Config) []string {
+		result := make([]string, 0, len(list))
+		for _, cfg := range list {
+			result = append(result, cfg.PipelineKey+"/"+cfg.Alias)
+		}
+		return result
+	}
+
+	list, err := dao.ListScheduled(ctx, db, "env", "", now, time.Time{})
+	if err != nil {
+		t.Fatalf("ListScheduled failed: %v", err)
+	}
+	if got := aliases(list); len(got) != 3 {
+		t.Errorf("Expected launch, promo and other/launch, got %v", got)
+	}
+
+	list, err = dao.ListScheduled(ctx, db, "env", "pipe", now, now.Add(24*time.Hour))
+	if err != nil {
+		t.Fatalf("ListScheduled failed: %v", err)
+	}
+	if got := aliases(list); len(got) != 1 || got[0] != "pipe/launch" {
+		t.Errorf("Expected only pipe/launch within a day, got %v", got)
+	}
+
+	t.Run("ClearSchedule", func(t *testing.T) {
+		promo := configs[2]
+		promo.EffectiveAt, promo.ExpiresAt = nil, nil
+		if err := dao.UpdateByEnvironmentAndPipeline(ctx, db, "env", "pipe", promo); err != nil {
+			t.Fatalf("Update failed: %v", err)
+		}
+		stored, err := dao.GetByResourceKey(ctx, db, "env", "pipe", promo.ResourceKey)
+		if err != nil {
+			t.Fatalf("GetByResourceKey failed: %v", err)
+		}
+		if stored.IsScheduled() {
+			t.Errorf("Expected schedule to be cleared, got %v - %v", stored.EffectiveAt, stored.ExpiresAt)
+		}
+	})
+}
+
+func TestConfigDAO_AdvanceRevision(t *testing.T) {
+	db := SetupTestDB(t)
+	defer CleanupTestDB(t, db)
+	dao := NewConfigDAO()
+	ctx := context.Background()
+
+	cfg := &model.Config{EnvironmentKey: "env", PipelineKey: "pipe", Alias: "banner", Content: "v1", Revision: 7}
+	if err := dao.Create(ctx, db, cfg); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	revision := func() int64 {
+		stored, err := dao.GetByResourceKey(ctx, db, "env", "pipe", cfg.ResourceKey)
+		if err != nil {
+			t.Fatalf("GetByResourceKey failed: %v", err)
+		}
+		return stored.Revision
+	}
+	if got := revision(); got != 1 {
+		t.Fatalf("Expected new configs to start at revision 1, got %d", got)
+	}
+
+	ok, err := dao.AdvanceRevision(ctx, db, "env", "pipe", cfg.ResourceKey, 1)
+	if err != nil || !ok {
+		t.Fatalf("AdvanceRevision from 1 = %v, %v", ok, err)
+	}
+	ok, err = dao.AdvanceRevision(ctx, db, "env", "pipe", cfg.ResourceKey, 1)
+	if err != nil || ok {
+		t.Fatalf("Expected stale AdvanceRevision to fail, got %v, %v", ok, err)
+	}
+	if ok, err := dao.AdvanceRevision(ctx, db, "env", "pipe", cfg.ResourceKey, 0); err != nil || !ok {
+		t.Fatalf("Unconditional AdvanceRevision = %v, %v", ok, err)
+	}
+
+	// 更新不会改写版本号
+	cfg.Content, cfg.Revision = "v2", 1
+	if err := dao.UpdateByEnvironmentAndPipeline(ctx, db, "env", "pipe", cfg); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if got := revision(); got != 3 {
+		t.Errorf("Expected revision 3, got %d", got)
+	}
+	if err := dao.SetLabels(ctx, db, cfg.ID, `{"team":"growth"}`); err != nil {
+		t.Fatalf("SetLabels failed: %v", err)
+	}
+	if got := revision(); got != 4 {
+		t.Errorf("Expected label changes to advance the revision to 4, got %d", got)
+	}
+	if ok, err := dao.AdvanceRevision(ctx, db, "env", "pipe", "missing", 0); err != nil || ok {
+		t.Errorf("Expected AdvanceRevision of a missing config to report false, got %v, %v", ok, err)
+	}
+}
+
+func TestConfigDAO_RenameAlias(t *testing.T) {
+	db := SetupTestDB(t)
+	defer CleanupTestDB(t, db)
+	dao := NewConfigDAO()
+	ctx := context.Background()
+
+	configs := []*model.Config{
+		{EnvironmentKey: "env", PipelineKey: "pipe", Alias: "cdn", Content: "default"},
+		{EnvironmentKey: "env", PipelineKey: "pipe", Alias: "cdn", Content: "v2", MinVersion: "2.0.0"},
+		{EnvironmentKey: "env", PipelineKey: "other", Alias: "cdn", Content: "other"},
+	}
+	for _, cfg := range configs {
+		if err := dao.Create(ctx, db, cfg); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	renamed, err := dao.RenameAlias(ctx, db, "env", "pipe", "cdn", "asset_cdn")
+	if err != nil || renamed != 2 {
+		t.Fatalf("RenameAlias = %d, %v", renamed, err)
+	}
+	variants, err := dao.ListByAlias(ctx, db, "env", "pipe", "asset_cdn")
+	if err != nil {
+		t.Fatalf("ListByAlias failed: %v", err)
+	}
+	if len(variants) != 2 || variants[0].Revision != 2 || variants[1].Revision != 2 {
+		t.Fatalf("Expected both variants renamed at revision 2, got %+v", variants)
+	}
+	if left, _ := dao.ListByAlias(ctx, db, "env", "pipe", "cdn"); len(left) != 0 {
+		t.Errorf("Expected no variants left under the old alias, got %d", len(left))
+	}
+	if other, _ := dao.ListByAlias(ctx, db, "env", "other", "cdn"); len(other) != 1 {
+		t.Errorf("Expected other pipelines to keep the alias, got %d", len(other))
+	}
+}
@@ -0,0 +1,126 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"gorm.io/gorm"
+)
+
+// ConfigReleaseDAO persists and queries published config releases.
+type ConfigReleaseDAO struct{}
+
+func NewConfigReleaseDAO() *ConfigReleaseDAO { return &ConfigReleaseDAO{} }
+
+// Create persists a new release.
+func (dao *ConfigReleaseDAO) Create(ctx context.Context, db *gorm.DB, entity *model.ConfigRelease) error {
+	if entity == nil {
+		return errors.New("config release must not be nil")
+	}
+	if entity.EnvironmentKey == "" || entity.PipelineKey == "" {
+		return errors.New("environment_key and pipeline_key are required")
+	}
+	return db.WithContext(ctx).Create(entity).Error
+}
+
+// GetByVersion fetches a release by its version number.
+func (dao *ConfigReleaseDAO) GetByVersion(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey string, version int) (*model.ConfigRelease, error) {
+	var entity model.ConfigRelease
+	if err := db.WithContext(ctx).
+		Where("environment_key = ? AND pipeline_key = ? AND version = ?", environmentKey, pipelineKey, version).
+		First(&entity).Error; err != nil {
+		return nil, err
+	}
+	return &entity, nil
+}
+
+// GetActive fetches the currently active release of an environment/pipeline.
+func (dao *ConfigReleaseDAO) GetActive(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey string) (*model.ConfigRelease, error) {
+	var entity model.ConfigRelease
+	if err := db.WithContext(ctx).
+		Where("environment_key = ? AND pipeline_key = ? AND is_active = ?", environmentKey, pipelineKey, true).
+		Order("version DESC").
+		First(&entity).Error; err != nil {
+		return nil, err
+	}
+	return &entity, nil
+}
+
+// MaxVersion returns the highest version number published so far, 0 when none.
+func (dao *ConfigReleaseDAO) MaxVersion(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey string) (int, error) {
+	var version *int
+	if err := db.WithContext(ctx).
+		Model(&model.ConfigRelease{}).
+		Where("environment_key = ? AND pipeline_key = ?", environmentKey, pipelineKey).
+		Select("MAX(version)").
+		Scan(&version).Error; err != nil {
+		return 0, err
+	}
+	if version == nil {
+		return 0, nil
+	}
+	return *version, nil
+}
+
+// List returns releases of an environment/pipeline, newest first, without
+// their snapshot payload, together with the total count.
+func (dao *ConfigReleaseDAO) List(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey string, page, pageSize int) ([]model.ConfigRelease, int64, error) {
+	tx := db.WithContext(ctx).
+		Model(&model.ConfigRelease{}).
+		Where("environment_key = ? AND pipeline_key = ?", environmentKey, pipelineKey)
+
+	var total int64
+	if err := tx.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 添加分页支持
+	if page > 0 && pageSize > 0 {
+		offset := (page - 1) * pageSize
+		tx = tx.Limit(pageSize).Offset(offset)
+	}
+
+	var entities []model.ConfigRelease
+	if err := tx.Omit("snapshot").Order("version DESC").Find(&entities).Error; err != nil {
+		return nil, 0, err
+	}
+	return entities, total, nil
+}
+
+// Activate marks the given version as the only active release of its environment/pipeline.
+func (dao *ConfigReleaseDAO) Activate(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey string, version int) error {
+	if err := db.WithContext(ctx).
+		Model(&model.ConfigRelease{}).
+		Where("environment_key = ? AND pipeline_key = ? AND is_active = ?", environmentKey, pipelineKey, true).
+		Update("is_active", false).Error; err != nil {
+		return err
+	}
+	result := db.WithContext(ctx).
+		Model(&model.ConfigRelease{}).
+		Where("environment_key = ? AND pipeline_key = ? AND version = ?", environmentKey, pipelineKey, version).
+		Updates(map[string]any{"is_active": true, "activated_at": time.Now()})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// ListBySnapshotContent returns the releases whose snapshot contains the given text.
+func (dao *ConfigReleaseDAO) ListBySnapshotContent(ctx context.Context, db *gorm.DB, text string) ([]model.ConfigRelease, error) {
+	var entities []model.ConfigRelease
+	if err := db.WithContext(ctx).Where("snapshot LIKE ?", "%"+text+"%").Order("id ASC").Find(&entities).Error; err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+// UpdateSnapshot overwrites the snapshot of a release. Only used to re-encode
+// content in place (e.g. secret key rotation); the configs it describes stay the same.
+func (dao *ConfigReleaseDAO) UpdateSnapshot(ctx context.Context, db *gorm.DB, id uint, snapshot string) error {
+	return db.WithContext(ctx).Model(&model.ConfigRelease{}).Where("id = ?", id).UpdateColumn("snapshot", snapshot).Error
+}
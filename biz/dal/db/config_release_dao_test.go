@@ -0,0 +1,93 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+)
+
+func TestConfigReleaseDAO(t *testing.T) {
+	db := SetupTestDB(t)
+	defer CleanupTestDB(t, db)
+	dao := NewConfigReleaseDAO()
+	ctx := context.Background()
+
+	version, err := dao.MaxVersion(ctx, db, "env", "pipe")
+	if err != nil {
+		t.Fatalf("MaxVersion failed: %v", err)
+	}
+	if version != 0 {
+		t.Fatalf("Expected max version 0 without releases, got %d", version)
+	}
+
+	for v := 1; v <= 2; v++ {
+		release := &model.ConfigRelease{
+			EnvironmentKey: "env",
+			PipelineKey:    "pipe",
+			Version:        v,
+			Snapshot:       "[]",
+		}
+		if err := dao.Create(ctx, db, release); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+		if err := dao.Activate(ctx, db, "env", "pipe", v); err != nil {
+			t.Fatalf("Activate failed: %v", err)
+		}
+	}
+
+	t.Run("DuplicateVersion", func(t *testing.T) {
+		err := dao.Create(ctx, db, &model.ConfigRelease{EnvironmentKey: "env", PipelineKey: "pipe", Version: 2})
+		if err == nil {
+			t.Error("Expected error for duplicate version")
+		}
+	})
+
+	t.Run("MaxVersion", func(t *testing.T) {
+		version, err := dao.MaxVersion(ctx, db, "env", "pipe")
+		if err != nil {
+			t.Fatalf("MaxVersion failed: %v", err)
+		}
+		if version != 2 {
+			t.Errorf("Expected max version 2, got %d", version)
+		}
+	})
+
+	t.Run("ReactivateOlder", func(t *testing.T) {
+		if err := dao.Activate(ctx, db, "env", "pipe", 1); err != nil {
+			t.Fatalf("Activate failed: %v", err)
+		}
+		active, err := dao.GetActive(ctx, db, "env", "pipe")
+		if err != nil {
+			t.Fatalf("GetActive failed: %v", err)
+		}
+		if active.Version != 1 {
+			t.Errorf("Expected version 1 to be active, got %d", active.Version)
+		}
+		if active.ActivatedAt == nil {
+			t.Error("Expected activated_at to be set")
+		}
+	})
+
+	t.Run("ActivateMissing", func(t *testing.T) {
+		if err := dao.Activate(ctx, db, "env", "pipe", 99); err == nil {
+			t.Error("Expected error for missing version")
+		}
+	})
+
+	t.Run("ListOmitsSnapshot", func(t *testing.T) {
+		list, total, err := dao.List(ctx, db, "env", "pipe", 0, 0)
+		if err != nil {
+			t.Fatalf("List failed: %v", err)
+		}
+		if total != 2 || len(list) != 2 {
+			t.Fatalf("Expected 2 releases, got total=%d len=%d", total, len(list))
+		}
+		if list[0].Version != 2 {
+			t.Errorf("Expected newest release first, got version %d", list[0].Version)
+		}
+		if list[0].Snapshot != "" {
+			t.Error("Expected snapshot to be omitted from list")
+		}
+	})
+}
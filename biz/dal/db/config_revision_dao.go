@@ -0,0 +1,74 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"gorm.io/gorm"
+)
+
+// ConfigRevisionDAO persists and queries the change history of configs.
+type ConfigRevisionDAO struct{}
+
+func NewConfigRevisionDAO() *ConfigRevisionDAO { return &ConfigRevisionDAO{} }
+
+// Create appends a new revision record.
+func (dao *ConfigRevisionDAO) Create(ctx context.Context, db *gorm.DB, entity *model.ConfigRevision) error {
+	if entity == nil {
+		return errors.New("config revision must not be nil")
+	}
+	return db.WithContext(ctx).Create(entity).Error
+}
+
+// GetByID fetches a single revision by its primary key.
+func (dao *ConfigRevisionDAO) GetByID(ctx context.Context, db *gorm.DB, id uint) (*model.ConfigRevision, error) {
+	var entity model.ConfigRevision
+	if err := db.WithContext(ctx).First(&entity, id).Error; err != nil {
+		return nil, err
+	}
+	return &entity, nil
+}
+
+// ListByResource returns revisions of a config ordered from newest to oldest together with the total count.
+func (dao *ConfigRevisionDAO) ListByResource(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey, resourceKey string, page, pageSize int) ([]model.ConfigRevision, int64, error) {
+	tx := db.WithContext(ctx).
+		Model(&model.ConfigRevision{}).
+		Where("environment_key = ? AND pipeline_key = ? AND resource_key = ?", environmentKey, pipelineKey, resourceKey)
+
+	var total int64
+	if err := tx.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 添加分页支持
+	if page > 0 && pageSize > 0 {
+		offset := (page - 1) * pageSize
+		tx = tx.Limit(pageSize).Offset(offset)
+	}
+
+	var entities []model.ConfigRevision
+	if err := tx.Order("id DESC").Find(&entities).Error; err != nil {
+		return nil, 0, err
+	}
+	return entities, total, nil
+}
+
+// ListByContent returns the revisions whose before or after snapshot contains the given text.
+func (dao *ConfigRevisionDAO) ListByContent(ctx context.Context, db *gorm.DB, text string) ([]model.ConfigRevision, error) {
+	var entities []model.ConfigRevision
+	pattern := "%" + text + "%"
+	if err := db.WithContext(ctx).Where("before_content LIKE ? OR after_content LIKE ?", pattern, pattern).Order("id ASC").Find(&entities).Error; err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+// UpdateContents overwrites the before/after snapshots of a revision. Only used to
+// re-encode content in place (e.g. secret key rotation).
+func (dao *ConfigRevisionDAO) UpdateContents(ctx context.Context, db *gorm.DB, id uint, before, after string) error {
+	return db.WithContext(ctx).
+		Model(&model.ConfigRevision{}).
+		Where("id = ?", id).
+		UpdateColumns(map[string]any{"before_content": before, "after_content": after}).Error
+}
@@ -0,0 +1,62 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+)
+
+func TestConfigRevisionDAO_ListByResource(t *testing.T) {
+	db := SetupTestDB(t)
+	defer CleanupTestDB(t, db)
+	dao := NewConfigRevisionDAO()
+	ctx := context.Background()
+
+	for _, action := range []string{model.ConfigRevisionActionCreate, model.ConfigRevisionActionUpdate, model.ConfigRevisionActionDelete} {
+		rev := &model.ConfigRevision{
+			EnvironmentKey: "env",
+			PipelineKey:    "pipe",
+			ResourceKey:    "res",
+			Action:         action,
+		}
+		if err := dao.Create(ctx, db, rev); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+	if err := dao.Create(ctx, db, &model.ConfigRevision{EnvironmentKey: "env", PipelineKey: "pipe", ResourceKey: "other"}); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	t.Run("NewestFirst", func(t *testing.T) {
+		list, total, err := dao.ListByResource(ctx, db, "env", "pipe", "res", 0, 0)
+		if err != nil {
+			t.Fatalf("ListByResource failed: %v", err)
+		}
+		if total != 3 || len(list) != 3 {
+			t.Fatalf("Expected 3 revisions, got total=%d len=%d", total, len(list))
+		}
+		if list[0].Action != model.ConfigRevisionActionDelete {
+			t.Errorf("Expected newest revision first, got '%s'", list[0].Action)
+		}
+	})
+
+	t.Run("Pagination", func(t *testing.T) {
+		list, total, err := dao.ListByResource(ctx, db, "env", "pipe", "res", 2, 2)
+		if err != nil {
+			t.Fatalf("ListByResource failed: %v", err)
+		}
+		if total != 3 {
+			t.Errorf("Expected total 3, got %d", total)
+		}
+		if len(list) != 1 || list[0].Action != model.ConfigRevisionActionCreate {
+			t.Errorf("Unexpected second page: %+v", list)
+		}
+	})
+
+	t.Run("NilEntity", func(t *testing.T) {
+		if err := dao.Create(ctx, db, nil); err == nil {
+			t.Error("Expected error for nil entity")
+		}
+	})
+}
@@ -0,0 +1,107 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"gorm.io/gorm"
+)
+
+var openRolloutStatuses = []string{
+	model.ConfigRolloutStatusRunning,
+	model.ConfigRolloutStatusPaused,
+	model.ConfigRolloutStatusPromoted,
+}
+
+// ConfigRolloutDAO persists and queries percentage rollouts of configs.
+type ConfigRolloutDAO struct{}
+
+func NewConfigRolloutDAO() *ConfigRolloutDAO { return &ConfigRolloutDAO{} }
+
+// Create persists a new rollout.
+func (dao *ConfigRolloutDAO) Create(ctx context.Context, db *gorm.DB, entity *model.ConfigRollout) error {
+	if entity == nil {
+		return errors.New("config rollout must not be nil")
+	}
+	return db.WithContext(ctx).Create(entity).Error
+}
+
+// Save updates all fields of an existing rollout.
+func (dao *ConfigRolloutDAO) Save(ctx context.Context, db *gorm.DB, entity *model.ConfigRollout) error {
+	if entity == nil || entity.ID == 0 {
+		return errors.New("config rollout must not be nil")
+	}
+	return db.WithContext(ctx).Save(entity).Error
+}
+
+// GetByID fetches a rollout by its primary key.
+func (dao *ConfigRolloutDAO) GetByID(ctx context.Context, db *gorm.DB, id uint) (*model.ConfigRollout, error) {
+	var entity model.ConfigRollout
+	if err := db.WithContext(ctx).First(&entity, id).Error; err != nil {
+		return nil, err
+	}
+	return &entity, nil
+}
+
+// GetOpenByResourceKey fetches the rollout still affecting a config, if any.
+func (dao *ConfigRolloutDAO) GetOpenByResourceKey(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey, resourceKey string) (*model.ConfigRollout, error) {
+	var entity model.ConfigRollout
+	if err := db.WithContext(ctx).
+		Where("environment_key = ? AND pipeline_key = ? AND resource_key = ? AND status IN ?", environmentKey, pipelineKey, resourceKey, openRolloutStatuses).
+		Order("id DESC").
+		First(&entity).Error; err != nil {
+		return nil, err
+	}
+	return &entity, nil
+}
+
+// List returns rollouts of an environment/pipeline, newest first, optionally filtered by status.
+func (dao *ConfigRolloutDAO) List(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey, status string) ([]model.ConfigRollout, error) {
+	tx := db.WithContext(ctx).Where("environment_key = ? AND pipeline_key = ?", environmentKey, pipelineKey)
+	if status != "" {
+		tx = tx.Where("status = ?", status)
+	}
+	var entities []model.ConfigRollout
+	if err := tx.Order("id DESC").Find(&entities).Error; err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+// ListOpen returns the rollouts still affecting runtime responses of an environment/pipeline.
+func (dao *ConfigRolloutDAO) ListOpen(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey string) ([]model.ConfigRollout, error) {
+	var entities []model.ConfigRollout
+	if err := db.WithContext(ctx).
+		Where("environment_key = ? AND pipeline_key = ? AND status IN ?", environmentKey, pipelineKey, openRolloutStatuses).
+		Order("id ASC").
+		Find(&entities).Error; err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+// ListByCandidatePrefix returns every rollout whose candidate content starts with prefix.
+func (dao *ConfigRolloutDAO) ListByCandidatePrefix(ctx context.Context, db *gorm.DB, prefix string) ([]model.ConfigRollout, error) {
+	var entities []model.ConfigRollout
+	if err := db.WithContext(ctx).Where("candidate_content LIKE ?", prefix+"%").Order("id ASC").Find(&entities).Error; err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+// CompletePromoted marks every promoted rollout of an environment/pipeline as completed.
+func (dao *ConfigRolloutDAO) CompletePromoted(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey string) error {
+	return db.WithContext(ctx).
+		Model(&model.ConfigRollout{}).
+		Where("environment_key = ? AND pipeline_key = ? AND status = ?", environmentKey, pipelineKey, model.ConfigRolloutStatusPromoted).
+		Update("status", model.ConfigRolloutStatusCompleted).Error
+}
+
+// RenameAlias updates the alias recorded on the rollouts of a renamed config.
+func (dao *ConfigRolloutDAO) RenameAlias(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey, from, to string) error {
+	return db.WithContext(ctx).
+		Model(&model.ConfigRollout{}).
+		Where("environment_key = ? AND pipeline_key = ? AND alias = ?", environmentKey, pipelineKey, from).
+		Update("alias", to).Error
+}
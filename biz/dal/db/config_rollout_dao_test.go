@@ -0,0 +1,83 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"gorm.io/gorm"
+)
+
+func TestConfigRolloutDAO(t *testing.T) {
+	db := SetupTestDB(t)
+	defer CleanupTestDB(t, db)
+	dao := NewConfigRolloutDAO()
+	ctx := context.Background()
+
+	statuses := map[string]string{
+		"res-running":  model.ConfigRolloutStatusRunning,
+		"res-paused":   model.ConfigRolloutStatusPaused,
+		"res-promoted": model.ConfigRolloutStatusPromoted,
+		"res-aborted":  model.ConfigRolloutStatusAborted,
+	}
+	for resourceKey, status := range statuses {
+		rollout := &model.ConfigRollout{
+			EnvironmentKey: "env",
+			PipelineKey:    "pipe",
+			ResourceKey:    resourceKey,
+			Alias:          resourceKey,
+			Percentage:     10,
+			Status:         status,
+		}
+		if err := dao.Create(ctx, db, rollout); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	t.Run("GetOpenByResourceKey", func(t *testing.T) {
+		rollout, err := dao.GetOpenByResourceKey(ctx, db, "env", "pipe", "res-paused")
+		if err != nil {
+			t.Fatalf("GetOpenByResourceKey failed: %v", err)
+		}
+		if rollout.Status != model.ConfigRolloutStatusPaused {
+			t.Errorf("Expected paused rollout, got %s", rollout.Status)
+		}
+		if _, err := dao.GetOpenByResourceKey(ctx, db, "env", "pipe", "res-aborted"); !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Errorf("Expected ErrRecordNotFound for aborted rollout, got %v", err)
+		}
+	})
+
+	t.Run("ListOpen", func(t *testing.T) {
+		rollouts, err := dao.ListOpen(ctx, db, "env", "pipe")
+		if err != nil {
+			t.Fatalf("ListOpen failed: %v", err)
+		}
+		if len(rollouts) != 3 {
+			t.Errorf("Expected 3 open rollouts, got %d", len(rollouts))
+		}
+	})
+
+	t.Run("ListByStatus", func(t *testing.T) {
+		rollouts, err := dao.List(ctx, db, "env", "pipe", model.ConfigRolloutStatusAborted)
+		if err != nil {
+			t.Fatalf("List failed: %v", err)
+		}
+		if len(rollouts) != 1 || rollouts[0].ResourceKey != "res-aborted" {
+			t.Errorf("Expected only the aborted rollout, got %+v", rollouts)
+		}
+	})
+
+	t.Run("CompletePromoted", func(t *testing.T) {
+		if err := dao.CompletePromoted(ctx, db, "env", "pipe"); err != nil {
+			t.Fatalf("CompletePromoted failed: %v", err)
+		}
+		rollouts, err := dao.List(ctx, db, "env", "pipe", model.ConfigRolloutStatusCompleted)
+		if err != nil {
+			t.Fatalf("List failed: %v", err)
+		}
+		if len(rollouts) != 1 || rollouts[0].ResourceKey != "res-promoted" {
+			t.Errorf("Expected promoted rollout to be completed, got %+v", rollouts)
+		}
+	})
+}
@@ -0,0 +1,69 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"gorm.io/gorm"
+)
+
+// ConfigSchemaDAO persists and queries JSON Schemas attached to config aliases.
+type ConfigSchemaDAO struct{}
+
+func NewConfigSchemaDAO() *ConfigSchemaDAO { return &ConfigSchemaDAO{} }
+
+// Create persists a new schema.
+func (dao *ConfigSchemaDAO) Create(ctx context.Context, db *gorm.DB, entity *model.ConfigSchema) error {
+	if entity == nil {
+		return errors.New("config schema must not be nil")
+	}
+	return db.WithContext(ctx).Create(entity).Error
+}
+
+// Save updates all fields of an existing schema.
+func (dao *ConfigSchemaDAO) Save(ctx context.Context, db *gorm.DB, entity *model.ConfigSchema) error {
+	if entity == nil || entity.ID == 0 {
+		return errors.New("config schema must not be nil")
+	}
+	return db.WithContext(ctx).Save(entity).Error
+}
+
+// GetByID fetches a schema by its primary key.
+func (dao *ConfigSchemaDAO) GetByID(ctx context.Context, db *gorm.DB, id uint) (*model.ConfigSchema, error) {
+	var entity model.ConfigSchema
+	if err := db.WithContext(ctx).First(&entity, id).Error; err != nil {
+		return nil, err
+	}
+	return &entity, nil
+}
+
+// GetByPattern fetches the schema registered for an alias pattern.
+func (dao *ConfigSchemaDAO) GetByPattern(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey, aliasPattern string) (*model.ConfigSchema, error) {
+	var entity model.ConfigSchema
+	if err := db.WithContext(ctx).
+		Where("environment_key = ? AND pipeline_key = ? AND alias_pattern = ?", environmentKey, pipelineKey, aliasPattern).
+		First(&entity).Error; err != nil {
+		return nil, err
+	}
+	return &entity, nil
+}
+
+// Delete removes a schema by its primary key.
+func (dao *ConfigSchemaDAO) Delete(ctx context.Context, db *gorm.DB, id uint) error {
+	return db.WithContext(ctx).Delete(&model.ConfigSchema{}, id).Error
+}
+
+// List returns the schemas of an environment, optionally restricted to some
+// pipelines, ordered by pipeline and alias pattern.
+func (dao *ConfigSchemaDAO) List(ctx context.Context, db *gorm.DB, environmentKey string, pipelineKeys ...string) ([]model.ConfigSchema, error) {
+	tx := db.WithContext(ctx).Where("environment_key = ?", environmentKey)
+	if len(pipelineKeys) > 0 {
+		tx = tx.Where("pipeline_key IN ?", pipelineKeys)
+	}
+	var entities []model.ConfigSchema
+	if err := tx.Order("pipeline_key ASC, alias_pattern ASC").Find(&entities).Error; err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
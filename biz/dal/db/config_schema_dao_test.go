@@ -0,0 +1,69 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+)
+
+func TestConfigSchemaDAO(t *testing.T) {
+	db := SetupTestDB(t)
+	defer CleanupTestDB(t, db)
+	dao := NewConfigSchemaDAO()
+	ctx := context.Background()
+
+	schemas := []*model.ConfigSchema{
+		{EnvironmentKey: "env", PipelineKey: model.BasePipelineKey, AliasPattern: "banner_*", Schema: "{}"},
+		{EnvironmentKey: "env", PipelineKey: "pipe", AliasPattern: "banner_home", Schema: "{}"},
+		{EnvironmentKey: "env", PipelineKey: "other", AliasPattern: "banner_home", Schema: "{}"},
+	}
+	for _, schema := range schemas {
+		if err := dao.Create(ctx, db, schema); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	t.Run("DuplicatePattern", func(t *testing.T) {
+		err := dao.Create(ctx, db, &model.ConfigSchema{EnvironmentKey: "env", PipelineKey: "pipe", AliasPattern: "banner_home", Schema: "{}"})
+		if err == nil {
+			t.Error("Expected error for duplicate alias pattern")
+		}
+	})
+
+	t.Run("GetByPattern", func(t *testing.T) {
+		schema, err := dao.GetByPattern(ctx, db, "env", model.BasePipelineKey, "banner_*")
+		if err != nil {
+			t.Fatalf("GetByPattern failed: %v", err)
+		}
+		if schema.ID != schemas[0].ID {
+			t.Errorf("Expected schema %d, got %d", schemas[0].ID, schema.ID)
+		}
+	})
+
+	t.Run("ListPipelines", func(t *testing.T) {
+		list, err := dao.List(ctx, db, "env", "pipe", model.BasePipelineKey)
+		if err != nil {
+			t.Fatalf("List failed: %v", err)
+		}
+		if len(list) != 2 {
+			t.Errorf("Expected 2 schemas for pipe and base, got %d", len(list))
+		}
+		all, err := dao.List(ctx, db, "env")
+		if err != nil {
+			t.Fatalf("List failed: %v", err)
+		}
+		if len(all) != 3 {
+			t.Errorf("Expected 3 schemas for env, got %d", len(all))
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		if err := dao.Delete(ctx, db, schemas[2].ID); err != nil {
+			t.Fatalf("Delete failed: %v", err)
+		}
+		if _, err := dao.GetByID(ctx, db, schemas[2].ID); err == nil {
+			t.Error("Expected deleted schema to be gone")
+		}
+	})
+}
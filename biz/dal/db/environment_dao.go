@@ -36,6 +36,15 @@ func (dao *EnvironmentDAO) Update(ctx context.Context, db *gorm.DB, entity *mode
 		Error
 }
 
+// SetRequireApproval turns the approval requirement of an environment on or off.
+func (dao *EnvironmentDAO) SetRequireApproval(ctx context.Context, db *gorm.DB, environmentKey string, required bool) error {
+	return db.WithContext(ctx).
+		Model(&model.Environment{}).
+		Where("environment_key = ?", environmentKey).
+		Update("require_approval", required).
+		Error
+}
+
 // Delete performs a soft delete by environment_key.
 func (dao *EnvironmentDAO) Delete(ctx context.Context, db *gorm.DB, environmentKey string) error {
 	return db.WithContext(ctx).
@@ -150,6 +150,27 @@ func TestEnvironmentDAO_Update(t *testing.T) {
 	})
 }
 
+func TestEnvironmentDAO_SetRequireApproval(t *testing.T) {
+	db := SetupTestDB(t)
+	defer CleanupTestDB(t, db)
+	dao := NewEnvironmentDAO()
+	ctx := context.Background()
+
+	CreateTestEnvironment(t, db, "prod")
+	for _, required := range []bool{true, false} {
+		if err := dao.SetRequireApproval(ctx, db, "prod", required); err != nil {
+			t.Fatalf("SetRequireApproval failed: %v", err)
+		}
+		found, err := dao.GetByKey(ctx, db, "prod")
+		if err != nil {
+			t.Fatalf("GetByKey failed: %v", err)
+		}
+		if found.RequireApproval != required {
+			t.Errorf("Expected require_approval %v, got %v", required, found.RequireApproval)
+		}
+	}
+}
+
 func TestEnvironmentDAO_Delete(t *testing.T) {
 	db := SetupTestDB(t)
 	defer CleanupTestDB(t, db)
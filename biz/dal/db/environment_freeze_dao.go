@@ -0,0 +1,89 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"gorm.io/gorm"
+)
+
+// EnvironmentFreezeDAO persists the change freeze windows of environments and
+// the overrides recorded against them.
+type EnvironmentFreezeDAO struct{}
+
+func NewEnvironmentFreezeDAO() *EnvironmentFreezeDAO { return &EnvironmentFreezeDAO{} }
+
+// Create persists a new freeze window.
+func (dao *EnvironmentFreezeDAO) Create(ctx context.Context, db *gorm.DB, entity *model.EnvironmentFreeze) error {
+	if entity == nil {
+		return errors.New("environment freeze must not be nil")
+	}
+	if entity.EnvironmentKey == "" {
+		return errors.New("environment_key is required")
+	}
+	return db.WithContext(ctx).Create(entity).Error
+}
+
+// Save updates all fields of an existing freeze window.
+func (dao *EnvironmentFreezeDAO) Save(ctx context.Context, db *gorm.DB, entity *model.EnvironmentFreeze) error {
+	if entity == nil || entity.ID == 0 {
+		return errors.New("environment freeze must not be nil")
+	}
+	return db.WithContext(ctx).Save(entity).Error
+}
+
+// Delete removes a freeze window. Its recorded overrides are kept.
+func (dao *EnvironmentFreezeDAO) Delete(ctx context.Context, db *gorm.DB, id uint) error {
+	return db.WithContext(ctx).Delete(&model.EnvironmentFreeze{}, id).Error
+}
+
+// GetByID fetches a freeze window by its primary key.
+func (dao *EnvironmentFreezeDAO) GetByID(ctx context.Context, db *gorm.DB, id uint) (*model.EnvironmentFreeze, error) {
+	var entity model.EnvironmentFreeze
+	if err := db.WithContext(ctx).First(&entity, id).Error; err != nil {
+		return nil, err
+	}
+	return &entity, nil
+}
+
+// ListByEnvironment returns the freeze windows of an environment in creation order.
+func (dao *EnvironmentFreezeDAO) ListByEnvironment(ctx context.Context, db *gorm.DB, environmentKey string) ([]model.EnvironmentFreeze, error) {
+	var entities []model.EnvironmentFreeze
+	if err := db.WithContext(ctx).
+		Where("environment_key = ?", environmentKey).
+		Order("id ASC").
+		Find(&entities).Error; err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+// CreateOverride records a write let through an active freeze window.
+func (dao *EnvironmentFreezeDAO) CreateOverride(ctx context.Context, db *gorm.DB, entity *model.EnvironmentFreezeOverride) error {
+	if entity == nil {
+		return errors.New("environment freeze override must not be nil")
+	}
+	return db.WithContext(ctx).Create(entity).Error
+}
+
+// ListOverrides returns the overrides recorded for an environment, newest first, together with the total count.
+func (dao *EnvironmentFreezeDAO) ListOverrides(ctx context.Context, db *gorm.DB, environmentKey string, page, pageSize int) ([]model.EnvironmentFreezeOverride, int64, error) {
+	tx := db.WithContext(ctx).
+		Model(&model.EnvironmentFreezeOverride{}).
+		Where("environment_key = ?", environmentKey)
+
+	var total int64
+	if err := tx.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if page > 0 && pageSize > 0 {
+		tx = tx.Limit(pageSize).Offset((page - 1) * pageSize)
+	}
+
+	var entities []model.EnvironmentFreezeOverride
+	if err := tx.Order("id DESC").Find(&entities).Error; err != nil {
+		return nil, 0, err
+	}
+	return entities, total, nil
+}
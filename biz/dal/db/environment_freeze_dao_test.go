@@ -0,0 +1,69 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+)
+
+func TestEnvironmentFreezeDAO(t *testing.T) {
+	db := SetupTestDB(t)
+	defer CleanupTestDB(t, db)
+	dao := NewEnvironmentFreezeDAO()
+	ctx := context.Background()
+
+	start := time.Date(2026, 11, 10, 0, 0, 0, 0, time.UTC)
+	end := start.Add(48 * time.Hour)
+	freezes := []*model.EnvironmentFreeze{
+		{EnvironmentKey: "prod", Name: "双十一", Recurrence: model.EnvironmentFreezeOnce, StartsAt: &start, EndsAt: &end, AllowedUsers: "alice"},
+		{EnvironmentKey: "prod", Name: "周末", Recurrence: model.EnvironmentFreezeWeekly, WeeklyStart: "fri 18:00", WeeklyEnd: "mon 08:00"},
+		{EnvironmentKey: "test", Name: "其他环境", Recurrence: model.EnvironmentFreezeOnce, StartsAt: &start, EndsAt: &end},
+	}
+	for _, freeze := range freezes {
+		if err := dao.Create(ctx, db, freeze); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	list, err := dao.ListByEnvironment(ctx, db, "prod")
+	if err != nil {
+		t.Fatalf("ListByEnvironment failed: %v", err)
+	}
+	if len(list) != 2 || list[0].Name != "双十一" || list[1].Name != "周末" {
+		t.Fatalf("unexpected freezes: %+v", list)
+	}
+	if list[0].StartsAt == nil || !list[0].StartsAt.Equal(start) {
+		t.Errorf("StartsAt not persisted: %v", list[0].StartsAt)
+	}
+
+	list[1].AllowedUsers = "bob"
+	if err := dao.Save(ctx, db, &list[1]); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	saved, err := dao.GetByID(ctx, db, list[1].ID)
+	if err != nil || saved.AllowedUsers != "bob" {
+		t.Fatalf("GetByID = %+v, %v", saved, err)
+	}
+
+	for i := 0; i < 3; i++ {
+		override := &model.EnvironmentFreezeOverride{FreezeID: list[0].ID, EnvironmentKey: "prod", Operation: "config.update", Reason: "hotfix", OperatorName: "alice"}
+		if err := dao.CreateOverride(ctx, db, override); err != nil {
+			t.Fatalf("CreateOverride failed: %v", err)
+		}
+	}
+	if err := dao.Delete(ctx, db, list[0].ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	overrides, total, err := dao.ListOverrides(ctx, db, "prod", 1, 2)
+	if err != nil {
+		t.Fatalf("ListOverrides failed: %v", err)
+	}
+	if total != 3 || len(overrides) != 2 || overrides[0].ID < overrides[1].ID {
+		t.Errorf("unexpected overrides: total=%d %+v", total, overrides)
+	}
+	if list, _ := dao.ListByEnvironment(ctx, db, "prod"); len(list) != 1 {
+		t.Errorf("expected 1 freeze after delete, got %d", len(list))
+	}
+}
@@ -0,0 +1,86 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"gorm.io/gorm"
+)
+
+// PipelineAPIKeyDAO persists the API keys of pipelines.
+type PipelineAPIKeyDAO struct{}
+
+func NewPipelineAPIKeyDAO() *PipelineAPIKeyDAO { return &PipelineAPIKeyDAO{} }
+
+// Create persists a new API key.
+func (dao *PipelineAPIKeyDAO) Create(ctx context.Context, db *gorm.DB, entity *model.PipelineAPIKey) error {
+	if entity == nil {
+		return errors.New("pipeline api key must not be nil")
+	}
+	if entity.EnvironmentKey == "" || entity.PipelineKey == "" {
+		return errors.New("environment_key and pipeline_key are required")
+	}
+	if entity.KeyID == "" || entity.SecretHash == "" {
+		return errors.New("key_id and secret_hash are required")
+	}
+	return db.WithContext(ctx).Create(entity).Error
+}
+
+// Save updates all fields of an existing API key.
+func (dao *PipelineAPIKeyDAO) Save(ctx context.Context, db *gorm.DB, entity *model.PipelineAPIKey) error {
+	if entity == nil || entity.ID == 0 {
+		return errors.New("pipeline api key must not be nil")
+	}
+	return db.WithContext(ctx).Save(entity).Error
+}
+
+// GetByID fetches an API key by its primary key.
+func (dao *PipelineAPIKeyDAO) GetByID(ctx context.Context, db *gorm.DB, id uint) (*model.PipelineAPIKey, error) {
+	var entity model.PipelineAPIKey
+	if err := db.WithContext(ctx).First(&entity, id).Error; err != nil {
+		return nil, err
+	}
+	return &entity, nil
+}
+
+// GetByKeyID fetches an API key by the public key id of its tokens.
+func (dao *PipelineAPIKeyDAO) GetByKeyID(ctx context.Context, db *gorm.DB, keyID string) (*model.PipelineAPIKey, error) {
+	var entity model.PipelineAPIKey
+	if err := db.WithContext(ctx).Where("key_id = ?", keyID).First(&entity).Error; err != nil {
+		return nil, err
+	}
+	return &entity, nil
+}
+
+// ListByPipeline returns the API keys of a pipeline in creation order.
+func (dao *PipelineAPIKeyDAO) ListByPipeline(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey string) ([]model.PipelineAPIKey, error) {
+	var entities []model.PipelineAPIKey
+	if err := db.WithContext(ctx).
+		Where("environment_key = ? AND pipeline_key = ?", environmentKey, pipelineKey).
+		Order("id ASC").
+		Find(&entities).Error; err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+// CountActive counts the keys of a pipeline that are neither revoked nor expired at now.
+func (dao *PipelineAPIKeyDAO) CountActive(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey string, now time.Time) (int64, error) {
+	var count int64
+	err := db.WithContext(ctx).
+		Model(&model.PipelineAPIKey{}).
+		Where("environment_key = ? AND pipeline_key = ?", environmentKey, pipelineKey).
+		Where("revoked_at IS NULL AND (expires_at IS NULL OR expires_at > ?)", now).
+		Count(&count).Error
+	return count, err
+}
+
+// RevokeByPipeline revokes the keys of a pipeline that are not revoked yet.
+func (dao *PipelineAPIKeyDAO) RevokeByPipeline(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey string, now time.Time) error {
+	return db.WithContext(ctx).
+		Model(&model.PipelineAPIKey{}).
+		Where("environment_key = ? AND pipeline_key = ? AND revoked_at IS NULL", environmentKey, pipelineKey).
+		Update("revoked_at", now).Error
+}
@@ -0,0 +1,55 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+)
+
+func TestPipelineAPIKeyDAO(t *testing.T) {
+	db := SetupTestDB(t)
+	defer CleanupTestDB(t, db)
+	dao := NewPipelineAPIKeyDAO()
+	ctx := context.Background()
+	now := time.Now()
+	past := now.Add(-time.Hour)
+
+	keys := []*model.PipelineAPIKey{
+		{EnvironmentKey: "prod", PipelineKey: "main", Name: "app", KeyID: "k1", SecretHash: "h1", Scopes: "runtime-read"},
+		{EnvironmentKey: "prod", PipelineKey: "main", Name: "old", KeyID: "k2", SecretHash: "h2", ExpiresAt: &past},
+		{EnvironmentKey: "prod", PipelineKey: "other", Name: "app", KeyID: "k3", SecretHash: "h3"},
+	}
+	for _, key := range keys {
+		if err := dao.Create(ctx, db, key); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+	if err := dao.Create(ctx, db, &model.PipelineAPIKey{EnvironmentKey: "prod", PipelineKey: "main", KeyID: "k1", SecretHash: "h"}); err == nil {
+		t.Fatal("expected duplicate key_id to be rejected")
+	}
+
+	found, err := dao.GetByKeyID(ctx, db, "k1")
+	if err != nil || found.ID != keys[0].ID || found.ScopeList()[0] != "runtime-read" {
+		t.Fatalf("GetByKeyID = %+v, %v", found, err)
+	}
+	listed, err := dao.ListByPipeline(ctx, db, "prod", "main")
+	if err != nil || len(listed) != 2 || listed[0].KeyID != "k1" {
+		t.Fatalf("ListByPipeline = %+v, %v", listed, err)
+	}
+	if count, err := dao.CountActive(ctx, db, "prod", "main", now); err != nil || count != 1 {
+		t.Fatalf("CountActive = %d, %v, want 1", count, err)
+	}
+
+	if err := dao.RevokeByPipeline(ctx, db, "prod", "main", now); err != nil {
+		t.Fatalf("RevokeByPipeline failed: %v", err)
+	}
+	if count, err := dao.CountActive(ctx, db, "prod", "main", now); err != nil || count != 0 {
+		t.Fatalf("CountActive after revoke = %d, %v, want 0", count, err)
+	}
+	other, err := dao.GetByID(ctx, db, keys[2].ID)
+	if err != nil || !other.ActiveAt(now) {
+		t.Fatalf("expected key of another pipeline to stay active: %+v, %v", other, err)
+	}
+}
@@ -39,6 +39,16 @@ func (dao *PipelineDAO) Update(ctx context.Context, db *gorm.DB, entity *model.P
 		Error
 }
 
+// SetLocales stores the default locale and the further locales of a pipeline;
+// unlike Update it also clears them.
+func (dao *PipelineDAO) SetLocales(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey, defaultLocale, locales string) error {
+	return db.WithContext(ctx).
+		Model(&model.Pipeline{}).
+		Where("environment_key = ? AND pipeline_key = ?", environmentKey, pipelineKey).
+		Updates(map[string]any{"default_locale": defaultLocale, "locales": locales}).
+		Error
+}
+
 // Delete performs a soft delete by environment_key and pipeline_key.
 func (dao *PipelineDAO) Delete(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey string) error {
 	return db.WithContext(ctx).
@@ -0,0 +1,163 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"gorm.io/gorm"
+)
+
+// RuntimeChangeDAO persists the runtime change counters of pipelines.
+type RuntimeChangeDAO struct{}
+
+func NewRuntimeChangeDAO() *RuntimeChangeDAO { return &RuntimeChangeDAO{} }
+
+// Bump increments the change counter of an environment/pipeline and records
+// at as the time of the change, creating the counter when needed.
+func (dao *RuntimeChangeDAO) Bump(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey string, at time.Time) error {
+	bump := func() (int64, error) {
+		result := db.WithContext(ctx).
+			Model(&model.RuntimeChange{}).
+			Where("environment_key = ? AND pipeline_key = ?", environmentKey, pipelineKey).
+			Updates(map[string]interface{}{
+				"counter":    gorm.Expr("counter + 1"),
+				"changed_at": at.UTC(),
+			})
+		return result.RowsAffected, result.Error
+	}
+	affected, err := bump()
+	if err != nil || affected > 0 {
+		return err
+	}
+	entity := &model.RuntimeChange{EnvironmentKey: environmentKey, PipelineKey: pipelineKey, Counter: 1, ChangedAt: at.UTC()}
+	if err := db.WithContext(ctx).Create(entity).Error; err != nil {
+		// 并发创建时计数器已存在，重新递增
+		if affected, retryErr := bump(); retryErr != nil || affected == 0 {
+			return err
+		}
+	}
+	return nil
+}
+
+// BumpAll increments every change counter, e.g. after all configs were replaced.
+func (dao *RuntimeChangeDAO) BumpAll(ctx context.Context, db *gorm.DB, at time.Time) error {
+	return db.WithContext(ctx).
+		Model(&model.RuntimeChange{}).
+		Where("1 = 1").
+		Updates(map[string]interface{}{
+			"counter":    gorm.Expr("counter + 1"),
+			"changed_at": at.UTC(),
+		}).Error
+}
+
+// Get returns the change counter of an environment/pipeline, creating it with
+// a count of 0 changed at at when it does not exist yet.
+func (dao *RuntimeChangeDAO) Get(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey string, at time.Time) (*model.RuntimeChange, error) {
+	var entity model.RuntimeChange
+	err := db.WithContext(ctx).
+		Where("environment_key = ? AND pipeline_key = ?", environmentKey, pipelineKey).
+		First(&entity).Error
+	if err == nil {
+		return &entity, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	entity = model.RuntimeChange{EnvironmentKey: environmentKey, PipelineKey: pipelineKey, ChangedAt: at.UTC()}
+	if createErr := db.WithContext(ctx).Create(&entity).Error; createErr != nil {
+		// 并发创建时读取已存在的计数器
+		entity = model.RuntimeChange{}
+		if err := db.WithContext(ctx).
+			Where("environment_key = ? AND pipeline_key = ?", environmentKey, pipelineKey).
+			First(&entity).Error; err != nil {
+			return nil, createErr
+		}
+	}
+	return &entity, nil
+}
+
+// ListScopes returns the environment/pipelines that have a change counter.
+func (dao *RuntimeChangeDAO) ListScopes(ctx context.Context, db *gorm.DB) ([]model.RuntimeChange, error) {
+	var changes []model.RuntimeChange
+	if err := db.WithContext(ctx).
+		Order("environment_key ASC, pipeline_key ASC").
+		Find(&changes).Error; err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// AppendLog records changes in the change log; every entry gets the next
+// revision. Called within the transaction of the change, revisions follow the
+// commit order; see model.ChangeSequence.
+func (dao *RuntimeChangeDAO) AppendLog(ctx context.Context, db *gorm.DB, entries []model.RuntimeChangeLog) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		first, err := reserveSequence(ctx, tx, model.RuntimeChangeLog{}.TableName(), len(entries))
+		if err != nil {
+			return err
+		}
+		for i := range entries {
+			entries[i].ID = uint(first) + uint(i)
+		}
+		return tx.Create(&entries).Error
+	})
+}
+
+// LatestRevision returns the revision of the last change logged for an
+// environment and any of pipelineKeys, or 0 when there is none.
+func (dao *RuntimeChangeDAO) LatestRevision(ctx context.Context, db *gorm.DB, environmentKey string, pipelineKeys []string) (int64, error) {
+	var revision int64
+	err := db.WithContext(ctx).
+		Model(&model.RuntimeChangeLog{}).
+		Where("environment_key = ? AND pipeline_key IN ?", environmentKey, pipelineKeys).
+		Select("COALESCE(MAX(id), 0)").
+		Scan(&revision).Error
+	return revision, err
+}
+
+// ListLogAfter returns up to limit changes logged for an environment and any of
+// pipelineKeys after revision, oldest first.
+func (dao *RuntimeChangeDAO) ListLogAfter(ctx context.Context, db *gorm.DB, environmentKey string, pipelineKeys []string, revision int64, limit int) ([]model.RuntimeChangeLog, error) {
+	var entries []model.RuntimeChangeLog
+	if err := db.WithContext(ctx).
+		Where("environment_key = ? AND pipeline_key IN ? AND id > ?", environmentKey, pipelineKeys, revision).
+		Order("id ASC").
+		Limit(limit).
+		Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// OldestRevision returns the revision of the oldest change still logged, or 0
+// when the log is empty.
+func (dao *RuntimeChangeDAO) OldestRevision(ctx context.Context, db *gorm.DB) (int64, error) {
+	var revision int64
+	err := db.WithContext(ctx).
+		Model(&model.RuntimeChangeLog{}).
+		Select("COALESCE(MIN(id), 0)").
+		Scan(&revision).Error
+	return revision, err
+}
+
+// PruneLog keeps only the last keep changes of the log.
+func (dao *RuntimeChangeDAO) PruneLog(ctx context.Context, db *gorm.DB, keep int64) error {
+	var latest int64
+	if err := db.WithContext(ctx).
+		Model(&model.RuntimeChangeLog{}).
+		Select("COALESCE(MAX(id), 0)").
+		Scan(&latest).Error; err != nil {
+		return err
+	}
+	if latest <= keep {
+		return nil
+	}
+	return db.WithContext(ctx).
+		Where("id <= ?", latest-keep).
+		Delete(&model.RuntimeChangeLog{}).Error
+}
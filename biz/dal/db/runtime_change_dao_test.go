@@ -0,0 +1,143 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"gorm.io/gorm"
+)
+
+func TestRuntimeChangeDAO(t *testing.T) {
+	db := SetupTestDB(t)
+	defer CleanupTestDB(t, db)
+	dao := NewRuntimeChangeDAO()
+	ctx := context.Background()
+
+	start := time.Date(2026, 10, 17, 8, 0, 0, 0, time.UTC)
+	change, err := dao.Get(ctx, db, "prod", "main", start)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if change.Counter != 0 || !change.ChangedAt.Equal(start) {
+		t.Fatalf("unexpected new counter: %+v", change)
+	}
+
+	for i := 1; i <= 2; i++ {
+		if err := dao.Bump(ctx, db, "prod", "main", start.Add(time.Duration(i)*time.Minute)); err != nil {
+			t.Fatalf("Bump failed: %v", err)
+		}
+	}
+	// 不存在的计数器在首次变更时创建
+	if err := dao.Bump(ctx, db, "prod", model.BasePipelineKey, start); err != nil {
+		t.Fatalf("Bump failed: %v", err)
+	}
+
+	change, err = dao.Get(ctx, db, "prod", "main", start.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if change.Counter != 2 || !change.ChangedAt.Equal(start.Add(2*time.Minute)) {
+		t.Fatalf("unexpected counter after bumps: %+v", change)
+	}
+	base, err := dao.Get(ctx, db, "prod", model.BasePipelineKey, start.Add(time.Hour))
+	if err != nil || base.Counter != 1 {
+		t.Fatalf("unexpected base counter: %+v, %v", base, err)
+	}
+
+	if err := dao.BumpAll(ctx, db, start.Add(time.Hour)); err != nil {
+		t.Fatalf("BumpAll failed: %v", err)
+	}
+	change, _ = dao.Get(ctx, db, "prod", "main", start)
+	base, _ = dao.Get(ctx, db, "prod", model.BasePipelineKey, start)
+	if change.Counter != 3 || base.Counter != 2 || !change.ChangedAt.Equal(start.Add(time.Hour)) {
+		t.Fatalf("unexpected counters after BumpAll: %+v %+v", change, base)
+	}
+}
+
+func TestRuntimeChangeDAOLog(t *testing.T) {
+	db := SetupTestDB(t)
+	defer CleanupTestDB(t, db)
+	dao := NewRuntimeChangeDAO()
+	ctx := context.Background()
+	scopes := []string{"main", model.BasePipelineKey}
+
+	revision, err := dao.LatestRevision(ctx, db, "prod", scopes)
+	if err != nil || revision != 0 {
+		t.Fatalf("LatestRevision of empty log = %d, %v", revision, err)
+	}
+
+	entries := []model.RuntimeChangeLog{
+		{EnvironmentKey: "prod", PipelineKey: "main", Alias: "title"},
+		{EnvironmentKey: "prod", PipelineKey: "other", Alias: "title"},
+		{EnvironmentKey: "test", PipelineKey: "main"},
+		{EnvironmentKey: "prod", PipelineKey: model.BasePipelineKey, Alias: "theme"},
+	}
+	if err := dao.AppendLog(ctx, db, entries); err != nil {
+		t.Fatalf("AppendLog failed: %v", err)
+	}
+
+	revision, err = dao.LatestRevision(ctx, db, "prod", scopes)
+	if err != nil || revision != int64(entries[3].ID) {
+		t.Fatalf("LatestRevision = %d, %v, want %d", revision, err, entries[3].ID)
+	}
+	after, err := dao.ListLogAfter(ctx, db, "prod", scopes, int64(entries[0].ID), 10)
+	if err != nil {
+		t.Fatalf("ListLogAfter failed: %v", err)
+	}
+	if len(after) != 1 || after[0].Alias != "theme" {
+		t.Fatalf("unexpected changes after first revision: %+v", after)
+	}
+
+	if err := dao.PruneLog(ctx, db, 2); err != nil {
+		t.Fatalf("PruneLog failed: %v", err)
+	}
+	oldest, err := dao.OldestRevision(ctx, db)
+	if err != nil || oldest != int64(entries[2].ID) {
+		t.Fatalf("OldestRevision = %d, %v, want %d", oldest, err, entries[2].ID)
+	}
+}
+
+// TestRuntimeChangeDAOLogRevisions checks that revisions continue after the
+// entries already logged and are released by a rolled back transaction.
+func TestRuntimeChangeDAOLogRevisions(t *testing.T) {
+	db := SetupTestDB(t)
+	defer CleanupTestDB(t, db)
+	dao := NewRuntimeChangeDAO()
+	ctx := context.Background()
+
+	// 早于变更序列写入的日志
+	if err := db.Create(&model.RuntimeChangeLog{ID: 41, EnvironmentKey: "prod", PipelineKey: "main"}).Error; err != nil {
+		t.Fatalf("create log entry: %v", err)
+	}
+	entries := []model.RuntimeChangeLog{
+		{EnvironmentKey: "prod", PipelineKey: "main", Alias: "title"},
+		{EnvironmentKey: "prod", PipelineKey: "main", Alias: "theme"},
+	}
+	if err := dao.AppendLog(ctx, db, entries); err != nil {
+		t.Fatalf("AppendLog failed: %v", err)
+	}
+	if entries[0].ID != 42 || entries[1].ID != 43 {
+		t.Fatalf("revisions = %d, %d; want 42, 43", entries[0].ID, entries[1].ID)
+	}
+
+	rollback := errors.New("rollback")
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := dao.AppendLog(ctx, tx, []model.RuntimeChangeLog{{EnvironmentKey: "prod", PipelineKey: "main"}}); err != nil {
+			return err
+		}
+		return rollback
+	})
+	if !errors.Is(err, rollback) {
+		t.Fatalf("transaction = %v, want rollback", err)
+	}
+	entries = []model.RuntimeChangeLog{{EnvironmentKey: "prod", PipelineKey: "main", Alias: "title"}}
+	if err := dao.AppendLog(ctx, db, entries); err != nil {
+		t.Fatalf("AppendLog failed: %v", err)
+	}
+	if entries[0].ID != 44 {
+		t.Fatalf("revision after a rollback = %d, want 44", entries[0].ID)
+	}
+}
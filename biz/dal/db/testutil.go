@@ -27,6 +27,22 @@ func SetupTestDB(t *testing.T) *gorm.DB {
 		&model.Pipeline{},
 		&model.Config{},
 		&model.Asset{},
+		&model.ConfigRevision{},
+		&model.ConfigRelease{},
+		&model.ConfigRollout{},
+		&model.ConfigSchema{},
+		&model.ConfigLabel{},
+		&model.EnvironmentFreeze{},
+		&model.EnvironmentFreezeOverride{},
+		&model.ConfigChangeRequest{},
+		&model.ConfigChangeRequestItem{},
+		&model.ConfigChangeRequestComment{},
+		&model.ConfigAliasRedirect{},
+		&model.RuntimeChange{},
+		&model.RuntimeChangeLog{},
+		&model.ChangeSequence{},
+		&model.ChangeEvent{},
+		&model.PipelineAPIKey{},
 	); err != nil {
 		t.Fatalf("Failed to migrate tables: %v", err)
 	}
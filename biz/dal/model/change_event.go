@@ -0,0 +1,44 @@
+package model
+
+import (
+	"time"
+)
+
+// Change event kinds.
+const (
+	ChangeEventKindConfig = "config"
+	ChangeEventKindAsset  = "asset"
+)
+
+// Change event actions.
+const (
+	ChangeEventCreated = "created"
+	ChangeEventUpdated = "updated"
+	ChangeEventDeleted = "deleted"
+)
+
+// ChangeEvent records that a config or asset of an environment/pipeline was
+// created, updated or deleted, for clients streaming changes. Its ID is the
+// event ID clients resume from, handed out in commit order by ChangeSequence.
+// Configs are identified by ResourceKey and Alias, assets by FileID; Name is
+// the config name or asset file name. IsPerm marks events of configs marked
+// is_perm before or after the change.
+type ChangeEvent struct {
+	ID             uint      `gorm:"primaryKey" json:"id"`
+	EnvironmentKey string    `gorm:"column:environment_key;index:idx_change_event_scope,priority:1" json:"environment_key"`
+	PipelineKey    string    `gorm:"column:pipeline_key;index:idx_change_event_scope,priority:2" json:"pipeline_key"`
+	Kind           string    `gorm:"column:kind;type:varchar(16)" json:"kind"`
+	Action         string    `gorm:"column:action;type:varchar(16)" json:"action"`
+	ResourceKey    string    `gorm:"column:resource_key" json:"resource_key,omitempty"`
+	Alias          string    `gorm:"column:alias" json:"alias,omitempty"`
+	FileID         string    `gorm:"column:file_id" json:"file_id,omitempty"`
+	Name           string    `gorm:"column:name" json:"name,omitempty"`
+	IsPerm         bool      `gorm:"column:is_perm" json:"is_perm,omitempty"`
+	OperatorName   string    `gorm:"column:operator_name" json:"operator_name,omitempty"`
+	CreatedAt      time.Time `gorm:"column:created_at" json:"created_at"`
+}
+
+// TableName overrides gorm to use change_event table.
+func (ChangeEvent) TableName() string {
+	return "change_event"
+}
@@ -0,0 +1,16 @@
+package model
+
+// ChangeSequence hands out the IDs of an append-only change log in commit
+// order. Its row is incremented within the transaction that appends to the
+// log, so writers take the row lock in turn and an ID only becomes visible
+// after every lower one: readers may resume from the highest ID they have
+// seen without missing entries committed later.
+type ChangeSequence struct {
+	Name  string `gorm:"column:name;primaryKey;type:varchar(64)" json:"name"`
+	Value int64  `gorm:"column:value;not null;default:0" json:"value"`
+}
+
+// TableName overrides gorm to use change_sequence table.
+func (ChangeSequence) TableName() string {
+	return "change_sequence"
+}
@@ -1,16 +1,29 @@
 package model
 
 import (
+	"sort"
 	"time"
 
+	"github.com/yi-nology/rainbow_bridge/pkg/util"
 	"gorm.io/gorm"
 )
 
+// BasePipelineKey is the pipeline key of environment-level base configs. Every
+// pipeline of the environment inherits them unless it has its own config with
+// the same alias.
+const BasePipelineKey = "_base"
+
+// Config origins reported on merged views.
+const (
+	ConfigOriginEnvironment = "environment"
+	ConfigOriginPipeline    = "pipeline"
+)
+
 // Config represents a configuration resource persisted in storage.
 type Config struct {
 	ID             uint           `gorm:"primaryKey" json:"id,omitempty"`
 	CreatedAt      time.Time      `json:"created_at,omitempty"`
-	UpdatedAt      time.Time      `json:"updated_at,omitempty"`
+	UpdatedAt      time.Time      `gorm:"index:idx_config_updated" json:"updated_at,omitempty"`
 	DeletedAt      gorm.DeletedAt `gorm:"index" json:"-"`
 	EnvironmentKey string         `gorm:"column:environment_key;uniqueIndex:uk_config_resource,priority:1;index:idx_config_env" json:"environment_key,omitempty"`
 	PipelineKey    string         `gorm:"column:pipeline_key;uniqueIndex:uk_config_resource,priority:2;index:idx_config_pipeline" json:"pipeline_key,omitempty"`
@@ -22,9 +35,229 @@ type Config struct {
 	Remark         string         `gorm:"column:remark;type:varchar(512)" json:"remark,omitempty"`
 	IsPerm         bool           `gorm:"column:is_perm" json:"is_perm,omitempty"`
 	Description    string         `gorm:"column:description;type:text" json:"description,omitempty"`
+	// MinVersion/MaxVersion restrict the config to client versions in [MinVersion, MaxVersion).
+	// Several variants of one alias may exist with non-overlapping ranges; the
+	// variant without any range is the default.
+	MinVersion string `gorm:"column:min_version;type:varchar(64)" json:"min_version,omitempty"`
+	MaxVersion string `gorm:"column:max_version;type:varchar(64)" json:"max_version,omitempty"`
+	// Options holds type-specific constraints as JSON, e.g. {"min":0,"max":100,"precision":2}
+	// for number and decimal configs.
+	Options string `gorm:"column:options;type:text" json:"options,omitempty"`
+	// Labels holds free-form key=value labels as a JSON object with sorted keys.
+	// They are indexed in ConfigLabel for label selector searches.
+	Labels string `gorm:"column:labels;type:text" json:"labels,omitempty"`
+	// Translations holds the per-locale values of text, textarea and richtext configs
+	// as a JSON object keyed by locale; Content is the value in the pipeline's default locale.
+	Translations string `gorm:"column:translations;type:text" json:"translations,omitempty"`
+	// EffectiveAt/ExpiresAt limit the config to runtime clients within [EffectiveAt, ExpiresAt);
+	// nil bounds are open. Both are stored in UTC, ScheduleTimezone (IANA name) is the
+	// zone the schedule was entered in and is used to display it.
+	EffectiveAt      *time.Time `gorm:"column:effective_at;index:idx_config_effective" json:"effective_at,omitempty"`
+	ExpiresAt        *time.Time `gorm:"column:expires_at;index:idx_config_expires" json:"expires_at,omitempty"`
+	ScheduleTimezone string     `gorm:"column:schedule_timezone;type:varchar(64)" json:"schedule_timezone,omitempty"`
+	// Revision starts at 1 and increases with every write of the config. Updates
+	// and deletes may require it to still match the revision a client has read.
+	Revision int64 `gorm:"column:revision;not null;default:1" json:"revision,omitempty"`
+	// Origin and OverridesBase are computed on merged views and not persisted.
+	Origin        string `gorm:"-" json:"origin,omitempty"`
+	OverridesBase bool   `gorm:"-" json:"overrides_base,omitempty"`
+	// RenamedTo and DeprecatedUntil are set on runtime entries served under the
+	// old alias of a renamed config, see ConfigAliasRedirect.
+	RenamedTo       string     `gorm:"-" json:"renamed_to,omitempty"`
+	DeprecatedUntil *time.Time `gorm:"-" json:"deprecated_until,omitempty"`
+	// Locale is set on runtime entries of translated configs to the locale Content was served in.
+	Locale string `gorm:"-" json:"locale,omitempty"`
 }
 
 // TableName overrides gorm to use resource_config table.
 func (Config) TableName() string {
 	return "resource_config"
 }
+
+// IsDefaultVariant reports whether the config carries no client-version range.
+func (c *Config) IsDefaultVariant() bool {
+	return c.MinVersion == "" && c.MaxVersion == ""
+}
+
+// IsScheduled reports whether the config carries an activation or expiry time.
+func (c *Config) IsScheduled() bool {
+	return c.EffectiveAt != nil || c.ExpiresAt != nil
+}
+
+// InScheduleWindow reports whether the config is served at t.
+func (c *Config) InScheduleWindow(t time.Time) bool {
+	if c.EffectiveAt != nil && t.Before(*c.EffectiveAt) {
+		return false
+	}
+	if c.ExpiresAt != nil && !t.Before(*c.ExpiresAt) {
+		return false
+	}
+	return true
+}
+
+// MatchesClientVersion reports whether a ranged config applies to the client version.
+// Default variants never match explicitly; they are only used as fallback.
+func (c *Config) MatchesClientVersion(version string) bool {
+	if c.IsDefaultVariant() || version == "" {
+		return false
+	}
+	return util.VersionInRange(version, c.MinVersion, c.MaxVersion)
+}
+
+// SelectConfigVariants reduces the variants of each alias to the one serving
+// the given client version. A ranged variant containing the version wins;
+// if several do, the one with the highest lower bound, then the lowest upper
+// bound, then the newest row is chosen. Otherwise the default variant is used,
+// and the alias is left out when there is none. Configs without alias are kept
+// as is, and selected configs keep their original order.
+func SelectConfigVariants(configs []Config, clientVersion string) []Config {
+	order := make([]string, 0, len(configs))
+	candidates := make(map[string][]int, len(configs))
+	selected := make(map[int]struct{}, len(configs))
+	for i := range configs {
+		alias := configs[i].Alias
+		if alias == "" {
+			selected[i] = struct{}{}
+			continue
+		}
+		if _, ok := candidates[alias]; !ok {
+			order = append(order, alias)
+		}
+		candidates[alias] = append(candidates[alias], i)
+	}
+
+	for _, alias := range order {
+		var matched []int
+		fallback := -1
+		for _, idx := range candidates[alias] {
+			cfg := &configs[idx]
+			if cfg.IsDefaultVariant() {
+				if fallback < 0 || cfg.ID > configs[fallback].ID {
+					fallback = idx
+				}
+				continue
+			}
+			if cfg.MatchesClientVersion(clientVersion) {
+				matched = append(matched, idx)
+			}
+		}
+		if len(matched) > 0 {
+			sort.SliceStable(matched, func(i, j int) bool {
+				return moreSpecificVariant(&configs[matched[i]], &configs[matched[j]])
+			})
+			selected[matched[0]] = struct{}{}
+		} else if fallback >= 0 {
+			selected[fallback] = struct{}{}
+		}
+	}
+	result := make([]Config, 0, len(selected))
+	for i := range configs {
+		if _, ok := selected[i]; ok {
+			result = append(result, configs[i])
+		}
+	}
+	return result
+}
+
+// FilterScheduledConfigs drops the configs whose schedule window does not contain
+// now, keeping the order of the others.
+func FilterScheduledConfigs(configs []Config, now time.Time) []Config {
+	scheduled := false
+	for i := range configs {
+		if configs[i].IsScheduled() {
+			scheduled = true
+			break
+		}
+	}
+	if !scheduled {
+		return configs
+	}
+	result := make([]Config, 0, len(configs))
+	for i := range configs {
+		if configs[i].InScheduleWindow(now) {
+			result = append(result, configs[i])
+		}
+	}
+	return result
+}
+
+// NextScheduleBoundary returns the earliest activation or expiry time after now,
+// or the zero time when no config changes state in the future.
+func NextScheduleBoundary(configs []Config, now time.Time) time.Time {
+	var next time.Time
+	for i := range configs {
+		for _, bound := range []*time.Time{configs[i].EffectiveAt, configs[i].ExpiresAt} {
+			if bound != nil && bound.After(now) && (next.IsZero() || bound.Before(next)) {
+				next = *bound
+			}
+		}
+	}
+	return next
+}
+
+// MergeBaseConfigs overlays the configs of a pipeline on the base configs of its
+// environment. A pipeline config replaces every base variant of the same alias
+// and is flagged with OverridesBase; base configs that are not overridden are
+// appended after the pipeline configs. Each result carries its Origin.
+func MergeBaseConfigs(base, own []Config) []Config {
+	baseAliases := make(map[string]struct{}, len(base))
+	for i := range base {
+		if base[i].Alias != "" {
+			baseAliases[base[i].Alias] = struct{}{}
+		}
+	}
+	ownAliases := make(map[string]struct{}, len(own))
+	result := make([]Config, 0, len(own)+len(base))
+	for _, cfg := range own {
+		cfg.Origin = ConfigOriginPipeline
+		if _, ok := baseAliases[cfg.Alias]; ok && cfg.Alias != "" {
+			cfg.OverridesBase = true
+		}
+		if cfg.Alias != "" {
+			ownAliases[cfg.Alias] = struct{}{}
+		}
+		result = append(result, cfg)
+	}
+	for _, cfg := range base {
+		if _, ok := ownAliases[cfg.Alias]; ok && cfg.Alias != "" {
+			continue
+		}
+		cfg.Origin = ConfigOriginEnvironment
+		result = append(result, cfg)
+	}
+	return result
+}
+
+func moreSpecificVariant(a, b *Config) bool {
+	if cmp := compareBound(a.MinVersion, b.MinVersion, false); cmp != 0 {
+		return cmp > 0
+	}
+	if cmp := compareBound(a.MaxVersion, b.MaxVersion, true); cmp != 0 {
+		return cmp < 0
+	}
+	return a.ID > b.ID
+}
+
+// compareBound compares two range bounds; an empty bound is -inf for lower
+// bounds and +inf for upper bounds.
+func compareBound(a, b string, upper bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a == "":
+		if upper {
+			return 1
+		}
+		return -1
+	case b == "":
+		if upper {
+			return -1
+		}
+		return 1
+	}
+	cmp, err := util.CompareVersions(a, b)
+	if err != nil {
+		return 0
+	}
+	return cmp
+}
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"time"
+)
+
+// ConfigAliasRedirect keeps a renamed alias served under its old name until
+// ExpiresAt. Runtime responses carry the config under both aliases and mark
+// the entry under OldAlias as deprecated.
+type ConfigAliasRedirect struct {
+	ID             uint      `gorm:"primaryKey" json:"id,omitempty"`
+	CreatedAt      time.Time `json:"created_at,omitempty"`
+	EnvironmentKey string    `gorm:"column:environment_key;uniqueIndex:uk_alias_redirect,priority:1" json:"environment_key,omitempty"`
+	PipelineKey    string    `gorm:"column:pipeline_key;uniqueIndex:uk_alias_redirect,priority:2" json:"pipeline_key,omitempty"`
+	OldAlias       string    `gorm:"column:old_alias;uniqueIndex:uk_alias_redirect,priority:3" json:"old_alias,omitempty"`
+	NewAlias       string    `gorm:"column:new_alias" json:"new_alias,omitempty"`
+	ExpiresAt      time.Time `gorm:"column:expires_at;index:idx_alias_redirect_expires" json:"expires_at,omitempty"`
+	OperatorName   string    `gorm:"column:operator_name" json:"operator_name,omitempty"`
+}
+
+// TableName overrides gorm to use resource_config_alias_redirect table.
+func (ConfigAliasRedirect) TableName() string {
+	return "resource_config_alias_redirect"
+}
@@ -0,0 +1,95 @@
+package model
+
+import (
+	"time"
+)
+
+// Config change request statuses.
+const (
+	// ConfigChangeRequestPending waits for a second user to approve or reject it.
+	ConfigChangeRequestPending = "pending"
+	// ConfigChangeRequestApplying has been claimed by an approver and is being
+	// applied. It returns to pending when the apply fails.
+	ConfigChangeRequestApplying = "applying"
+	// ConfigChangeRequestApproved has been approved and applied.
+	ConfigChangeRequestApproved = "approved"
+	// ConfigChangeRequestRejected was rejected, or withdrawn by its requester.
+	ConfigChangeRequestRejected = "rejected"
+)
+
+// Operations that become change requests in environments requiring approval.
+const (
+	ChangeRequestConfigCreate    = "config.create"
+	ChangeRequestConfigUpdate    = "config.update"
+	ChangeRequestConfigDelete    = "config.delete"
+	ChangeRequestConfigImport    = "config.import"
+	ChangeRequestConfigBatch     = "config.batch"
+	ChangeRequestConfigRename    = "config.rename"
+	ChangeRequestConfigTranslate = "config.translations"
+	ChangeRequestConfigRollback  = "config.rollback"
+	ChangeRequestTransferMigrate = "transfer.migrate"
+	ChangeRequestRolloutCreate   = "rollout.create"
+	ChangeRequestRolloutRamp     = "rollout.ramp"
+	ChangeRequestRolloutPromote  = "rollout.promote"
+	ChangeRequestReleasePublish  = "release.publish"
+	ChangeRequestReleaseActivate = "release.activate"
+)
+
+// ConfigChangeRequest is a write to environments that require approval. It is
+// applied only once a user other than the requester approves it. Payload holds
+// the operation input needed to apply it, e.g. the imported configs.
+type ConfigChangeRequest struct {
+	ID            uint       `gorm:"primaryKey" json:"id,omitempty"`
+	CreatedAt     time.Time  `json:"created_at,omitempty"`
+	UpdatedAt     time.Time  `json:"updated_at,omitempty"`
+	Operation     string     `gorm:"column:operation;type:varchar(64)" json:"operation,omitempty"`
+	Status        string     `gorm:"column:status;type:varchar(16);index:idx_change_request_status" json:"status,omitempty"`
+	Summary       string     `gorm:"column:summary;type:varchar(512)" json:"summary,omitempty"`
+	Payload       string     `gorm:"column:payload;type:text" json:"payload,omitempty"`
+	RequesterID   int        `gorm:"column:requester_id" json:"requester_id,omitempty"`
+	RequesterName string     `gorm:"column:requester_name" json:"requester_name,omitempty"`
+	ReviewerID    int        `gorm:"column:reviewer_id" json:"reviewer_id,omitempty"`
+	ReviewerName  string     `gorm:"column:reviewer_name" json:"reviewer_name,omitempty"`
+	ReviewComment string     `gorm:"column:review_comment;type:varchar(1024)" json:"review_comment,omitempty"`
+	ReviewedAt    *time.Time `gorm:"column:reviewed_at" json:"reviewed_at,omitempty"`
+}
+
+// TableName overrides gorm to use resource_config_change_request table.
+func (ConfigChangeRequest) TableName() string {
+	return "resource_config_change_request"
+}
+
+// ConfigChangeRequestItem is one config written by a change request. Before and
+// After hold JSON snapshots of the config row like ConfigRevision; Before is
+// empty for creates and After for deletes.
+type ConfigChangeRequestItem struct {
+	ID              uint   `gorm:"primaryKey" json:"id,omitempty"`
+	ChangeRequestID uint   `gorm:"column:change_request_id;index:idx_change_request_item_request" json:"change_request_id,omitempty"`
+	EnvironmentKey  string `gorm:"column:environment_key;type:varchar(64);index:idx_change_request_item_env" json:"environment_key,omitempty"`
+	PipelineKey     string `gorm:"column:pipeline_key" json:"pipeline_key,omitempty"`
+	ResourceKey     string `gorm:"column:resource_key" json:"resource_key,omitempty"`
+	Alias           string `gorm:"column:alias" json:"alias,omitempty"`
+	Action          string `gorm:"column:action;type:varchar(32)" json:"action,omitempty"`
+	Before          string `gorm:"column:before_content;type:text" json:"before,omitempty"`
+	After           string `gorm:"column:after_content;type:text" json:"after,omitempty"`
+}
+
+// TableName overrides gorm to use resource_config_change_request_item table.
+func (ConfigChangeRequestItem) TableName() string {
+	return "resource_config_change_request_item"
+}
+
+// ConfigChangeRequestComment is a discussion entry of a change request.
+type ConfigChangeRequestComment struct {
+	ID              uint      `gorm:"primaryKey" json:"id,omitempty"`
+	CreatedAt       time.Time `json:"created_at,omitempty"`
+	ChangeRequestID uint      `gorm:"column:change_request_id;index:idx_change_request_comment_request" json:"change_request_id,omitempty"`
+	AuthorID        int       `gorm:"column:author_id" json:"author_id,omitempty"`
+	AuthorName      string    `gorm:"column:author_name" json:"author_name,omitempty"`
+	Content         string    `gorm:"column:content;type:text" json:"content,omitempty"`
+}
+
+// TableName overrides gorm to use resource_config_change_request_comment table.
+func (ConfigChangeRequestComment) TableName() string {
+	return "resource_config_change_request_comment"
+}
@@ -0,0 +1,16 @@
+package model
+
+// ConfigLabel indexes one label of a config so label selectors can be answered
+// by the database. The labels themselves are stored on Config.Labels; rows are
+// kept in sync by the config DAO.
+type ConfigLabel struct {
+	ID         uint   `gorm:"primaryKey" json:"id,omitempty"`
+	ConfigID   uint   `gorm:"column:config_id;index:idx_config_label_config;index:idx_config_label_kv,priority:3" json:"config_id,omitempty"`
+	LabelKey   string `gorm:"column:label_key;type:varchar(63);index:idx_config_label_kv,priority:1" json:"label_key,omitempty"`
+	LabelValue string `gorm:"column:label_value;type:varchar(128);index:idx_config_label_kv,priority:2" json:"label_value,omitempty"`
+}
+
+// TableName overrides gorm to use resource_config_label table.
+func (ConfigLabel) TableName() string {
+	return "resource_config_label"
+}
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+)
+
+// ConfigRelease is an immutable, numbered snapshot of all configs of an
+// environment/pipeline. Snapshot holds the JSON encoded []Config at publish
+// time. At most one release per environment/pipeline is active and served
+// to runtime clients.
+type ConfigRelease struct {
+	ID             uint       `gorm:"primaryKey" json:"id,omitempty"`
+	CreatedAt      time.Time  `json:"created_at,omitempty"`
+	EnvironmentKey string     `gorm:"column:environment_key;uniqueIndex:uk_release_version,priority:1" json:"environment_key,omitempty"`
+	PipelineKey    string     `gorm:"column:pipeline_key;uniqueIndex:uk_release_version,priority:2" json:"pipeline_key,omitempty"`
+	Version        int        `gorm:"column:version;uniqueIndex:uk_release_version,priority:3" json:"version,omitempty"`
+	Description    string     `gorm:"column:description;type:varchar(512)" json:"description,omitempty"`
+	Snapshot       string     `gorm:"column:snapshot;type:text" json:"snapshot,omitempty"`
+	ConfigCount    int        `gorm:"column:config_count" json:"config_count,omitempty"`
+	IsActive       bool       `gorm:"column:is_active;default:false" json:"is_active,omitempty"`
+	ActivatedAt    *time.Time `gorm:"column:activated_at" json:"activated_at,omitempty"`
+	OperatorID     int        `gorm:"column:operator_id" json:"operator_id,omitempty"`
+	OperatorName   string     `gorm:"column:operator_name" json:"operator_name,omitempty"`
+}
+
+// TableName overrides gorm to use resource_config_release table.
+func (ConfigRelease) TableName() string {
+	return "resource_config_release"
+}
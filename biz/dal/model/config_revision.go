@@ -0,0 +1,44 @@
+package model
+
+import (
+	"time"
+)
+
+// Config revision actions.
+const (
+	ConfigRevisionActionCreate   = "create"
+	ConfigRevisionActionUpdate   = "update"
+	ConfigRevisionActionDelete   = "delete"
+	ConfigRevisionActionImport   = "import"
+	ConfigRevisionActionRollback = "rollback"
+	ConfigRevisionActionPromote  = "promote"
+	ConfigRevisionActionRename   = "rename"
+	// ConfigRevisionActionRollout marks change request items exposing a
+	// rollout candidate; it is never stored as a revision.
+	ConfigRevisionActionRollout = "rollout"
+	// ConfigRevisionActionRelease marks change request items exposing how a
+	// release changes the served configs; it is never stored as a revision.
+	ConfigRevisionActionRelease = "release"
+)
+
+// ConfigRevision records a single change applied to a configuration resource.
+// Before and After hold the full JSON snapshot of the config row; either may be
+// empty when the config did not exist on that side of the change.
+type ConfigRevision struct {
+	ID             uint      `gorm:"primaryKey" json:"id,omitempty"`
+	CreatedAt      time.Time `gorm:"index:idx_revision_created" json:"created_at,omitempty"`
+	EnvironmentKey string    `gorm:"column:environment_key;index:idx_revision_resource,priority:1" json:"environment_key,omitempty"`
+	PipelineKey    string    `gorm:"column:pipeline_key;index:idx_revision_resource,priority:2" json:"pipeline_key,omitempty"`
+	ResourceKey    string    `gorm:"column:resource_key;index:idx_revision_resource,priority:3" json:"resource_key,omitempty"`
+	Alias          string    `gorm:"column:alias" json:"alias,omitempty"`
+	Action         string    `gorm:"column:action;type:varchar(32)" json:"action,omitempty"`
+	Before         string    `gorm:"column:before_content;type:text" json:"before,omitempty"`
+	After          string    `gorm:"column:after_content;type:text" json:"after,omitempty"`
+	OperatorID     int       `gorm:"column:operator_id" json:"operator_id,omitempty"`
+	OperatorName   string    `gorm:"column:operator_name" json:"operator_name,omitempty"`
+}
+
+// TableName overrides gorm to use resource_config_revision table.
+func (ConfigRevision) TableName() string {
+	return "resource_config_revision"
+}
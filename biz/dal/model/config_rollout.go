@@ -0,0 +1,56 @@
+package model
+
+import (
+	"time"
+)
+
+// Config rollout statuses.
+const (
+	// ConfigRolloutStatusRunning serves the candidate to Percentage% of the buckets.
+	ConfigRolloutStatusRunning = "running"
+	// ConfigRolloutStatusPaused serves the baseline to everyone until ramped again.
+	ConfigRolloutStatusPaused = "paused"
+	// ConfigRolloutStatusPromoted has written the candidate into the config. The
+	// candidate keeps being served to everyone until the next release is published.
+	ConfigRolloutStatusPromoted = "promoted"
+	// ConfigRolloutStatusCompleted is a promoted rollout whose value is live everywhere.
+	ConfigRolloutStatusCompleted = "completed"
+	// ConfigRolloutStatusAborted discarded the candidate.
+	ConfigRolloutStatusAborted = "aborted"
+)
+
+// DefaultRolloutBucketHeader is the request header used for bucketing when none is configured.
+const DefaultRolloutBucketHeader = "X-Device-Id"
+
+// ConfigRollout gradually exposes a candidate content of a config to a
+// percentage of clients. Clients are bucketed by hashing BucketSalt with the
+// value of the BucketHeader request header.
+type ConfigRollout struct {
+	ID               uint      `gorm:"primaryKey" json:"id,omitempty"`
+	CreatedAt        time.Time `json:"created_at,omitempty"`
+	UpdatedAt        time.Time `json:"updated_at,omitempty"`
+	EnvironmentKey   string    `gorm:"column:environment_key;index:idx_rollout_env_pipeline,priority:1" json:"environment_key,omitempty"`
+	PipelineKey      string    `gorm:"column:pipeline_key;index:idx_rollout_env_pipeline,priority:2" json:"pipeline_key,omitempty"`
+	ResourceKey      string    `gorm:"column:resource_key;index:idx_rollout_resource" json:"resource_key,omitempty"`
+	Alias            string    `gorm:"column:alias" json:"alias,omitempty"`
+	CandidateContent string    `gorm:"column:candidate_content;type:text" json:"candidate_content,omitempty"`
+	Percentage       int       `gorm:"column:percentage;default:0" json:"percentage,omitempty"`
+	BucketHeader     string    `gorm:"column:bucket_header;type:varchar(128)" json:"bucket_header,omitempty"`
+	BucketSalt       string    `gorm:"column:bucket_salt;type:varchar(128)" json:"bucket_salt,omitempty"`
+	Status           string    `gorm:"column:status;type:varchar(32);index:idx_rollout_status" json:"status,omitempty"`
+	OperatorName     string    `gorm:"column:operator_name" json:"operator_name,omitempty"`
+}
+
+// TableName overrides gorm to use resource_config_rollout table.
+func (ConfigRollout) TableName() string {
+	return "resource_config_rollout"
+}
+
+// IsOpen reports whether the rollout still affects runtime responses.
+func (r *ConfigRollout) IsOpen() bool {
+	switch r.Status {
+	case ConfigRolloutStatusRunning, ConfigRolloutStatusPaused, ConfigRolloutStatusPromoted:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,37 @@
+package model
+
+import (
+	"time"
+)
+
+// ConfigSchema attaches a JSON Schema to the configs of an environment/pipeline
+// whose alias matches AliasPattern. The pattern is an exact alias or a glob
+// such as "banner_*"; schemas of the BasePipelineKey pipeline apply to every
+// pipeline of the environment.
+type ConfigSchema struct {
+	ID             uint      `gorm:"primaryKey" json:"id,omitempty"`
+	CreatedAt      time.Time `json:"created_at,omitempty"`
+	UpdatedAt      time.Time `json:"updated_at,omitempty"`
+	EnvironmentKey string    `gorm:"column:environment_key;uniqueIndex:uk_schema_pattern,priority:1" json:"environment_key,omitempty"`
+	PipelineKey    string    `gorm:"column:pipeline_key;uniqueIndex:uk_schema_pattern,priority:2" json:"pipeline_key,omitempty"`
+	AliasPattern   string    `gorm:"column:alias_pattern;uniqueIndex:uk_schema_pattern,priority:3" json:"alias_pattern,omitempty"`
+	Schema         string    `gorm:"column:schema_content;type:text" json:"schema,omitempty"`
+	Description    string    `gorm:"column:description;type:varchar(512)" json:"description,omitempty"`
+	OperatorName   string    `gorm:"column:operator_name" json:"operator_name,omitempty"`
+}
+
+// TableName overrides gorm to use resource_config_schema table.
+func (ConfigSchema) TableName() string {
+	return "resource_config_schema"
+}
+
+// IsExactAlias reports whether the pattern names a single alias.
+func (s *ConfigSchema) IsExactAlias() bool {
+	for _, r := range s.AliasPattern {
+		switch r {
+		case '*', '?', '[', '\\':
+			return false
+		}
+	}
+	return true
+}
@@ -17,6 +17,9 @@ type Environment struct {
 	Description     string         `gorm:"column:description;type:varchar(512)" json:"description,omitempty"`
 	SortOrder       int            `gorm:"column:sort_order;default:0;index:idx_env_active_sort,priority:2" json:"sort_order,omitempty"`
 	IsActive        bool           `gorm:"column:is_active;default:true;index:idx_env_active_sort,priority:1" json:"is_active,omitempty"`
+	// RequireApproval turns config writes to the environment into change
+	// requests that another user has to approve.
+	RequireApproval bool `gorm:"column:require_approval;default:false" json:"require_approval,omitempty"`
 }
 
 // TableName overrides gorm to use environment table.
@@ -0,0 +1,130 @@
+package model
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yi-nology/rainbow_bridge/pkg/util"
+)
+
+// Environment freeze recurrences.
+const (
+	// EnvironmentFreezeOnce freezes the environment between StartsAt and EndsAt.
+	EnvironmentFreezeOnce = "once"
+	// EnvironmentFreezeWeekly freezes the environment every week between
+	// WeeklyStart and WeeklyEnd, e.g. "fri 18:00" to "mon 08:00".
+	EnvironmentFreezeWeekly = "weekly"
+)
+
+// EnvironmentFreeze is a change freeze window of an environment. While a window
+// is active, writes to the environment are rejected unless they come from one
+// of AllowedUsers and carry an override reason.
+type EnvironmentFreeze struct {
+	ID             uint       `gorm:"primaryKey" json:"id,omitempty"`
+	CreatedAt      time.Time  `json:"created_at,omitempty"`
+	UpdatedAt      time.Time  `json:"updated_at,omitempty"`
+	EnvironmentKey string     `gorm:"column:environment_key;type:varchar(64);index:idx_env_freeze_env" json:"environment_key,omitempty"`
+	Name           string     `gorm:"column:name;type:varchar(128)" json:"name,omitempty"`
+	Reason         string     `gorm:"column:reason;type:varchar(512)" json:"reason,omitempty"`
+	Recurrence     string     `gorm:"column:recurrence;type:varchar(16)" json:"recurrence,omitempty"`
+	StartsAt       *time.Time `gorm:"column:starts_at" json:"starts_at,omitempty"`
+	EndsAt         *time.Time `gorm:"column:ends_at" json:"ends_at,omitempty"`
+	WeeklyStart    string     `gorm:"column:weekly_start;type:varchar(32)" json:"weekly_start,omitempty"`
+	WeeklyEnd      string     `gorm:"column:weekly_end;type:varchar(32)" json:"weekly_end,omitempty"`
+	Timezone       string     `gorm:"column:timezone;type:varchar(64)" json:"timezone,omitempty"`
+	// AllowedUsers is a comma separated list of usernames or user IDs that may
+	// override the freeze.
+	AllowedUsers string `gorm:"column:allowed_users;type:text" json:"allowed_users,omitempty"`
+	CreatedBy    string `gorm:"column:created_by" json:"created_by,omitempty"`
+}
+
+// TableName overrides gorm to use environment_freeze table.
+func (EnvironmentFreeze) TableName() string {
+	return "environment_freeze"
+}
+
+// ActiveWindow reports whether the freeze covers t and, if so, the bounds of
+// the window that does. Weekly windows may wrap around the end of the week.
+func (f *EnvironmentFreeze) ActiveWindow(t time.Time) (start, end time.Time, ok bool) {
+	if f.Recurrence != EnvironmentFreezeWeekly {
+		if f.StartsAt == nil || f.EndsAt == nil || t.Before(*f.StartsAt) || !t.Before(*f.EndsAt) {
+			return time.Time{}, time.Time{}, false
+		}
+		return *f.StartsAt, *f.EndsAt, true
+	}
+
+	startMin, err := util.ParseWeeklyTime(f.WeeklyStart)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	endMin, err := util.ParseWeeklyTime(f.WeeklyEnd)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	loc, err := util.LoadScheduleLocation(f.Timezone)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	if endMin <= startMin {
+		endMin += util.MinutesPerWeek
+	}
+
+	local := t.In(loc)
+	sunday := local.Day() - int(local.Weekday())
+	at := func(week, minutes int) time.Time {
+		// time.Date normalises the overflowing day and keeps wall-clock times across DST changes
+		return time.Date(local.Year(), local.Month(), sunday+week*7+minutes/(24*60), minutes/60%24, minutes%60, 0, 0, loc)
+	}
+	// 跨周的窗口可能始于上一周
+	for _, week := range []int{0, -1} {
+		start, end = at(week, startMin), at(week, endMin)
+		if !t.Before(start) && t.Before(end) {
+			return start, end, true
+		}
+	}
+	return time.Time{}, time.Time{}, false
+}
+
+// AllowedUserList returns the entries of AllowedUsers.
+func (f *EnvironmentFreeze) AllowedUserList() []string {
+	var users []string
+	for _, user := range strings.Split(f.AllowedUsers, ",") {
+		if user = strings.TrimSpace(user); user != "" {
+			users = append(users, user)
+		}
+	}
+	return users
+}
+
+// AllowsUser reports whether the user identified by userID (when known) or
+// username may override the freeze.
+func (f *EnvironmentFreeze) AllowsUser(userID int, hasUserID bool, username string) bool {
+	for _, user := range f.AllowedUserList() {
+		if username != "" && user == username {
+			return true
+		}
+		if hasUserID && user == strconv.Itoa(userID) {
+			return true
+		}
+	}
+	return false
+}
+
+// EnvironmentFreezeOverride records a write that was let through an active
+// freeze window, together with the reason given for it.
+type EnvironmentFreezeOverride struct {
+	ID             uint      `gorm:"primaryKey" json:"id,omitempty"`
+	CreatedAt      time.Time `gorm:"index:idx_env_freeze_override_env,priority:2" json:"created_at,omitempty"`
+	FreezeID       uint      `gorm:"column:freeze_id;index:idx_env_freeze_override_freeze" json:"freeze_id,omitempty"`
+	EnvironmentKey string    `gorm:"column:environment_key;type:varchar(64);index:idx_env_freeze_override_env,priority:1" json:"environment_key,omitempty"`
+	Operation      string    `gorm:"column:operation;type:varchar(64)" json:"operation,omitempty"`
+	Reason         string    `gorm:"column:reason;type:varchar(512)" json:"reason,omitempty"`
+	OperatorID     int       `gorm:"column:operator_id" json:"operator_id,omitempty"`
+	OperatorName   string    `gorm:"column:operator_name" json:"operator_name,omitempty"`
+}
+
+// TableName overrides gorm to use environment_freeze_override table.
+func (EnvironmentFreezeOverride) TableName() string {
+	return "environment_freeze_override"
+}
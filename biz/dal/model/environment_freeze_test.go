@@ -0,0 +1,60 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEnvironmentFreezeActiveWindow(t *testing.T) {
+	shanghai, _ := time.LoadLocation("Asia/Shanghai")
+	weekend := &EnvironmentFreeze{
+		Recurrence:  EnvironmentFreezeWeekly,
+		WeeklyStart: "fri 18:00",
+		WeeklyEnd:   "mon 08:00",
+		Timezone:    "Asia/Shanghai",
+	}
+	// 2026-10-16 is a Friday
+	cases := []struct {
+		at     time.Time
+		active bool
+		end    string
+	}{
+		{time.Date(2026, 10, 16, 17, 59, 0, 0, shanghai), false, ""},
+		{time.Date(2026, 10, 16, 18, 0, 0, 0, shanghai), true, "2026-10-19T08:00:00+08:00"},
+		{time.Date(2026, 10, 18, 12, 0, 0, 0, shanghai), true, "2026-10-19T08:00:00+08:00"},
+		{time.Date(2026, 10, 19, 7, 59, 0, 0, shanghai), true, "2026-10-19T08:00:00+08:00"},
+		{time.Date(2026, 10, 19, 8, 0, 0, 0, shanghai), false, ""},
+		{time.Date(2026, 10, 14, 12, 0, 0, 0, shanghai), false, ""},
+		// 上海的周五 18:00 是 UTC 的周五 10:00
+		{time.Date(2026, 10, 16, 10, 30, 0, 0, time.UTC), true, "2026-10-19T08:00:00+08:00"},
+	}
+	for _, tc := range cases {
+		_, end, ok := weekend.ActiveWindow(tc.at)
+		if ok != tc.active {
+			t.Fatalf("ActiveWindow(%v) active = %v, want %v", tc.at, ok, tc.active)
+		}
+		if ok && end.Format(time.RFC3339) != tc.end {
+			t.Fatalf("ActiveWindow(%v) end = %v, want %s", tc.at, end, tc.end)
+		}
+	}
+
+	start := time.Date(2026, 11, 10, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	once := &EnvironmentFreeze{Recurrence: EnvironmentFreezeOnce, StartsAt: &start, EndsAt: &end}
+	if _, _, ok := once.ActiveWindow(start); !ok {
+		t.Fatal("expected once freeze to be active at its start")
+	}
+	if _, _, ok := once.ActiveWindow(end); ok {
+		t.Fatal("expected once freeze to be inactive at its end")
+	}
+}
+
+func TestEnvironmentFreezeAllowsUser(t *testing.T) {
+	freeze := &EnvironmentFreeze{AllowedUsers: " alice, 42 ,,"}
+	if !freeze.AllowsUser(0, false, "alice") || !freeze.AllowsUser(42, true, "") {
+		t.Fatal("expected alice and user 42 to be allowed")
+	}
+	if freeze.AllowsUser(7, true, "bob") || freeze.AllowsUser(0, false, "") {
+		t.Fatal("expected other users to be rejected")
+	}
+}
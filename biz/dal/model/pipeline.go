@@ -18,6 +18,10 @@ type Pipeline struct {
 	Description    string         `gorm:"column:description;type:varchar(512)" json:"description,omitempty"`
 	SortOrder      int            `gorm:"column:sort_order;default:0;index:idx_pipeline_active_sort,priority:2" json:"sort_order,omitempty"`
 	IsActive       bool           `gorm:"column:is_active;default:true;index:idx_pipeline_active_sort,priority:1" json:"is_active,omitempty"`
+	// DefaultLocale is the locale of config content; Locales lists, comma separated,
+	// the further locales configs are translated into.
+	DefaultLocale string `gorm:"column:default_locale;type:varchar(35)" json:"default_locale,omitempty"`
+	Locales       string `gorm:"column:locales;type:varchar(512)" json:"locales,omitempty"`
 }
 
 // TableName overrides gorm to use pipeline table.
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"strings"
+	"time"
+)
+
+// PipelineAPIKey authenticates runtime clients of a pipeline. Clients present
+// the token "rbk_<KeyID>_<secret>"; only a bcrypt hash of the secret is kept.
+// After a rotation the previous secret stays valid until PreviousExpiresAt.
+type PipelineAPIKey struct {
+	ID             uint      `gorm:"primaryKey" json:"id,omitempty"`
+	CreatedAt      time.Time `json:"created_at,omitempty"`
+	UpdatedAt      time.Time `json:"updated_at,omitempty"`
+	EnvironmentKey string    `gorm:"column:environment_key;type:varchar(64);index:idx_pipeline_api_key_scope,priority:1" json:"environment_key,omitempty"`
+	PipelineKey    string    `gorm:"column:pipeline_key;type:varchar(64);index:idx_pipeline_api_key_scope,priority:2" json:"pipeline_key,omitempty"`
+	Name           string    `gorm:"column:name;type:varchar(128)" json:"name,omitempty"`
+	KeyID          string    `gorm:"column:key_id;type:varchar(32);uniqueIndex:uk_pipeline_api_key_id" json:"key_id,omitempty"`
+	SecretHash     string    `gorm:"column:secret_hash;type:varchar(255)" json:"-"`
+	// Scopes is a comma separated list of the scopes granted to the key.
+	Scopes             string     `gorm:"column:scopes;type:varchar(255)" json:"scopes,omitempty"`
+	ExpiresAt          *time.Time `gorm:"column:expires_at" json:"expires_at,omitempty"`
+	RevokedAt          *time.Time `gorm:"column:revoked_at" json:"revoked_at,omitempty"`
+	RotatedAt          *time.Time `gorm:"column:rotated_at" json:"rotated_at,omitempty"`
+	PreviousSecretHash string     `gorm:"column:previous_secret_hash;type:varchar(255)" json:"-"`
+	PreviousExpiresAt  *time.Time `gorm:"column:previous_expires_at" json:"previous_expires_at,omitempty"`
+	CreatedBy          string     `gorm:"column:created_by" json:"created_by,omitempty"`
+}
+
+// TableName overrides gorm to use pipeline_api_key table.
+func (PipelineAPIKey) TableName() string {
+	return "pipeline_api_key"
+}
+
+// ScopeList returns the entries of Scopes.
+func (k *PipelineAPIKey) ScopeList() []string {
+	var scopes []string
+	for _, scope := range strings.Split(k.Scopes, ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes
+}
+
+// ActiveAt reports whether the key is neither revoked nor expired at t.
+func (k *PipelineAPIKey) ActiveAt(t time.Time) bool {
+	if k.RevokedAt != nil {
+		return false
+	}
+	return k.ExpiresAt == nil || t.Before(*k.ExpiresAt)
+}
+
+// PreviousSecretActiveAt reports whether the secret replaced by the last
+// rotation is still accepted at t.
+func (k *PipelineAPIKey) PreviousSecretActiveAt(t time.Time) bool {
+	return k.PreviousSecretHash != "" && k.PreviousExpiresAt != nil && t.Before(*k.PreviousExpiresAt)
+}
@@ -0,0 +1,39 @@
+package model
+
+import (
+	"time"
+)
+
+// RuntimeChange counts the changes that may alter what runtime clients of an
+// environment/pipeline are served. The row of BasePipelineKey counts changes
+// to the environment base configs and the environment itself, which affect
+// every pipeline of the environment.
+type RuntimeChange struct {
+	ID             uint      `gorm:"primaryKey" json:"id,omitempty"`
+	EnvironmentKey string    `gorm:"column:environment_key;uniqueIndex:uk_runtime_change,priority:1" json:"environment_key,omitempty"`
+	PipelineKey    string    `gorm:"column:pipeline_key;uniqueIndex:uk_runtime_change,priority:2" json:"pipeline_key,omitempty"`
+	Counter        int64     `gorm:"column:counter;not null;default:0" json:"counter"`
+	ChangedAt      time.Time `gorm:"column:changed_at" json:"changed_at,omitempty"`
+}
+
+// TableName overrides gorm to use runtime_change table.
+func (RuntimeChange) TableName() string {
+	return "runtime_change"
+}
+
+// RuntimeChangeLog records a change that may alter the runtime configs of an
+// environment/pipeline. Its ID is the revision runtime clients watch, handed
+// out in commit order by ChangeSequence; Alias is empty when the change may
+// affect every config.
+type RuntimeChangeLog struct {
+	ID             uint      `gorm:"primaryKey" json:"id,omitempty"`
+	EnvironmentKey string    `gorm:"column:environment_key;index:idx_runtime_change_log_scope,priority:1" json:"environment_key,omitempty"`
+	PipelineKey    string    `gorm:"column:pipeline_key;index:idx_runtime_change_log_scope,priority:2" json:"pipeline_key,omitempty"`
+	Alias          string    `gorm:"column:alias" json:"alias,omitempty"`
+	CreatedAt      time.Time `gorm:"column:created_at" json:"created_at,omitempty"`
+}
+
+// TableName overrides gorm to use runtime_change_log table.
+func (RuntimeChangeLog) TableName() string {
+	return "runtime_change_log"
+}
@@ -168,8 +168,12 @@ func Upload(ctx context.Context, c *app.RequestContext) {
 
 	assetItem, reference, err := svc.UploadAsset(handler.EnrichContext(ctx, c), input)
 	if err != nil {
+		status := consts.StatusInternalServerError
+		if errors.Is(err, service.ErrEnvironmentFrozen) {
+			status = consts.StatusLocked
+		}
 		c.JSON(consts.StatusOK, &asset.UploadAssetResponse{
-			Code:  consts.StatusInternalServerError,
+			Code:  int32(status),
 			Msg:   "error",
 			Error: err.Error(),
 		})
@@ -251,3 +255,90 @@ func GetFile(ctx context.Context, c *app.RequestContext) {
 	}
 	c.Data(consts.StatusOK, contentType, content)
 }
+
+// CheckReferences .
+// @router /api/v1/asset/references/check [GET]
+func CheckReferences(ctx context.Context, c *app.RequestContext) {
+	var req asset.CheckAssetReferencesRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &asset.AssetReferenceCheckResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	data, err := svc.CheckAssetReferences(handler.EnrichContext(ctx, c), req.GetEnvironmentKey(), req.GetPipelineKey())
+	if err != nil {
+		c.JSON(consts.StatusOK, &asset.AssetReferenceCheckResponse{
+			Code:  assetErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+	c.JSON(consts.StatusOK, &asset.AssetReferenceCheckResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: data,
+	})
+}
+
+// RepairReference .
+// @router /api/v1/asset/references/repair [POST]
+func RepairReference(ctx context.Context, c *app.RequestContext) {
+	var req asset.RepairAssetReferenceRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &asset.AssetReferenceRepairResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	cfg, assetItem, err := svc.RepairAssetReference(handler.EnrichContext(ctx, c), &req)
+	if err != nil {
+		if id, ok := handler.ChangeRequestPending(err); ok {
+			c.JSON(consts.StatusOK, &asset.AssetReferenceRepairResponse{
+				Code:            consts.StatusAccepted,
+				Msg:             "Accepted",
+				Error:           err.Error(),
+				ChangeRequestId: id,
+			})
+			return
+		}
+		c.JSON(consts.StatusOK, &asset.AssetReferenceRepairResponse{
+			Code:  assetErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+	c.JSON(consts.StatusOK, &asset.AssetReferenceRepairResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: &asset.AssetReferenceRepairData{
+			Config: cfg,
+			Asset:  assetItem,
+		},
+	})
+}
+
+func assetErrorStatus(err error) int32 {
+	switch {
+	case errors.Is(err, service.ErrAssetNotFound),
+		errors.Is(err, service.ErrResourceNotFound),
+		errors.Is(err, service.ErrAssetReferenceNotFound):
+		return consts.StatusNotFound
+	case errors.Is(err, service.ErrAssetRepairInvalid):
+		return consts.StatusBadRequest
+	case errors.Is(err, service.ErrConfigRevisionConflict):
+		return consts.StatusConflict
+	case errors.Is(err, service.ErrEnvironmentFrozen):
+		return consts.StatusLocked
+	default:
+		return consts.StatusInternalServerError
+	}
+}
@@ -0,0 +1,182 @@
+// Code generated by hertz generator.
+
+package changerequest
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/yi-nology/rainbow_bridge/biz/handler"
+	changerequest "github.com/yi-nology/rainbow_bridge/biz/model/changerequest"
+	"github.com/yi-nology/rainbow_bridge/biz/service"
+)
+
+var svc *service.Service
+
+func SetService(s *service.Service) {
+	svc = s
+}
+
+// List .
+// @router /api/v1/change-request/list [GET]
+func List(ctx context.Context, c *app.RequestContext) {
+	var req changerequest.ListChangeRequestRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &changerequest.ChangeRequestListResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	list, total, err := svc.ListChangeRequests(handler.EnrichContext(ctx, c), req.EnvironmentKey, req.Status, int(req.Page), int(req.PageSize))
+	if err != nil {
+		c.JSON(consts.StatusOK, &changerequest.ChangeRequestListResponse{
+			Code:  changeRequestErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &changerequest.ChangeRequestListResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: &changerequest.ChangeRequestListData{
+			Total: int32(total), // #nosec G115 -- count will not exceed int32
+			List:  list,
+		},
+	})
+}
+
+// Detail .
+// @router /api/v1/change-request/detail [GET]
+func Detail(ctx context.Context, c *app.RequestContext) {
+	var req changerequest.ChangeRequestDetailRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &changerequest.ChangeRequestResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	item, err := svc.GetChangeRequest(handler.EnrichContext(ctx, c), req.Id)
+	respondChangeRequest(c, item, err)
+}
+
+// Approve .
+// @router /api/v1/change-request/approve [POST]
+func Approve(ctx context.Context, c *app.RequestContext) {
+	var req changerequest.ReviewChangeRequestRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &changerequest.ChangeRequestResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	item, err := svc.ApproveChangeRequest(handler.EnrichContext(ctx, c), req.Id, req.Comment)
+	respondChangeRequest(c, item, err)
+}
+
+// Reject .
+// @router /api/v1/change-request/reject [POST]
+func Reject(ctx context.Context, c *app.RequestContext) {
+	var req changerequest.ReviewChangeRequestRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &changerequest.ChangeRequestResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	item, err := svc.RejectChangeRequest(handler.EnrichContext(ctx, c), req.Id, req.Comment)
+	respondChangeRequest(c, item, err)
+}
+
+// Comment .
+// @router /api/v1/change-request/comment [POST]
+func Comment(ctx context.Context, c *app.RequestContext) {
+	var req changerequest.CommentChangeRequestRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &changerequest.ChangeRequestCommentResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	comment, err := svc.CommentChangeRequest(handler.EnrichContext(ctx, c), req.Id, req.Content)
+	if err != nil {
+		c.JSON(consts.StatusOK, &changerequest.ChangeRequestCommentResponse{
+			Code:  changeRequestErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &changerequest.ChangeRequestCommentResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: comment,
+	})
+}
+
+func respondChangeRequest(c *app.RequestContext, item *changerequest.ChangeRequest, err error) {
+	if err != nil {
+		c.JSON(consts.StatusOK, &changerequest.ChangeRequestResponse{
+			Code:  changeRequestErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+	c.JSON(consts.StatusOK, &changerequest.ChangeRequestResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: item,
+	})
+}
+
+func changeRequestErrorStatus(err error) int32 {
+	var schemaErr *service.SchemaValidationError
+	switch {
+	case errors.Is(err, service.ErrChangeRequestNotFound):
+		return consts.StatusNotFound
+	case errors.Is(err, service.ErrChangeRequestAnonymous):
+		return consts.StatusUnauthorized
+	case errors.Is(err, service.ErrChangeRequestSelfApproval):
+		return consts.StatusForbidden
+	case errors.Is(err, service.ErrChangeRequestStale):
+		return consts.StatusConflict
+	case errors.Is(err, service.ErrEnvironmentFrozen):
+		return consts.StatusLocked
+	case errors.Is(err, service.ErrChangeRequestInvalid),
+		errors.Is(err, service.ErrChangeRequestNotPending),
+		errors.Is(err, service.ErrConfigAliasExists),
+		errors.Is(err, service.ErrConfigAliasRenameInvalid),
+		errors.Is(err, service.ErrConfigVersionRangeOverlap),
+		errors.Is(err, service.ErrConfigLabelsInvalid),
+		errors.Is(err, service.ErrConfigTranslationsInvalid),
+		errors.Is(err, service.ErrConfigScheduleInvalid),
+		errors.Is(err, service.ErrConfigReferenced),
+		errors.Is(err, service.ErrConfigReferenceDangling),
+		errors.Is(err, service.ErrConfigReferenceCycle),
+		errors.Is(err, service.ErrConfigReferenceSecret),
+		errors.As(err, &schemaErr):
+		return consts.StatusBadRequest
+	default:
+		return consts.StatusInternalServerError
+	}
+}
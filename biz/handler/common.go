@@ -2,16 +2,19 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"sort"
-	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
 	"github.com/yi-nology/rainbow_bridge/biz/model/common"
 	"github.com/yi-nology/rainbow_bridge/biz/model/transfer"
+	"github.com/yi-nology/rainbow_bridge/biz/service"
 	pkgcommon "github.com/yi-nology/rainbow_bridge/pkg/common"
+	"github.com/yi-nology/rainbow_bridge/pkg/util"
 )
 
 // Upload constraints
@@ -85,12 +88,8 @@ var AllowedMimeTypes = map[string]bool{
 }
 
 // EnrichContext mirrors the logic used in protobuf handlers to propagate headers.
+// The user identity is set by middleware.Auth.
 func EnrichContext(ctx context.Context, c *app.RequestContext) context.Context {
-	if userHeader := c.GetHeader("X-User-Id"); len(userHeader) > 0 {
-		if id, err := strconv.Atoi(string(userHeader)); err == nil {
-			ctx = pkgcommon.ContextWithUserID(ctx, id)
-		}
-	}
 	clientVersion := string(c.GetHeader("X-Client-Version"))
 	if clientVersion == "" {
 		clientVersion = c.Query("client_version")
@@ -98,7 +97,19 @@ func EnrichContext(ctx context.Context, c *app.RequestContext) context.Context {
 	if clientVersion != "" {
 		ctx = pkgcommon.ContextWithClientVersion(ctx, clientVersion)
 	}
-	return ctx
+	// locale 参数优先于 Accept-Language，两者均可按优先级列出多个语言
+	locales := c.Query("locale")
+	if locales == "" {
+		locales = string(c.GetHeader("Accept-Language"))
+	}
+	if preferred := util.ParseAcceptLanguage(locales); len(preferred) > 0 {
+		ctx = pkgcommon.ContextWithLocales(ctx, preferred)
+	}
+	headers := make(map[string]string)
+	c.Request.Header.VisitAll(func(key, value []byte) {
+		headers[string(key)] = string(value)
+	})
+	return pkgcommon.ContextWithRequestHeaders(ctx, headers)
 }
 
 func WriteBadRequest(c *app.RequestContext, err error) {
@@ -171,6 +182,36 @@ func RespondError(c *app.RequestContext, status int, err error) {
 	})
 }
 
+// NotModified reports whether a conditional GET can be answered with 304 for
+// the response identified by etag and lastModified. If-None-Match takes
+// precedence over If-Modified-Since.
+func NotModified(c *app.RequestContext, etag string, lastModified time.Time) bool {
+	if raw := strings.TrimSpace(string(c.GetHeader("If-None-Match"))); raw != "" {
+		for _, tag := range strings.Split(raw, ",") {
+			tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+			if tag == "*" || tag == etag {
+				return true
+			}
+		}
+		return false
+	}
+	if lastModified.IsZero() {
+		return false
+	}
+	return !c.IfModifiedSince(lastModified)
+}
+
+// ChangeRequestPending reports whether err is a write that was queued as a
+// change request for approval, and returns the ID of the request. Such writes
+// are answered with code 202.
+func ChangeRequestPending(err error) (int64, bool) {
+	var pending *service.ChangeRequestPendingError
+	if !errors.As(err, &pending) {
+		return 0, false
+	}
+	return int64(pending.Request.ID), true
+}
+
 // --------------------- Utility functions ---------------------
 
 func ParseBusinessKeys(raw string) []string {
@@ -5,7 +5,9 @@ package config
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
@@ -35,14 +37,27 @@ func Create(ctx context.Context, c *app.RequestContext) {
 	}
 	cfg, err := svc.AddConfig(handler.EnrichContext(ctx, c), req.GetConfig())
 	if err != nil {
+		if id, ok := handler.ChangeRequestPending(err); ok {
+			c.JSON(consts.StatusOK, &config.ConfigResponse{
+				Code:            consts.StatusAccepted,
+				Msg:             "Accepted",
+				Error:           err.Error(),
+				ChangeRequestId: id,
+			})
+			return
+		}
 		status := consts.StatusInternalServerError
-		if errors.Is(err, service.ErrConfigAliasExists) {
+		violations := schemaViolations(err)
+		if errors.Is(err, service.ErrConfigAliasExists) || errors.Is(err, service.ErrConfigVersionRangeOverlap) || errors.Is(err, service.ErrConfigLabelsInvalid) || errors.Is(err, service.ErrConfigScheduleInvalid) || isReferenceError(err) || violations != nil {
 			status = consts.StatusBadRequest
+		} else if errors.Is(err, service.ErrEnvironmentFrozen) {
+			status = consts.StatusLocked
 		}
 		c.JSON(consts.StatusOK, &config.ConfigResponse{
-			Code:  int32(status),
-			Msg:   "error",
-			Error: err.Error(),
+			Code:       int32(status),
+			Msg:        "error",
+			Error:      err.Error(),
+			Violations: violations,
 		})
 		return
 	}
@@ -73,16 +88,51 @@ func Update(ctx context.Context, c *app.RequestContext) {
 		})
 		return
 	}
-	cfg, err := svc.UpdateConfig(handler.EnrichContext(ctx, c), req.GetConfig())
+	expected, err := expectedRevision(c, req.GetExpectedRevision())
 	if err != nil {
+		c.JSON(consts.StatusOK, &config.ConfigResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+	ctx = handler.EnrichContext(ctx, c)
+	cfg, err := svc.UpdateConfig(ctx, req.GetConfig(), expected)
+	if err != nil {
+		if current := svc.RevisionConflictConfig(ctx, err); current != nil {
+			c.JSON(consts.StatusOK, &config.ConfigResponse{
+				Code:    consts.StatusConflict,
+				Msg:     "error",
+				Error:   err.Error(),
+				Current: current,
+			})
+			return
+		}
+		if id, ok := handler.ChangeRequestPending(err); ok {
+			c.JSON(consts.StatusOK, &config.ConfigResponse{
+				Code:            consts.StatusAccepted,
+				Msg:             "Accepted",
+				Error:           err.Error(),
+				ChangeRequestId: id,
+			})
+			return
+		}
 		status := consts.StatusInternalServerError
-		if errors.Is(err, service.ErrResourceNotFound) {
+		violations := schemaViolations(err)
+		switch {
+		case errors.Is(err, service.ErrResourceNotFound):
 			status = consts.StatusNotFound
+		case errors.Is(err, service.ErrConfigAliasExists), errors.Is(err, service.ErrConfigVersionRangeOverlap), errors.Is(err, service.ErrConfigLabelsInvalid), errors.Is(err, service.ErrConfigScheduleInvalid), isReferenceError(err), violations != nil:
+			status = consts.StatusBadRequest
+		case errors.Is(err, service.ErrEnvironmentFrozen):
+			status = consts.StatusLocked
 		}
 		c.JSON(consts.StatusOK, &config.ConfigResponse{
-			Code:  int32(status),
-			Msg:   "error",
-			Error: err.Error(),
+			Code:       int32(status),
+			Msg:        "error",
+			Error:      err.Error(),
+			Violations: violations,
 		})
 		return
 	}
@@ -113,10 +163,43 @@ func Delete(ctx context.Context, c *app.RequestContext) {
 		})
 		return
 	}
-	if err := svc.DeleteConfig(handler.EnrichContext(ctx, c), req.GetEnvironmentKey(), req.GetPipelineKey(), req.GetResourceKey()); err != nil {
+	expected, err := expectedRevision(c, req.GetExpectedRevision())
+	if err != nil {
+		c.JSON(consts.StatusOK, &config.DeleteConfigResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+	ctx = handler.EnrichContext(ctx, c)
+	if err := svc.DeleteConfig(ctx, req.GetEnvironmentKey(), req.GetPipelineKey(), req.GetResourceKey(), expected); err != nil {
+		if current := svc.RevisionConflictConfig(ctx, err); current != nil {
+			c.JSON(consts.StatusOK, &config.DeleteConfigResponse{
+				Code:    consts.StatusConflict,
+				Msg:     "error",
+				Error:   err.Error(),
+				Current: current,
+			})
+			return
+		}
+		if id, ok := handler.ChangeRequestPending(err); ok {
+			c.JSON(consts.StatusOK, &config.DeleteConfigResponse{
+				Code:            consts.StatusAccepted,
+				Msg:             "Accepted",
+				Error:           err.Error(),
+				ChangeRequestId: id,
+			})
+			return
+		}
 		status := consts.StatusInternalServerError
-		if errors.Is(err, service.ErrResourceNotFound) {
+		switch {
+		case errors.Is(err, service.ErrResourceNotFound):
 			status = consts.StatusNotFound
+		case errors.Is(err, service.ErrConfigReferenced):
+			status = consts.StatusBadRequest
+		case errors.Is(err, service.ErrEnvironmentFrozen):
+			status = consts.StatusLocked
 		}
 		c.JSON(consts.StatusOK, &config.DeleteConfigResponse{
 			Code:  int32(status),
@@ -162,7 +245,7 @@ func List(ctx context.Context, c *app.RequestContext) {
 		req.IsLatest = parsed
 	}
 
-	list, err := svc.ListConfigs(handler.EnrichContext(ctx, c), req.GetEnvironmentKey(), req.GetPipelineKey(), req.GetType(), req.GetMinVersion(), req.GetMaxVersion(), req.GetIsLatest())
+	list, err := svc.ListConfigs(handler.EnrichContext(ctx, c), req.GetEnvironmentKey(), req.GetPipelineKey(), req.GetType(), req.GetMinVersion(), req.GetMaxVersion(), req.GetIsLatest(), req.GetIncludeInherited())
 	if err != nil {
 		c.JSON(consts.StatusOK, &config.ConfigListResponse{
 			Code:  consts.StatusInternalServerError,
@@ -214,9 +297,582 @@ func Detail(ctx context.Context, c *app.RequestContext) {
 		})
 		return
 	}
+	if cfg.GetRevision() > 0 {
+		c.Header("ETag", strconv.Quote(strconv.FormatInt(cfg.GetRevision(), 10)))
+	}
 	c.JSON(consts.StatusOK, &config.ConfigDetailResponse{
 		Code: consts.StatusOK,
 		Msg:  "OK",
 		Data: &config.ConfigData{Config: cfg},
 	})
 }
+
+// History .
+// @router /api/v1/config/history [GET]
+func History(ctx context.Context, c *app.RequestContext) {
+	var req config.ConfigHistoryRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &config.ConfigHistoryResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+	if req.EnvironmentKey == "" || req.PipelineKey == "" || req.ResourceKey == "" {
+		c.JSON(consts.StatusOK, &config.ConfigHistoryResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: "environment_key, pipeline_key and resource_key are required",
+		})
+		return
+	}
+
+	list, total, err := svc.ListConfigHistory(handler.EnrichContext(ctx, c), req.GetEnvironmentKey(), req.GetPipelineKey(), req.GetResourceKey(), int(req.GetPage()), int(req.GetPageSize()))
+	if err != nil {
+		c.JSON(consts.StatusOK, &config.ConfigHistoryResponse{
+			Code:  consts.StatusInternalServerError,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+	c.JSON(consts.StatusOK, &config.ConfigHistoryResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: &config.ConfigHistoryData{
+			Total: int32(total), // #nosec G115 -- count will not exceed int32
+			List:  list,
+		},
+	})
+}
+
+// Rollback .
+// @router /api/v1/config/rollback [POST]
+func Rollback(ctx context.Context, c *app.RequestContext) {
+	req := &config.RollbackConfigRequest{}
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusOK, &config.ConfigResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+	if req.RevisionId <= 0 {
+		c.JSON(consts.StatusOK, &config.ConfigResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: "revision_id is required",
+		})
+		return
+	}
+
+	cfg, err := svc.RollbackConfig(handler.EnrichContext(ctx, c), req.GetRevisionId())
+	if err != nil {
+		if id, ok := handler.ChangeRequestPending(err); ok {
+			c.JSON(consts.StatusOK, &config.ConfigResponse{
+				Code:            consts.StatusAccepted,
+				Msg:             "Accepted",
+				Error:           err.Error(),
+				ChangeRequestId: id,
+			})
+			return
+		}
+		status := consts.StatusInternalServerError
+		switch {
+		case errors.Is(err, service.ErrRevisionNotFound):
+			status = consts.StatusNotFound
+		case errors.Is(err, service.ErrConfigAliasExists), errors.Is(err, service.ErrConfigVersionRangeOverlap), isReferenceError(err):
+			status = consts.StatusBadRequest
+		case errors.Is(err, service.ErrEnvironmentFrozen):
+			status = consts.StatusLocked
+		}
+		c.JSON(consts.StatusOK, &config.ConfigResponse{
+			Code:  int32(status),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+	c.JSON(consts.StatusOK, &config.ConfigResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: &config.ConfigData{Config: cfg},
+	})
+}
+
+// Preview .
+// @router /api/v1/config/preview [GET]
+func Preview(ctx context.Context, c *app.RequestContext) {
+	req := &config.ConfigDetailRequest{
+		EnvironmentKey: c.Query("environment_key"),
+		PipelineKey:    c.Query("pipeline_key"),
+		ResourceKey:    c.Query("resource_key"),
+	}
+	if req.EnvironmentKey == "" || req.PipelineKey == "" || req.ResourceKey == "" {
+		c.JSON(consts.StatusOK, &config.ConfigPreviewResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: "environment_key, pipeline_key and resource_key are required",
+		})
+		return
+	}
+	data, err := svc.PreviewConfig(handler.EnrichContext(ctx, c), req.GetEnvironmentKey(), req.GetPipelineKey(), req.GetResourceKey())
+	if err != nil {
+		status := consts.StatusInternalServerError
+		if errors.Is(err, service.ErrResourceNotFound) {
+			status = consts.StatusNotFound
+		}
+		c.JSON(consts.StatusOK, &config.ConfigPreviewResponse{
+			Code:  int32(status),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+	c.JSON(consts.StatusOK, &config.ConfigPreviewResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: data,
+	})
+}
+
+// Reveal .
+// @router /api/v1/config/reveal [POST]
+func Reveal(ctx context.Context, c *app.RequestContext) {
+	req := &config.ConfigDetailRequest{}
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusOK, &config.ConfigResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+	if req.EnvironmentKey == "" || req.PipelineKey == "" || req.ResourceKey == "" {
+		c.JSON(consts.StatusOK, &config.ConfigResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: "environment_key, pipeline_key and resource_key are required",
+		})
+		return
+	}
+	cfg, err := svc.RevealSecret(handler.EnrichContext(ctx, c), req.GetEnvironmentKey(), req.GetPipelineKey(), req.GetResourceKey())
+	if err != nil {
+		c.JSON(consts.StatusOK, &config.ConfigResponse{
+			Code:  secretErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+	c.JSON(consts.StatusOK, &config.ConfigResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: &config.ConfigData{Config: cfg},
+	})
+}
+
+// RotateSecrets .
+// @router /api/v1/config/secret/rotate [POST]
+func RotateSecrets(ctx context.Context, c *app.RequestContext) {
+	data, err := svc.RotateSecrets(handler.EnrichContext(ctx, c))
+	if err != nil {
+		c.JSON(consts.StatusOK, &config.RotateSecretsResponse{
+			Code:  secretErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+	c.JSON(consts.StatusOK, &config.RotateSecretsResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: data,
+	})
+}
+
+// Search .
+// @router /api/v1/config/search [GET]
+func Search(ctx context.Context, c *app.RequestContext) {
+	var req config.SearchConfigRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &config.ConfigListResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	list, total, err := svc.SearchConfigs(handler.EnrichContext(ctx, c), &req)
+	if err != nil {
+		status := consts.StatusInternalServerError
+		if errors.Is(err, service.ErrInvalidSearchFilter) {
+			status = consts.StatusBadRequest
+		}
+		c.JSON(consts.StatusOK, &config.ConfigListResponse{
+			Code:  int32(status),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+	c.JSON(consts.StatusOK, &config.ConfigListResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: &config.ConfigListData{
+			Total: int32(total), // #nosec G115 -- count will not exceed int32
+			List:  list,
+		},
+	})
+}
+
+// UpdateLabels .
+// @router /api/v1/config/labels [POST]
+func UpdateLabels(ctx context.Context, c *app.RequestContext) {
+	req := &config.UpdateConfigLabelsRequest{}
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusOK, &config.ConfigResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+	if req.EnvironmentKey == "" || req.PipelineKey == "" || req.ResourceKey == "" {
+		c.JSON(consts.StatusOK, &config.ConfigResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: "environment_key, pipeline_key and resource_key are required",
+		})
+		return
+	}
+
+	cfg, err := svc.UpdateConfigLabels(handler.EnrichContext(ctx, c), req)
+	if err != nil {
+		status := consts.StatusInternalServerError
+		switch {
+		case errors.Is(err, service.ErrResourceNotFound):
+			status = consts.StatusNotFound
+		case errors.Is(err, service.ErrConfigLabelsInvalid):
+			status = consts.StatusBadRequest
+		case errors.Is(err, service.ErrEnvironmentFrozen):
+			status = consts.StatusLocked
+		}
+		c.JSON(consts.StatusOK, &config.ConfigResponse{
+			Code:  int32(status),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+	c.JSON(consts.StatusOK, &config.ConfigResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: &config.ConfigData{Config: cfg},
+	})
+}
+
+// Schedule .
+// @router /api/v1/config/schedule [GET]
+func Schedule(ctx context.Context, c *app.RequestContext) {
+	var req config.ConfigScheduleRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &config.ConfigScheduleResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+	if req.EnvironmentKey == "" {
+		c.JSON(consts.StatusOK, &config.ConfigScheduleResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: "environment_key is required",
+		})
+		return
+	}
+
+	list, err := svc.ListScheduledChanges(handler.EnrichContext(ctx, c), req.GetEnvironmentKey(), req.GetPipelineKey(), req.GetUntil())
+	if err != nil {
+		status := consts.StatusInternalServerError
+		if errors.Is(err, service.ErrConfigScheduleInvalid) {
+			status = consts.StatusBadRequest
+		}
+		c.JSON(consts.StatusOK, &config.ConfigScheduleResponse{
+			Code:  int32(status),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+	c.JSON(consts.StatusOK, &config.ConfigScheduleResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: &config.ConfigScheduleData{
+			Total: int32(len(list)), // #nosec G115 -- count will not exceed int32
+			List:  list,
+		},
+	})
+}
+
+// Batch .
+// @router /api/v1/config/batch [POST]
+func Batch(ctx context.Context, c *app.RequestContext) {
+	req := &config.BatchConfigRequest{}
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusOK, &config.BatchConfigResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+	data, err := svc.BatchConfigs(handler.EnrichContext(ctx, c), req.GetOperations())
+	if err != nil {
+		if id, ok := handler.ChangeRequestPending(err); ok {
+			c.JSON(consts.StatusOK, &config.BatchConfigResponse{
+				Code:            consts.StatusAccepted,
+				Msg:             "Accepted",
+				Error:           err.Error(),
+				ChangeRequestId: id,
+			})
+			return
+		}
+		c.JSON(consts.StatusOK, &config.BatchConfigResponse{
+			Code:  configErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+			Data:  data,
+		})
+		return
+	}
+	c.JSON(consts.StatusOK, &config.BatchConfigResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: data,
+	})
+}
+
+// RenameAlias .
+// @router /api/v1/config/alias/rename [POST]
+func RenameAlias(ctx context.Context, c *app.RequestContext) {
+	var req config.RenameConfigAliasRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &config.ConfigAliasRenameResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	data, err := svc.RenameConfigAlias(handler.EnrichContext(ctx, c), &req)
+	if err != nil {
+		if id, ok := handler.ChangeRequestPending(err); ok {
+			c.JSON(consts.StatusOK, &config.ConfigAliasRenameResponse{
+				Code:            consts.StatusAccepted,
+				Msg:             "Accepted",
+				Error:           err.Error(),
+				ChangeRequestId: id,
+			})
+			return
+		}
+		c.JSON(consts.StatusOK, &config.ConfigAliasRenameResponse{
+			Code:  configErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+	c.JSON(consts.StatusOK, &config.ConfigAliasRenameResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: data,
+	})
+}
+
+// ListAliasRedirects .
+// @router /api/v1/config/alias/redirects [GET]
+func ListAliasRedirects(ctx context.Context, c *app.RequestContext) {
+	var req config.ListAliasRedirectsRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &config.ConfigAliasRedirectListResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	list, err := svc.ListAliasRedirects(handler.EnrichContext(ctx, c), req.GetEnvironmentKey(), req.GetPipelineKey())
+	if err != nil {
+		c.JSON(consts.StatusOK, &config.ConfigAliasRedirectListResponse{
+			Code:  configErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+	c.JSON(consts.StatusOK, &config.ConfigAliasRedirectListResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: &config.ConfigAliasRedirectListData{
+			Total: int32(len(list)), // #nosec G115 -- count will not exceed int32
+			List:  list,
+		},
+	})
+}
+
+// UpdateTranslations .
+// @router /api/v1/config/translations [POST]
+func UpdateTranslations(ctx context.Context, c *app.RequestContext) {
+	req := &config.UpdateConfigTranslationsRequest{}
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(consts.StatusOK, &config.ConfigResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+	if req.EnvironmentKey == "" || req.PipelineKey == "" || req.ResourceKey == "" {
+		c.JSON(consts.StatusOK, &config.ConfigResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: "environment_key, pipeline_key and resource_key are required",
+		})
+		return
+	}
+
+	cfg, err := svc.UpdateConfigTranslations(handler.EnrichContext(ctx, c), req)
+	if err != nil {
+		if id, ok := handler.ChangeRequestPending(err); ok {
+			c.JSON(consts.StatusOK, &config.ConfigResponse{
+				Code:            consts.StatusAccepted,
+				Msg:             "Accepted",
+				Error:           err.Error(),
+				ChangeRequestId: id,
+			})
+			return
+		}
+		c.JSON(consts.StatusOK, &config.ConfigResponse{
+			Code:  configErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+	c.JSON(consts.StatusOK, &config.ConfigResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: &config.ConfigData{Config: cfg},
+	})
+}
+
+// MissingTranslations .
+// @router /api/v1/config/translations/missing [GET]
+func MissingTranslations(ctx context.Context, c *app.RequestContext) {
+	var req config.MissingTranslationsRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &config.MissingTranslationsResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	data, err := svc.ListMissingTranslations(handler.EnrichContext(ctx, c), &req)
+	if err != nil {
+		c.JSON(consts.StatusOK, &config.MissingTranslationsResponse{
+			Code:  configErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+	c.JSON(consts.StatusOK, &config.MissingTranslationsResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: data,
+	})
+}
+
+// schemaViolations extracts the field-path errors of a JSON Schema validation failure.
+func schemaViolations(err error) []*common.SchemaViolation {
+	var schemaErr *service.SchemaValidationError
+	if !errors.As(err, &schemaErr) {
+		return nil
+	}
+	return service.SchemaViolationsToPB(schemaErr.Violations)
+}
+
+// expectedRevision returns the revision an update or delete is based on: the
+// request field, or else the If-Match header (the ETag of detail). "*" and an
+// absent header skip the check.
+func expectedRevision(c *app.RequestContext, field int64) (int64, error) {
+	if field != 0 {
+		return field, nil
+	}
+	raw := strings.TrimSpace(string(c.GetHeader("If-Match")))
+	if raw == "" || raw == "*" {
+		return 0, nil
+	}
+	revision, err := strconv.ParseInt(strings.Trim(raw, `"`), 10, 64)
+	if err != nil || revision <= 0 {
+		return 0, fmt.Errorf("invalid If-Match header %q: expected a config revision", raw)
+	}
+	return revision, nil
+}
+
+// configErrorStatus maps the errors of config writes to response codes.
+func configErrorStatus(err error) int32 {
+	switch {
+	case errors.Is(err, service.ErrResourceNotFound), errors.Is(err, service.ErrPipelineNotFound):
+		return consts.StatusNotFound
+	case errors.Is(err, service.ErrPipelineKeyRequired):
+		return consts.StatusBadRequest
+	case errors.Is(err, service.ErrConfigRevisionConflict):
+		return consts.StatusConflict
+	case errors.Is(err, service.ErrEnvironmentFrozen):
+		return consts.StatusLocked
+	case errors.Is(err, service.ErrConfigBatchInvalid),
+		errors.Is(err, service.ErrConfigBatchFailed),
+		errors.Is(err, service.ErrConfigAliasRenameInvalid),
+		errors.Is(err, service.ErrConfigAliasExists),
+		errors.Is(err, service.ErrConfigVersionRangeOverlap),
+		errors.Is(err, service.ErrConfigLabelsInvalid),
+		errors.Is(err, service.ErrConfigTranslationsInvalid),
+		errors.Is(err, service.ErrConfigScheduleInvalid),
+		errors.Is(err, service.ErrConfigReferenced),
+		isReferenceError(err),
+		schemaViolations(err) != nil:
+		return consts.StatusBadRequest
+	default:
+		return consts.StatusInternalServerError
+	}
+}
+
+// isReferenceError reports whether err is an invalid config reference.
+func isReferenceError(err error) bool {
+	return errors.Is(err, service.ErrConfigReferenceDangling) ||
+		errors.Is(err, service.ErrConfigReferenceCycle) ||
+		errors.Is(err, service.ErrConfigReferenceSecret)
+}
+
+func secretErrorStatus(err error) int32 {
+	switch {
+	case errors.Is(err, service.ErrSecretAccessDenied):
+		return consts.StatusForbidden
+	case errors.Is(err, service.ErrResourceNotFound):
+		return consts.StatusNotFound
+	case errors.Is(err, service.ErrConfigNotSecret), errors.Is(err, service.ErrSecretKeyNotConfigured):
+		return consts.StatusBadRequest
+	default:
+		return consts.StatusInternalServerError
+	}
+}
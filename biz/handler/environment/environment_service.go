@@ -85,8 +85,13 @@ func Update(ctx context.Context, c *app.RequestContext) {
 
 	if err := svc.UpdateEnvironment(handler.EnrichContext(ctx, c), req.Environment); err != nil {
 		status := consts.StatusInternalServerError
-		if errors.Is(err, service.ErrEnvironmentNotFound) {
+		switch {
+		case errors.Is(err, service.ErrEnvironmentNotFound):
 			status = consts.StatusNotFound
+		case errors.Is(err, service.ErrApprovalDisableForbidden):
+			status = consts.StatusForbidden
+		case errors.Is(err, service.ErrEnvironmentFrozen):
+			status = consts.StatusLocked
 		}
 		c.JSON(consts.StatusOK, &environment.EnvironmentResponse{
 			Code:  int32(status),
@@ -127,8 +132,13 @@ func Delete(ctx context.Context, c *app.RequestContext) {
 
 	if err := svc.DeleteEnvironment(handler.EnrichContext(ctx, c), req.EnvironmentKey); err != nil {
 		status := consts.StatusInternalServerError
-		if errors.Is(err, service.ErrEnvironmentNotFound) {
+		switch {
+		case errors.Is(err, service.ErrEnvironmentNotFound):
 			status = consts.StatusNotFound
+		case errors.Is(err, service.ErrEnvironmentDeleteForbidden):
+			status = consts.StatusForbidden
+		case errors.Is(err, service.ErrEnvironmentFrozen):
+			status = consts.StatusLocked
 		}
 		c.JSON(consts.StatusOK, &environment.DeleteEnvironmentResponse{
 			Code:  int32(status),
@@ -224,3 +234,170 @@ func Detail(ctx context.Context, c *app.RequestContext) {
 		Data: &environment.EnvironmentData{Environment: env},
 	})
 }
+
+// CreateFreeze .
+// @router /api/v1/environment/freeze/create [POST]
+func CreateFreeze(ctx context.Context, c *app.RequestContext) {
+	var req environment.CreateEnvironmentFreezeRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &environment.EnvironmentFreezeResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	freeze, err := svc.CreateEnvironmentFreeze(handler.EnrichContext(ctx, c), req.Freeze)
+	if err != nil {
+		c.JSON(consts.StatusOK, &environment.EnvironmentFreezeResponse{
+			Code:  freezeErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &environment.EnvironmentFreezeResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: freeze,
+	})
+}
+
+// UpdateFreeze .
+// @router /api/v1/environment/freeze/update [POST]
+func UpdateFreeze(ctx context.Context, c *app.RequestContext) {
+	var req environment.UpdateEnvironmentFreezeRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &environment.EnvironmentFreezeResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	freeze, err := svc.UpdateEnvironmentFreeze(handler.EnrichContext(ctx, c), req.Freeze)
+	if err != nil {
+		c.JSON(consts.StatusOK, &environment.EnvironmentFreezeResponse{
+			Code:  freezeErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &environment.EnvironmentFreezeResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: freeze,
+	})
+}
+
+// DeleteFreeze .
+// @router /api/v1/environment/freeze/delete [POST]
+func DeleteFreeze(ctx context.Context, c *app.RequestContext) {
+	var req environment.DeleteEnvironmentFreezeRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &environment.DeleteEnvironmentResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	if err := svc.DeleteEnvironmentFreeze(handler.EnrichContext(ctx, c), req.GetId()); err != nil {
+		c.JSON(consts.StatusOK, &environment.DeleteEnvironmentResponse{
+			Code:  freezeErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &environment.DeleteEnvironmentResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+	})
+}
+
+// ListFreezes .
+// @router /api/v1/environment/freeze/list [GET]
+func ListFreezes(ctx context.Context, c *app.RequestContext) {
+	var req environment.ListEnvironmentFreezeRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &environment.EnvironmentFreezeListResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	list, err := svc.ListEnvironmentFreezes(handler.EnrichContext(ctx, c), req.GetEnvironmentKey())
+	if err != nil {
+		c.JSON(consts.StatusOK, &environment.EnvironmentFreezeListResponse{
+			Code:  freezeErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &environment.EnvironmentFreezeListResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: &environment.EnvironmentFreezeListData{
+			Total: int32(len(list)), // #nosec G115 -- count will not exceed int32
+			List:  list,
+		},
+	})
+}
+
+// ListFreezeOverrides .
+// @router /api/v1/environment/freeze/overrides [GET]
+func ListFreezeOverrides(ctx context.Context, c *app.RequestContext) {
+	var req environment.ListFreezeOverrideRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &environment.FreezeOverrideListResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	list, total, err := svc.ListFreezeOverrides(handler.EnrichContext(ctx, c), req.GetEnvironmentKey(), int(req.GetPage()), int(req.GetPageSize()))
+	if err != nil {
+		c.JSON(consts.StatusOK, &environment.FreezeOverrideListResponse{
+			Code:  freezeErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &environment.FreezeOverrideListResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: &environment.FreezeOverrideListData{
+			Total: int32(total), // #nosec G115 -- count will not exceed int32
+			List:  list,
+		},
+	})
+}
+
+func freezeErrorStatus(err error) int32 {
+	switch {
+	case errors.Is(err, service.ErrEnvironmentNotFound), errors.Is(err, service.ErrEnvironmentFreezeNotFound):
+		return consts.StatusNotFound
+	case errors.Is(err, service.ErrEnvironmentKeyRequired), errors.Is(err, service.ErrEnvironmentFreezeInvalid):
+		return consts.StatusBadRequest
+	case errors.Is(err, service.ErrEnvironmentFrozen):
+		return consts.StatusLocked
+	default:
+		return consts.StatusInternalServerError
+	}
+}
@@ -51,8 +51,10 @@ func Create(ctx context.Context, c *app.RequestContext) {
 
 	if err := svc.AddPipeline(handler.EnrichContext(ctx, c), req.EnvironmentKey, req.Pipeline); err != nil {
 		status := consts.StatusInternalServerError
-		if errors.Is(err, service.ErrPipelineKeyExists) {
+		if errors.Is(err, service.ErrPipelineKeyExists) || errors.Is(err, service.ErrPipelineKeyReserved) || errors.Is(err, service.ErrPipelineLocaleInvalid) {
 			status = consts.StatusBadRequest
+		} else if errors.Is(err, service.ErrEnvironmentFrozen) {
+			status = consts.StatusLocked
 		}
 		c.JSON(consts.StatusOK, &pipeline.PipelineResponse{
 			Code:  int32(status),
@@ -103,6 +105,10 @@ func Update(ctx context.Context, c *app.RequestContext) {
 		status := consts.StatusInternalServerError
 		if errors.Is(err, service.ErrPipelineNotFound) {
 			status = consts.StatusNotFound
+		} else if errors.Is(err, service.ErrPipelineLocaleInvalid) {
+			status = consts.StatusBadRequest
+		} else if errors.Is(err, service.ErrEnvironmentFrozen) {
+			status = consts.StatusLocked
 		}
 		c.JSON(consts.StatusOK, &pipeline.PipelineResponse{
 			Code:  int32(status),
@@ -153,6 +159,8 @@ func Delete(ctx context.Context, c *app.RequestContext) {
 		status := consts.StatusInternalServerError
 		if errors.Is(err, service.ErrPipelineNotFound) {
 			status = consts.StatusNotFound
+		} else if errors.Is(err, service.ErrEnvironmentFrozen) {
+			status = consts.StatusLocked
 		}
 		c.JSON(consts.StatusOK, &pipeline.DeletePipelineResponse{
 			Code:  int32(status),
@@ -266,3 +274,169 @@ func Detail(ctx context.Context, c *app.RequestContext) {
 		Data: &pipeline.PipelineData{Pipeline: pl},
 	})
 }
+
+// CreateAPIKey .
+// @router /api/v1/pipeline/apikey/create [POST]
+func CreateAPIKey(ctx context.Context, c *app.RequestContext) {
+	var req pipeline.CreatePipelineAPIKeyRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &pipeline.PipelineAPIKeyResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	key, token, err := svc.CreatePipelineAPIKey(handler.EnrichContext(ctx, c), &req)
+	if err != nil {
+		c.JSON(consts.StatusOK, &pipeline.PipelineAPIKeyResponse{
+			Code:  apiKeyErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &pipeline.PipelineAPIKeyResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: &pipeline.PipelineAPIKeyData{ApiKey: key, Token: token},
+	})
+}
+
+// RotateAPIKey .
+// @router /api/v1/pipeline/apikey/rotate [POST]
+func RotateAPIKey(ctx context.Context, c *app.RequestContext) {
+	var req pipeline.RotatePipelineAPIKeyRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &pipeline.PipelineAPIKeyResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	key, token, err := svc.RotatePipelineAPIKey(handler.EnrichContext(ctx, c), req.Id, req.GraceSeconds)
+	if err != nil {
+		c.JSON(consts.StatusOK, &pipeline.PipelineAPIKeyResponse{
+			Code:  apiKeyErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &pipeline.PipelineAPIKeyResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: &pipeline.PipelineAPIKeyData{ApiKey: key, Token: token},
+	})
+}
+
+// SetAPIKeyExpiry .
+// @router /api/v1/pipeline/apikey/expiry [POST]
+func SetAPIKeyExpiry(ctx context.Context, c *app.RequestContext) {
+	var req pipeline.SetPipelineAPIKeyExpiryRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &pipeline.PipelineAPIKeyResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	key, err := svc.SetPipelineAPIKeyExpiry(handler.EnrichContext(ctx, c), req.Id, req.ExpiresAt)
+	if err != nil {
+		c.JSON(consts.StatusOK, &pipeline.PipelineAPIKeyResponse{
+			Code:  apiKeyErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &pipeline.PipelineAPIKeyResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: &pipeline.PipelineAPIKeyData{ApiKey: key},
+	})
+}
+
+// RevokeAPIKey .
+// @router /api/v1/pipeline/apikey/revoke [POST]
+func RevokeAPIKey(ctx context.Context, c *app.RequestContext) {
+	var req pipeline.RevokePipelineAPIKeyRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &pipeline.PipelineAPIKeyResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	key, err := svc.RevokePipelineAPIKey(handler.EnrichContext(ctx, c), req.Id)
+	if err != nil {
+		c.JSON(consts.StatusOK, &pipeline.PipelineAPIKeyResponse{
+			Code:  apiKeyErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &pipeline.PipelineAPIKeyResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: &pipeline.PipelineAPIKeyData{ApiKey: key},
+	})
+}
+
+// ListAPIKeys .
+// @router /api/v1/pipeline/apikey/list [GET]
+func ListAPIKeys(ctx context.Context, c *app.RequestContext) {
+	var req pipeline.ListPipelineAPIKeyRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &pipeline.PipelineAPIKeyListResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	list, err := svc.ListPipelineAPIKeys(handler.EnrichContext(ctx, c), req.EnvironmentKey, req.PipelineKey)
+	if err != nil {
+		c.JSON(consts.StatusOK, &pipeline.PipelineAPIKeyListResponse{
+			Code:  apiKeyErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &pipeline.PipelineAPIKeyListResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: &pipeline.PipelineAPIKeyListData{
+			Total: int32(len(list)),
+			List:  list,
+		},
+	})
+}
+
+func apiKeyErrorStatus(err error) int32 {
+	switch {
+	case errors.Is(err, service.ErrAPIKeyNotFound), errors.Is(err, service.ErrPipelineNotFound):
+		return consts.StatusNotFound
+	case errors.Is(err, service.ErrEnvironmentKeyRequired), errors.Is(err, service.ErrPipelineKeyRequired), errors.Is(err, service.ErrAPIKeyInvalid):
+		return consts.StatusBadRequest
+	case errors.Is(err, service.ErrAPIKeyRevoked):
+		return consts.StatusConflict
+	default:
+		return consts.StatusInternalServerError
+	}
+}
@@ -0,0 +1,212 @@
+// Code generated by hertz generator.
+
+package release
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/yi-nology/rainbow_bridge/biz/handler"
+	release "github.com/yi-nology/rainbow_bridge/biz/model/release"
+	"github.com/yi-nology/rainbow_bridge/biz/service"
+)
+
+var svc *service.Service
+
+func SetService(s *service.Service) {
+	svc = s
+}
+
+// Publish .
+// @router /api/v1/release/publish [POST]
+func Publish(ctx context.Context, c *app.RequestContext) {
+	var req release.PublishReleaseRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &release.ReleaseResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	item, err := svc.PublishRelease(handler.EnrichContext(ctx, c), req.EnvironmentKey, req.PipelineKey, req.Description)
+	if err != nil {
+		if id, ok := handler.ChangeRequestPending(err); ok {
+			c.JSON(consts.StatusOK, &release.ReleaseResponse{
+				Code:            consts.StatusAccepted,
+				Msg:             "Accepted",
+				Error:           err.Error(),
+				ChangeRequestId: id,
+			})
+			return
+		}
+		c.JSON(consts.StatusOK, &release.ReleaseResponse{
+			Code:  releaseErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &release.ReleaseResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: &release.ReleaseData{Release: item},
+	})
+}
+
+// List .
+// @router /api/v1/release/list [GET]
+func List(ctx context.Context, c *app.RequestContext) {
+	var req release.ListReleaseRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &release.ReleaseListResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	list, total, err := svc.ListReleases(handler.EnrichContext(ctx, c), req.EnvironmentKey, req.PipelineKey, int(req.Page), int(req.PageSize))
+	if err != nil {
+		c.JSON(consts.StatusOK, &release.ReleaseListResponse{
+			Code:  releaseErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &release.ReleaseListResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: &release.ReleaseListData{
+			Total: int32(total), // #nosec G115 -- count will not exceed int32
+			List:  list,
+		},
+	})
+}
+
+// Detail .
+// @router /api/v1/release/detail [GET]
+func Detail(ctx context.Context, c *app.RequestContext) {
+	var req release.ReleaseDetailRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &release.ReleaseResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	item, err := svc.GetRelease(handler.EnrichContext(ctx, c), req.EnvironmentKey, req.PipelineKey, int(req.Version))
+	if err != nil {
+		c.JSON(consts.StatusOK, &release.ReleaseResponse{
+			Code:  releaseErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &release.ReleaseResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: &release.ReleaseData{Release: item},
+	})
+}
+
+// Compare .
+// @router /api/v1/release/compare [GET]
+func Compare(ctx context.Context, c *app.RequestContext) {
+	var req release.CompareReleaseRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &release.ReleaseCompareResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	list, err := svc.CompareReleases(handler.EnrichContext(ctx, c), req.EnvironmentKey, req.PipelineKey, int(req.FromVersion), int(req.ToVersion))
+	if err != nil {
+		c.JSON(consts.StatusOK, &release.ReleaseCompareResponse{
+			Code:  releaseErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &release.ReleaseCompareResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: &release.ReleaseCompareData{
+			FromVersion: req.FromVersion,
+			ToVersion:   req.ToVersion,
+			List:        list,
+		},
+	})
+}
+
+// Activate .
+// @router /api/v1/release/activate [POST]
+func Activate(ctx context.Context, c *app.RequestContext) {
+	var req release.ActivateReleaseRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &release.ReleaseResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	item, err := svc.ActivateRelease(handler.EnrichContext(ctx, c), req.EnvironmentKey, req.PipelineKey, int(req.Version))
+	if err != nil {
+		if id, ok := handler.ChangeRequestPending(err); ok {
+			c.JSON(consts.StatusOK, &release.ReleaseResponse{
+				Code:            consts.StatusAccepted,
+				Msg:             "Accepted",
+				Error:           err.Error(),
+				ChangeRequestId: id,
+			})
+			return
+		}
+		c.JSON(consts.StatusOK, &release.ReleaseResponse{
+			Code:  releaseErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &release.ReleaseResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: &release.ReleaseData{Release: item},
+	})
+}
+
+func releaseErrorStatus(err error) int32 {
+	switch {
+	case errors.Is(err, service.ErrEnvironmentKeyRequired),
+		errors.Is(err, service.ErrPipelineKeyRequired),
+		errors.Is(err, service.ErrReleaseVersionRequired):
+		return consts.StatusBadRequest
+	case errors.Is(err, service.ErrEnvironmentNotFound),
+		errors.Is(err, service.ErrPipelineNotFound),
+		errors.Is(err, service.ErrReleaseNotFound):
+		return consts.StatusNotFound
+	case errors.Is(err, service.ErrEnvironmentFrozen):
+		return consts.StatusLocked
+	default:
+		return consts.StatusInternalServerError
+	}
+}
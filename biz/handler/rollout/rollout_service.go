@@ -0,0 +1,253 @@
+// Code generated by hertz generator.
+
+package rollout
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/yi-nology/rainbow_bridge/biz/handler"
+	rollout "github.com/yi-nology/rainbow_bridge/biz/model/rollout"
+	"github.com/yi-nology/rainbow_bridge/biz/service"
+)
+
+var svc *service.Service
+
+func SetService(s *service.Service) {
+	svc = s
+}
+
+// Create .
+// @router /api/v1/rollout/create [POST]
+func Create(ctx context.Context, c *app.RequestContext) {
+	var req rollout.CreateRolloutRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &rollout.RolloutResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	item, err := svc.CreateRollout(handler.EnrichContext(ctx, c), &req)
+	if err != nil {
+		if id, ok := handler.ChangeRequestPending(err); ok {
+			c.JSON(consts.StatusOK, &rollout.RolloutResponse{
+				Code:            consts.StatusAccepted,
+				Msg:             "Accepted",
+				Error:           err.Error(),
+				ChangeRequestId: id,
+			})
+			return
+		}
+		c.JSON(consts.StatusOK, &rollout.RolloutResponse{
+			Code:  rolloutErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &rollout.RolloutResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: &rollout.RolloutData{Rollout: item},
+	})
+}
+
+// List .
+// @router /api/v1/rollout/list [GET]
+func List(ctx context.Context, c *app.RequestContext) {
+	var req rollout.ListRolloutRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &rollout.RolloutListResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	list, err := svc.ListRollouts(handler.EnrichContext(ctx, c), req.EnvironmentKey, req.PipelineKey, req.Status)
+	if err != nil {
+		c.JSON(consts.StatusOK, &rollout.RolloutListResponse{
+			Code:  rolloutErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &rollout.RolloutListResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: &rollout.RolloutListData{
+			Total: int32(len(list)), // #nosec G115 -- count will not exceed int32
+			List:  list,
+		},
+	})
+}
+
+// Ramp .
+// @router /api/v1/rollout/ramp [POST]
+func Ramp(ctx context.Context, c *app.RequestContext) {
+	var req rollout.RampRolloutRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &rollout.RolloutResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	item, err := svc.RampRollout(handler.EnrichContext(ctx, c), req.Id, req.Percentage)
+	if err != nil {
+		if id, ok := handler.ChangeRequestPending(err); ok {
+			c.JSON(consts.StatusOK, &rollout.RolloutResponse{
+				Code:            consts.StatusAccepted,
+				Msg:             "Accepted",
+				Error:           err.Error(),
+				ChangeRequestId: id,
+			})
+			return
+		}
+		c.JSON(consts.StatusOK, &rollout.RolloutResponse{
+			Code:  rolloutErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &rollout.RolloutResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: &rollout.RolloutData{Rollout: item},
+	})
+}
+
+// Pause .
+// @router /api/v1/rollout/pause [POST]
+func Pause(ctx context.Context, c *app.RequestContext) {
+	var req rollout.RolloutActionRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &rollout.RolloutResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	item, err := svc.PauseRollout(handler.EnrichContext(ctx, c), req.Id)
+	if err != nil {
+		c.JSON(consts.StatusOK, &rollout.RolloutResponse{
+			Code:  rolloutErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &rollout.RolloutResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: &rollout.RolloutData{Rollout: item},
+	})
+}
+
+// Promote .
+// @router /api/v1/rollout/promote [POST]
+func Promote(ctx context.Context, c *app.RequestContext) {
+	var req rollout.RolloutActionRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &rollout.RolloutResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	item, err := svc.PromoteRollout(handler.EnrichContext(ctx, c), req.Id)
+	if err != nil {
+		if id, ok := handler.ChangeRequestPending(err); ok {
+			c.JSON(consts.StatusOK, &rollout.RolloutResponse{
+				Code:            consts.StatusAccepted,
+				Msg:             "Accepted",
+				Error:           err.Error(),
+				ChangeRequestId: id,
+			})
+			return
+		}
+		c.JSON(consts.StatusOK, &rollout.RolloutResponse{
+			Code:  rolloutErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &rollout.RolloutResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: &rollout.RolloutData{Rollout: item},
+	})
+}
+
+// Abort .
+// @router /api/v1/rollout/abort [POST]
+func Abort(ctx context.Context, c *app.RequestContext) {
+	var req rollout.RolloutActionRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &rollout.RolloutResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	item, err := svc.AbortRollout(handler.EnrichContext(ctx, c), req.Id)
+	if err != nil {
+		c.JSON(consts.StatusOK, &rollout.RolloutResponse{
+			Code:  rolloutErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &rollout.RolloutResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: &rollout.RolloutData{Rollout: item},
+	})
+}
+
+func rolloutErrorStatus(err error) int32 {
+	switch {
+	case errors.Is(err, service.ErrEnvironmentKeyRequired),
+		errors.Is(err, service.ErrPipelineKeyRequired),
+		errors.Is(err, service.ErrRolloutIDRequired),
+		errors.Is(err, service.ErrRolloutInvalidPercentage),
+		errors.Is(err, service.ErrRolloutAmbiguousAlias),
+		errors.Is(err, service.ErrRolloutExists),
+		errors.Is(err, service.ErrRolloutNotOpen),
+		errors.Is(err, service.ErrConfigReferenceDangling),
+		errors.Is(err, service.ErrConfigReferenceCycle),
+		errors.Is(err, service.ErrConfigReferenceSecret):
+		return consts.StatusBadRequest
+	case errors.Is(err, service.ErrResourceNotFound),
+		errors.Is(err, service.ErrRolloutNotFound):
+		return consts.StatusNotFound
+	case errors.Is(err, service.ErrEnvironmentFrozen):
+		return consts.StatusLocked
+	default:
+		return consts.StatusInternalServerError
+	}
+}
@@ -3,13 +3,19 @@
 package runtime
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/network"
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/cloudwego/hertz/pkg/protocol/http1/resp"
 	"github.com/yi-nology/rainbow_bridge/biz/handler"
 	runtime "github.com/yi-nology/rainbow_bridge/biz/model/runtime"
 	"github.com/yi-nology/rainbow_bridge/biz/service"
@@ -46,8 +52,26 @@ func GetConfig(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	ctx = handler.EnrichContext(ctx, c)
+
+	// 条件请求：配置未变化时返回 304，无需加载配置
+	validator, err := svc.RuntimeConfigValidator(ctx, environmentKey, pipelineKey)
+	if err == nil {
+		notModified := handler.NotModified(c, validator.ETag, validator.LastModified)
+		if notModified {
+			c.NotModified()
+		}
+		c.Header("ETag", validator.ETag)
+		c.Header("Last-Modified", validator.LastModified.Format(http.TimeFormat))
+		c.Header("Cache-Control", "no-cache")
+		c.Header("Vary", strings.Join(append([]string{"Accept-Language", "X-Client-Version", "Authorization", "X-API-Key"}, validator.Vary...), ", "))
+		if notModified {
+			return
+		}
+	}
+
 	// 调用 service 层
-	resp, err := svc.GetRuntimeConfig(handler.EnrichContext(ctx, c), environmentKey, pipelineKey)
+	resp, err := svc.GetRuntimeConfig(ctx, environmentKey, pipelineKey)
 	if err != nil {
 		c.JSON(consts.StatusOK, &runtime.RuntimeConfigResponse{
 			Code:  consts.StatusInternalServerError,
@@ -68,11 +92,19 @@ func GetConfig(ctx context.Context, c *app.RequestContext) {
 		Type           string      `json:"type"`
 		Remark         string      `json:"remark"`
 		IsPerm         bool        `json:"is_perm"`
+		Origin         string      `json:"origin"`
+		// 重命名后仍以旧别名下发的配置
+		Deprecated      bool   `json:"deprecated,omitempty"`
+		RenamedTo       string `json:"renamed_to,omitempty"`
+		DeprecatedUntil string `json:"deprecated_until,omitempty"`
+		// 多语言配置实际下发的语言
+		Locale string `json:"locale,omitempty"`
 	}
 
 	type CustomRuntimeConfigData struct {
 		Configs      []CustomResourceConfig `json:"configs"`
 		Environment  *runtime.EnvironmentInfo `json:"environment"`
+		Revision     int64                    `json:"revision"`
 	}
 
 	type CustomRuntimeConfigResponse struct {
@@ -80,35 +112,33 @@ func GetConfig(ctx context.Context, c *app.RequestContext) {
 		Msg     string                   `json:"msg"`
 		Error   string                   `json:"error"`
 		Data    CustomRuntimeConfigData  `json:"data"`
+		Signature *runtime.RuntimeSignature `json:"signature,omitempty"`
+		// 数据库不可用时返回的兜底快照
+		Stale      bool   `json:"stale,omitempty"`
+		SnapshotAt string `json:"snapshot_at,omitempty"`
 	}
 
 	// 转换配置数据
 	customConfigs := make([]CustomResourceConfig, len(resp.Data.Configs))
 	for i, cfg := range resp.Data.Configs {
 		customConfig := CustomResourceConfig{
-			ResourceKey:    cfg.ResourceKey,
-			Alias:          cfg.Alias,
-			Name:           cfg.Name,
-			EnvironmentKey: cfg.EnvironmentKey,
-			PipelineKey:    cfg.PipelineKey,
-			Type:           cfg.Type,
-			Remark:         cfg.Remark,
-			IsPerm:         cfg.IsPerm,
+			ResourceKey:     cfg.ResourceKey,
+			Alias:           cfg.Alias,
+			Name:            cfg.Name,
+			EnvironmentKey:  cfg.EnvironmentKey,
+			PipelineKey:     cfg.PipelineKey,
+			Type:            cfg.Type,
+			Remark:          cfg.Remark,
+			IsPerm:          cfg.IsPerm,
+			Origin:          cfg.Origin,
+			Deprecated:      cfg.Deprecated,
+			RenamedTo:       cfg.RenamedTo,
+			DeprecatedUntil: cfg.DeprecatedUntil,
+			Locale:          cfg.Locale,
 		}
 
-		// 对于 object 和 keyvalue 类型，解析为 JSON 对象
-		normalizedType := strings.ToLower(strings.TrimSpace(cfg.Type))
-		switch normalizedType {
-		case "object", "keyvalue", "json", "config", "kv", "key-value", "键值对":
-			var content interface{}
-			if err := json.Unmarshal([]byte(cfg.Content), &content); err == nil {
-				customConfig.Content = content
-			} else {
-				customConfig.Content = cfg.Content
-			}
-		default:
-			customConfig.Content = cfg.Content
-		}
+		// 对象、数值与布尔类型按原生 JSON 输出
+		customConfig.Content = service.TypedConfigContent(cfg.Type, cfg.Content)
 
 		customConfigs[i] = customConfig
 	}
@@ -121,9 +151,27 @@ func GetConfig(ctx context.Context, c *app.RequestContext) {
 		Data: CustomRuntimeConfigData{
 			Configs:     customConfigs,
 			Environment: resp.Data.Environment,
+			Revision:    resp.Data.Revision,
 		},
+		Stale:      resp.Stale,
+		SnapshotAt: resp.SnapshotAt,
+	}
+
+	// 对下发的 data 对象签名，客户端可经 CDN 或代理获取后校验来源
+	customResponse.Signature, err = svc.SignRuntimeData(customResponse.Data)
+	if err != nil {
+		c.JSON(consts.StatusOK, &runtime.RuntimeConfigResponse{
+			Code:  consts.StatusInternalServerError,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
 	}
 
+	if customResponse.Stale {
+		// 兜底快照不应被 CDN 或代理缓存
+		c.Header("Cache-Control", "no-store")
+	}
 	c.JSON(consts.StatusOK, customResponse)
 }
 
@@ -133,6 +181,7 @@ func ExportStatic(ctx context.Context, c *app.RequestContext) {
 	// 从 query 参数获取
 	environmentKey := strings.TrimSpace(c.Query("environment_key"))
 	pipelineKey := strings.TrimSpace(c.Query("pipeline_key"))
+	perLocale, _ := strconv.ParseBool(c.Query("per_locale"))
 
 	// 参数验证
 	if environmentKey == "" {
@@ -153,7 +202,7 @@ func ExportStatic(ctx context.Context, c *app.RequestContext) {
 	}
 
 	// 调用 service 层
-	data, filename, err := svc.ExportStaticPackage(handler.EnrichContext(ctx, c), environmentKey, pipelineKey)
+	data, filename, err := svc.ExportStaticPackage(handler.EnrichContext(ctx, c), environmentKey, pipelineKey, perLocale)
 	if err != nil {
 		c.JSON(consts.StatusOK, &runtime.RuntimeConfigResponse{
 			Code:  consts.StatusInternalServerError,
@@ -184,3 +233,177 @@ func GetOverview(ctx context.Context, c *app.RequestContext) {
 
 	c.JSON(consts.StatusOK, resp)
 }
+
+// GetSigningKeys .
+// @router /api/v1/runtime/signing-keys [GET]
+func GetSigningKeys(ctx context.Context, c *app.RequestContext) {
+	resp, err := svc.GetSigningKeys(handler.EnrichContext(ctx, c))
+	if err != nil {
+		c.JSON(consts.StatusOK, &runtime.SigningKeysResponse{
+			Code:  consts.StatusInternalServerError,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// Watch .
+// @router /api/v1/runtime/watch [GET]
+func Watch(ctx context.Context, c *app.RequestContext) {
+	var req runtime.RuntimeWatchRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &runtime.RuntimeWatchResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+	environmentKey := strings.TrimSpace(req.XEnvironment)
+	pipelineKey := strings.TrimSpace(req.XPipeline)
+	if environmentKey == "" || pipelineKey == "" {
+		c.JSON(consts.StatusOK, &runtime.RuntimeWatchResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: "x-environment and x-pipeline headers are required",
+		})
+		return
+	}
+	if req.Revision < 0 || req.Timeout < 0 {
+		c.JSON(consts.StatusOK, &runtime.RuntimeWatchResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: "revision and timeout must not be negative",
+		})
+		return
+	}
+
+	data, err := svc.WatchRuntimeChanges(handler.EnrichContext(ctx, c), environmentKey, pipelineKey, req.Revision, req.Timeout)
+	if err != nil {
+		c.JSON(consts.StatusOK, &runtime.RuntimeWatchResponse{
+			Code:  runtimeErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &runtime.RuntimeWatchResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: data,
+	})
+}
+
+func runtimeErrorStatus(err error) int32 {
+	switch {
+	case errors.Is(err, service.ErrEnvironmentNotFound),
+		errors.Is(err, service.ErrPipelineNotFound):
+		return consts.StatusNotFound
+	default:
+		return consts.StatusInternalServerError
+	}
+}
+
+// Stream .
+// @router /api/v1/runtime/stream [GET]
+func Stream(ctx context.Context, c *app.RequestContext) {
+	var req runtime.RuntimeStreamRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &runtime.RuntimeConfigResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+	// EventSource 无法设置请求头，允许通过 query 参数传递
+	environmentKey := firstNonEmpty(req.XEnvironment, req.EnvironmentKey)
+	pipelineKey := firstNonEmpty(req.XPipeline, req.PipelineKey)
+	if environmentKey == "" || pipelineKey == "" {
+		c.JSON(consts.StatusOK, &runtime.RuntimeConfigResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: "x-environment and x-pipeline headers are required",
+		})
+		return
+	}
+	var lastEventID int64
+	rawLastEventID := firstNonEmpty(req.LastEventId, req.LastEventIdQuery)
+	if rawLastEventID != "" {
+		id, err := strconv.ParseInt(rawLastEventID, 10, 64)
+		if err != nil || id < 0 {
+			c.JSON(consts.StatusOK, &runtime.RuntimeConfigResponse{
+				Code:  consts.StatusBadRequest,
+				Msg:   "error",
+				Error: "invalid Last-Event-ID",
+			})
+			return
+		}
+		lastEventID = id
+	}
+
+	stream := &eventStream{c: c}
+	err := svc.StreamChangeEvents(handler.EnrichContext(ctx, c), environmentKey, pipelineKey, lastEventID, rawLastEventID != "", stream)
+	if err != nil && stream.w == nil {
+		c.JSON(consts.StatusOK, &runtime.RuntimeConfigResponse{
+			Code:  runtimeErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+	}
+	// 流开始后的错误多为客户端断开，直接结束响应
+}
+
+// eventStream writes a change stream as server-sent events.
+type eventStream struct {
+	c *app.RequestContext
+	w network.ExtWriter
+}
+
+func (s *eventStream) Open() error {
+	s.c.SetStatusCode(consts.StatusOK)
+	s.c.Response.Header.SetContentType("text/event-stream; charset=utf-8")
+	s.c.Response.Header.Set("Cache-Control", "no-cache")
+	s.c.Response.Header.Set("X-Accel-Buffering", "no")
+	s.w = resp.NewChunkedBodyWriter(&s.c.Response, s.c.GetWriter())
+	s.c.Response.HijackWriter(s.w)
+	// 写入重连间隔，同时立即发送响应头
+	return s.write([]byte("retry: 3000\n\n"))
+}
+
+func (s *eventStream) Send(msg *service.ChangeStreamMessage) error {
+	if msg.Type == "" {
+		return s.write([]byte(": keep-alive\n\n"))
+	}
+	var data interface{} = map[string]int64{"id": msg.ID}
+	if msg.Event != nil {
+		data = msg.Event
+	}
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "id: %d\nevent: %s\ndata: %s\n\n", msg.ID, msg.Type, payload)
+	return s.write(buf.Bytes())
+}
+
+func (s *eventStream) write(p []byte) error {
+	if _, err := s.w.Write(p); err != nil {
+		return err
+	}
+	return s.w.Flush()
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+	return ""
+}
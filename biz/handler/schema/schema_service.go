@@ -0,0 +1,187 @@
+// Code generated by hertz generator.
+
+package schema
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"github.com/yi-nology/rainbow_bridge/biz/handler"
+	schema "github.com/yi-nology/rainbow_bridge/biz/model/schema"
+	"github.com/yi-nology/rainbow_bridge/biz/service"
+)
+
+var svc *service.Service
+
+func SetService(s *service.Service) {
+	svc = s
+}
+
+// Create .
+// @router /api/v1/schema/create [POST]
+func Create(ctx context.Context, c *app.RequestContext) {
+	var req schema.CreateSchemaRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &schema.SchemaResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	item, err := svc.CreateConfigSchema(handler.EnrichContext(ctx, c), &req)
+	if err != nil {
+		c.JSON(consts.StatusOK, &schema.SchemaResponse{
+			Code:  schemaErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &schema.SchemaResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: &schema.SchemaData{Schema: item},
+	})
+}
+
+// Update .
+// @router /api/v1/schema/update [POST]
+func Update(ctx context.Context, c *app.RequestContext) {
+	var req schema.UpdateSchemaRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &schema.SchemaResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	item, err := svc.UpdateConfigSchema(handler.EnrichContext(ctx, c), &req)
+	if err != nil {
+		c.JSON(consts.StatusOK, &schema.SchemaResponse{
+			Code:  schemaErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &schema.SchemaResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: &schema.SchemaData{Schema: item},
+	})
+}
+
+// Delete .
+// @router /api/v1/schema/delete [POST]
+func Delete(ctx context.Context, c *app.RequestContext) {
+	var req schema.DeleteSchemaRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &schema.SchemaResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	if err := svc.DeleteConfigSchema(handler.EnrichContext(ctx, c), req.Id); err != nil {
+		c.JSON(consts.StatusOK, &schema.SchemaResponse{
+			Code:  schemaErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &schema.SchemaResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+	})
+}
+
+// List .
+// @router /api/v1/schema/list [GET]
+func List(ctx context.Context, c *app.RequestContext) {
+	var req schema.ListSchemaRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &schema.SchemaListResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	list, err := svc.ListConfigSchemas(handler.EnrichContext(ctx, c), req.EnvironmentKey, req.PipelineKey)
+	if err != nil {
+		c.JSON(consts.StatusOK, &schema.SchemaListResponse{
+			Code:  schemaErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &schema.SchemaListResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: &schema.SchemaListData{
+			Total: int32(len(list)), // #nosec G115 -- count will not exceed int32
+			List:  list,
+		},
+	})
+}
+
+// Revalidate .
+// @router /api/v1/schema/revalidate [POST]
+func Revalidate(ctx context.Context, c *app.RequestContext) {
+	var req schema.RevalidateRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusOK, &schema.RevalidateResponse{
+			Code:  consts.StatusBadRequest,
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	data, err := svc.RevalidateConfigs(handler.EnrichContext(ctx, c), req.EnvironmentKey, req.PipelineKey)
+	if err != nil {
+		c.JSON(consts.StatusOK, &schema.RevalidateResponse{
+			Code:  schemaErrorStatus(err),
+			Msg:   "error",
+			Error: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, &schema.RevalidateResponse{
+		Code: consts.StatusOK,
+		Msg:  "OK",
+		Data: data,
+	})
+}
+
+func schemaErrorStatus(err error) int32 {
+	switch {
+	case errors.Is(err, service.ErrEnvironmentKeyRequired),
+		errors.Is(err, service.ErrPipelineKeyRequired),
+		errors.Is(err, service.ErrConfigSchemaIDRequired),
+		errors.Is(err, service.ErrConfigSchemaExists),
+		errors.Is(err, service.ErrConfigSchemaInvalid),
+		errors.Is(err, service.ErrConfigSchemaPatternInvalid):
+		return consts.StatusBadRequest
+	case errors.Is(err, service.ErrConfigSchemaNotFound):
+		return consts.StatusNotFound
+	default:
+		return consts.StatusInternalServerError
+	}
+}
@@ -5,6 +5,7 @@ package transfer
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"strings"
@@ -64,8 +65,11 @@ func Import(ctx context.Context, c *app.RequestContext) {
 
 		configs, err := svc.ImportConfigsArchive(handler.EnrichContext(ctx, c), data, targetEnv, targetPipeline, overwrite)
 		if err != nil {
+			if respondImportPending(c, err) {
+				return
+			}
 			c.JSON(consts.StatusOK, &transfer.ImportResponse{
-				Code:  consts.StatusInternalServerError,
+				Code:  writeErrorStatus(err),
 				Msg:   "error",
 				Error: err.Error(),
 			})
@@ -103,8 +107,11 @@ func Import(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 	if err := svc.ImportConfigs(handler.EnrichContext(ctx, c), req.GetConfigs(), req.GetOverwrite()); err != nil {
+		if respondImportPending(c, err) {
+			return
+		}
 		c.JSON(consts.StatusOK, &transfer.ImportResponse{
-			Code:  consts.StatusInternalServerError,
+			Code:  writeErrorStatus(err),
 			Msg:   "error",
 			Error: err.Error(),
 		})
@@ -181,8 +188,17 @@ func Migrate(ctx context.Context, c *app.RequestContext) {
 	// 调用 Service 层
 	data, err := svc.MigrateConfigs(handler.EnrichContext(ctx, c), req)
 	if err != nil {
+		if id, ok := handler.ChangeRequestPending(err); ok {
+			c.JSON(consts.StatusOK, &transfer.MigrateResponse{
+				Code:            consts.StatusAccepted,
+				Msg:             "Accepted",
+				Error:           err.Error(),
+				ChangeRequestId: id,
+			})
+			return
+		}
 		c.JSON(consts.StatusOK, &transfer.MigrateResponse{
-			Code:  consts.StatusInternalServerError,
+			Code:  writeErrorStatus(err),
 			Msg:   "error",
 			Error: err.Error(),
 		})
@@ -369,8 +385,11 @@ func ImportSelective(ctx context.Context, c *app.RequestContext) {
 
 	configs, err := svc.ImportConfigsSelective(handler.EnrichContext(ctx, c), data, fileHeader.Filename, selections, overwrite)
 	if err != nil {
+		if respondImportPending(c, err) {
+			return
+		}
 		c.JSON(consts.StatusOK, &transfer.ImportResponse{
-			Code:  consts.StatusInternalServerError,
+			Code:  writeErrorStatus(err),
 			Msg:   "error",
 			Error: err.Error(),
 		})
@@ -389,3 +408,26 @@ func ImportSelective(ctx context.Context, c *app.RequestContext) {
 		},
 	})
 }
+
+// respondImportPending answers an import that was queued for approval.
+func respondImportPending(c *app.RequestContext, err error) bool {
+	id, ok := handler.ChangeRequestPending(err)
+	if !ok {
+		return false
+	}
+	c.JSON(consts.StatusOK, &transfer.ImportResponse{
+		Code:            consts.StatusAccepted,
+		Msg:             "Accepted",
+		Error:           err.Error(),
+		ChangeRequestId: id,
+	})
+	return true
+}
+
+// writeErrorStatus maps the errors of import and migration writes to response codes.
+func writeErrorStatus(err error) int32 {
+	if errors.Is(err, service.ErrEnvironmentFrozen) {
+		return consts.StatusLocked
+	}
+	return consts.StatusInternalServerError
+}
@@ -0,0 +1,100 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/yi-nology/rainbow_bridge/pkg/common"
+)
+
+// RuntimeAuthorizer decides whether a runtime request may proceed.
+type RuntimeAuthorizer interface {
+	// AuthorizeRuntime checks token, which may be empty, for scope on the
+	// environment/pipeline and returns ctx carrying the identity of the key.
+	// It fails with common.ErrAPIKeyRequired, common.ErrAPIKeyUnauthorized or
+	// common.ErrAPIKeyForbidden when the request is rejected.
+	AuthorizeRuntime(ctx context.Context, token, environmentKey, pipelineKey, scope string) (context.Context, error)
+}
+
+var runtimeAuthorizer RuntimeAuthorizer
+
+// SetRuntimeAuthorizer sets the authorizer used by APIKeyAuth.
+func SetRuntimeAuthorizer(authorizer RuntimeAuthorizer) {
+	runtimeAuthorizer = authorizer
+}
+
+// APIKeyAuth returns a middleware that authenticates runtime clients with the
+// API key of their pipeline, sent in the X-API-Key header or as a Bearer token.
+// The pipeline is read from the x-environment/x-pipeline headers, falling back
+// to the environment_key/pipeline_key query parameters. Requests naming
+// different pipelines in the headers and the query are rejected, so handlers
+// reading either one serve the pipeline that was authorized.
+func APIKeyAuth(scope string) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		if runtimeAuthorizer == nil {
+			c.Next(ctx)
+			return
+		}
+		token := strings.TrimSpace(string(c.GetHeader("X-API-Key")))
+		if token == "" {
+			if bearer, ok := strings.CutPrefix(string(c.GetHeader("Authorization")), "Bearer "); ok && strings.HasPrefix(bearer, common.APIKeyTokenPrefix) {
+				token = strings.TrimSpace(bearer)
+			}
+		}
+		environmentKey, ok := runtimeTarget(c, "x-environment", "environment_key")
+		if !ok {
+			rejectRuntimeTarget(c, "x-environment header and environment_key query parameter differ")
+			return
+		}
+		pipelineKey, ok := runtimeTarget(c, "x-pipeline", "pipeline_key")
+		if !ok {
+			rejectRuntimeTarget(c, "x-pipeline header and pipeline_key query parameter differ")
+			return
+		}
+
+		authorized, err := runtimeAuthorizer.AuthorizeRuntime(ctx, token, environmentKey, pipelineKey, scope)
+		if err != nil {
+			status := http.StatusInternalServerError
+			switch {
+			case errors.Is(err, common.ErrAPIKeyRequired), errors.Is(err, common.ErrAPIKeyUnauthorized):
+				status = http.StatusUnauthorized
+			case errors.Is(err, common.ErrAPIKeyForbidden):
+				status = http.StatusForbidden
+			default:
+				log.Printf("[APIKey] failed to authorize runtime request: %v", err)
+			}
+			c.JSON(status, map[string]any{
+				"code":  status,
+				"error": err.Error(),
+				"msg":   http.StatusText(status),
+			})
+			c.Abort()
+			return
+		}
+		c.Next(authorized)
+	}
+}
+
+// runtimeTarget returns the value of header, falling back to the query
+// parameter; ok is false when both are set and differ.
+func runtimeTarget(c *app.RequestContext, header, query string) (string, bool) {
+	fromHeader := strings.TrimSpace(string(c.GetHeader(header)))
+	fromQuery := strings.TrimSpace(c.Query(query))
+	if fromHeader == "" {
+		return fromQuery, true
+	}
+	return fromHeader, fromQuery == "" || fromQuery == fromHeader
+}
+
+func rejectRuntimeTarget(c *app.RequestContext, message string) {
+	c.JSON(http.StatusBadRequest, map[string]any{
+		"code":  http.StatusBadRequest,
+		"error": message,
+		"msg":   http.StatusText(http.StatusBadRequest),
+	})
+	c.Abort()
+}
@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/config"
+	"github.com/cloudwego/hertz/pkg/common/ut"
+	"github.com/cloudwego/hertz/pkg/route"
+	"github.com/yi-nology/rainbow_bridge/pkg/common"
+)
+
+// pipelineAuthorizer allows the key of a single pipeline.
+type pipelineAuthorizer struct {
+	environmentKey, pipelineKey string
+}
+
+func (a pipelineAuthorizer) AuthorizeRuntime(ctx context.Context, token, environmentKey, pipelineKey, scope string) (context.Context, error) {
+	if token != common.APIKeyTokenPrefix+"a" {
+		return nil, common.ErrAPIKeyUnauthorized
+	}
+	if environmentKey != a.environmentKey || pipelineKey != a.pipelineKey {
+		return nil, common.ErrAPIKeyForbidden
+	}
+	return ctx, nil
+}
+
+func TestAPIKeyAuthRejectsMismatchedTarget(t *testing.T) {
+	SetRuntimeAuthorizer(pipelineAuthorizer{environmentKey: "prod", pipelineKey: "a"})
+	defer SetRuntimeAuthorizer(nil)
+
+	engine := route.NewEngine(config.NewOptions(nil))
+	engine.GET("/static", APIKeyAuth(common.APIKeyScopeRuntimeRead), func(ctx context.Context, c *app.RequestContext) {
+		c.String(http.StatusOK, c.Query("environment_key")+"/"+c.Query("pipeline_key"))
+	})
+
+	key := ut.Header{Key: "X-API-Key", Value: common.APIKeyTokenPrefix + "a"}
+	cases := []struct {
+		name    string
+		url     string
+		headers []ut.Header
+		status  int
+	}{
+		{"query", "/static?environment_key=prod&pipeline_key=a", nil, http.StatusOK},
+		{"matching header and query", "/static?environment_key=prod&pipeline_key=a", []ut.Header{{Key: "x-environment", Value: "prod"}, {Key: "x-pipeline", Value: "a"}}, http.StatusOK},
+		{"other pipeline in query", "/static?environment_key=prod&pipeline_key=b", []ut.Header{{Key: "x-environment", Value: "prod"}, {Key: "x-pipeline", Value: "a"}}, http.StatusBadRequest},
+		{"other environment in query", "/static?environment_key=test&pipeline_key=a", []ut.Header{{Key: "x-environment", Value: "prod"}, {Key: "x-pipeline", Value: "a"}}, http.StatusBadRequest},
+		{"other pipeline", "/static?environment_key=prod&pipeline_key=b", nil, http.StatusForbidden},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := ut.PerformRequest(engine, http.MethodGet, tc.url, nil, append(tc.headers, key)...).Result()
+			if resp.StatusCode() != tc.status {
+				t.Fatalf("status = %d, want %d: %s", resp.StatusCode(), tc.status, resp.Body())
+			}
+			if tc.status == http.StatusOK && string(resp.Body()) != "prod/a" {
+				t.Fatalf("handler served %s, want prod/a", resp.Body())
+			}
+		})
+	}
+}
@@ -11,11 +11,21 @@ import (
 
 var jwtConfig *common.JWTConfig
 
+// trustUserIDHeader enables the X-User-Id fallback of Auth.
+var trustUserIDHeader bool
+
 // SetJWTConfig sets the JWT configuration for the middleware
 func SetJWTConfig(config *common.JWTConfig) {
 	jwtConfig = config
 }
 
+// SetTrustUserIDHeader makes Auth accept the user ID in the X-User-Id header
+// of requests without a token. Anyone can send that header, so it must only be
+// enabled behind a gateway that sets it.
+func SetTrustUserIDHeader(trust bool) {
+	trustUserIDHeader = trust
+}
+
 // Auth returns a middleware that extracts user information from JWT token
 // and adds it to the context. This middleware does NOT enforce authentication,
 // it only enriches the context with user info if present.
@@ -36,8 +46,8 @@ func Auth() app.HandlerFunc {
 					}
 				}
 			}
-		} else {
-			// Fallback to X-User-Id header for backward compatibility
+		} else if trustUserIDHeader {
+			// Fallback to X-User-Id header set by a trusted gateway
 			if userHeader := c.GetHeader("X-User-Id"); len(userHeader) > 0 {
 				if id, err := strconv.Atoi(string(userHeader)); err == nil && id > 0 {
 					ctx = common.ContextWithUserID(ctx, id)
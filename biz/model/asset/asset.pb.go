@@ -384,6 +384,564 @@ func (x *GetFileRequest) GetFileId() string {
 	return ""
 }
 
+// CheckAssetReferencesRequest checks the asset references of configs.
+type CheckAssetReferencesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional; all environments when empty.
+	EnvironmentKey string `protobuf:"bytes,1,opt,name=environment_key,json=environmentKey,proto3" form:"environment_key" json:"environment_key,omitempty" query:"environment_key"`
+	// Optional; all pipelines (including environment base configs) when empty.
+	PipelineKey string `protobuf:"bytes,2,opt,name=pipeline_key,json=pipelineKey,proto3" form:"pipeline_key" json:"pipeline_key,omitempty" query:"pipeline_key"`
+}
+
+func (x *CheckAssetReferencesRequest) Reset() {
+	*x = CheckAssetReferencesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_asset_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckAssetReferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAssetReferencesRequest) ProtoMessage() {}
+
+func (x *CheckAssetReferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_asset_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAssetReferencesRequest.ProtoReflect.Descriptor instead.
+func (*CheckAssetReferencesRequest) Descriptor() ([]byte, []int) {
+	return file_asset_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CheckAssetReferencesRequest) GetEnvironmentKey() string {
+	if x != nil {
+		return x.EnvironmentKey
+	}
+	return ""
+}
+
+func (x *CheckAssetReferencesRequest) GetPipelineKey() string {
+	if x != nil {
+		return x.PipelineKey
+	}
+	return ""
+}
+
+// AssetReferenceIssue is an asset reference of a config that cannot be served.
+type AssetReferenceIssue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnvironmentKey string `protobuf:"bytes,1,opt,name=environment_key,json=environmentKey,proto3" form:"environment_key" json:"environment_key,omitempty" query:"environment_key"`
+	PipelineKey    string `protobuf:"bytes,2,opt,name=pipeline_key,json=pipelineKey,proto3" form:"pipeline_key" json:"pipeline_key,omitempty" query:"pipeline_key"`
+	ResourceKey    string `protobuf:"bytes,3,opt,name=resource_key,json=resourceKey,proto3" form:"resource_key" json:"resource_key,omitempty" query:"resource_key"`
+	Alias          string `protobuf:"bytes,4,opt,name=alias,proto3" form:"alias" json:"alias,omitempty" query:"alias"`
+	Name           string `protobuf:"bytes,5,opt,name=name,proto3" form:"name" json:"name,omitempty" query:"name"`
+	FileId         string `protobuf:"bytes,6,opt,name=file_id,json=fileId,proto3" form:"file_id" json:"file_id,omitempty" query:"file_id"`
+	// missing_asset: no asset with file_id; missing_file: the asset file is
+	// gone from storage; foreign_asset: the asset belongs to another
+	// environment or pipeline.
+	Problem string `protobuf:"bytes,7,opt,name=problem,proto3" form:"problem" json:"problem,omitempty" query:"problem"`
+	// The referenced asset, unless it is missing.
+	Asset *common.FileAsset `protobuf:"bytes,8,opt,name=asset,proto3" form:"asset" json:"asset,omitempty" query:"asset"`
+	// The file name the reference is known by, from the asset or the file URL.
+	FileName string `protobuf:"bytes,9,opt,name=file_name,json=fileName,proto3" form:"file_name" json:"file_name,omitempty" query:"file_name"`
+	// Assets of the config's pipeline with the same file name to re-link to.
+	Candidates []*common.FileAsset `protobuf:"bytes,10,rep,name=candidates,proto3" form:"candidates" json:"candidates,omitempty" query:"candidates"`
+}
+
+func (x *AssetReferenceIssue) Reset() {
+	*x = AssetReferenceIssue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_asset_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetReferenceIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetReferenceIssue) ProtoMessage() {}
+
+func (x *AssetReferenceIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_asset_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetReferenceIssue.ProtoReflect.Descriptor instead.
+func (*AssetReferenceIssue) Descriptor() ([]byte, []int) {
+	return file_asset_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AssetReferenceIssue) GetEnvironmentKey() string {
+	if x != nil {
+		return x.EnvironmentKey
+	}
+	return ""
+}
+
+func (x *AssetReferenceIssue) GetPipelineKey() string {
+	if x != nil {
+		return x.PipelineKey
+	}
+	return ""
+}
+
+func (x *AssetReferenceIssue) GetResourceKey() string {
+	if x != nil {
+		return x.ResourceKey
+	}
+	return ""
+}
+
+func (x *AssetReferenceIssue) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *AssetReferenceIssue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AssetReferenceIssue) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *AssetReferenceIssue) GetProblem() string {
+	if x != nil {
+		return x.Problem
+	}
+	return ""
+}
+
+func (x *AssetReferenceIssue) GetAsset() *common.FileAsset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+func (x *AssetReferenceIssue) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *AssetReferenceIssue) GetCandidates() []*common.FileAsset {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+// AssetReferenceCheckData is the data wrapper for an asset reference check.
+type AssetReferenceCheckData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Configs    int32                  `protobuf:"varint,1,opt,name=configs,proto3" form:"configs" json:"configs,omitempty" query:"configs"`
+	References int32                  `protobuf:"varint,2,opt,name=references,proto3" form:"references" json:"references,omitempty" query:"references"`
+	Total      int32                  `protobuf:"varint,3,opt,name=total,proto3" form:"total" json:"total,omitempty" query:"total"`
+	List       []*AssetReferenceIssue `protobuf:"bytes,4,rep,name=list,proto3" form:"list" json:"list,omitempty" query:"list"`
+}
+
+func (x *AssetReferenceCheckData) Reset() {
+	*x = AssetReferenceCheckData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_asset_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetReferenceCheckData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetReferenceCheckData) ProtoMessage() {}
+
+func (x *AssetReferenceCheckData) ProtoReflect() protoreflect.Message {
+	mi := &file_asset_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetReferenceCheckData.ProtoReflect.Descriptor instead.
+func (*AssetReferenceCheckData) Descriptor() ([]byte, []int) {
+	return file_asset_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AssetReferenceCheckData) GetConfigs() int32 {
+	if x != nil {
+		return x.Configs
+	}
+	return 0
+}
+
+func (x *AssetReferenceCheckData) GetReferences() int32 {
+	if x != nil {
+		return x.References
+	}
+	return 0
+}
+
+func (x *AssetReferenceCheckData) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *AssetReferenceCheckData) GetList() []*AssetReferenceIssue {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// RepairAssetReferenceRequest repairs one asset reference of a config.
+type RepairAssetReferenceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnvironmentKey string `protobuf:"bytes,1,opt,name=environment_key,json=environmentKey,proto3" form:"environment_key" json:"environment_key,omitempty" query:"environment_key"`
+	PipelineKey    string `protobuf:"bytes,2,opt,name=pipeline_key,json=pipelineKey,proto3" form:"pipeline_key" json:"pipeline_key,omitempty" query:"pipeline_key"`
+	ResourceKey    string `protobuf:"bytes,3,opt,name=resource_key,json=resourceKey,proto3" form:"resource_key" json:"resource_key,omitempty" query:"resource_key"`
+	FileId         string `protobuf:"bytes,4,opt,name=file_id,json=fileId,proto3" form:"file_id" json:"file_id,omitempty" query:"file_id"`
+	// relink: point the reference at target_file_id, or at the only same-named
+	// asset of the config's pipeline when empty; copy: copy a foreign asset
+	// into the config's pipeline and point the reference at the copy.
+	Action       string `protobuf:"bytes,5,opt,name=action,proto3" form:"action" json:"action,omitempty" query:"action"`
+	TargetFileId string `protobuf:"bytes,6,opt,name=target_file_id,json=targetFileId,proto3" form:"target_file_id" json:"target_file_id,omitempty" query:"target_file_id"`
+}
+
+func (x *RepairAssetReferenceRequest) Reset() {
+	*x = RepairAssetReferenceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_asset_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepairAssetReferenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepairAssetReferenceRequest) ProtoMessage() {}
+
+func (x *RepairAssetReferenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_asset_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepairAssetReferenceRequest.ProtoReflect.Descriptor instead.
+func (*RepairAssetReferenceRequest) Descriptor() ([]byte, []int) {
+	return file_asset_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RepairAssetReferenceRequest) GetEnvironmentKey() string {
+	if x != nil {
+		return x.EnvironmentKey
+	}
+	return ""
+}
+
+func (x *RepairAssetReferenceRequest) GetPipelineKey() string {
+	if x != nil {
+		return x.PipelineKey
+	}
+	return ""
+}
+
+func (x *RepairAssetReferenceRequest) GetResourceKey() string {
+	if x != nil {
+		return x.ResourceKey
+	}
+	return ""
+}
+
+func (x *RepairAssetReferenceRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *RepairAssetReferenceRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *RepairAssetReferenceRequest) GetTargetFileId() string {
+	if x != nil {
+		return x.TargetFileId
+	}
+	return ""
+}
+
+// AssetReferenceRepairData is the data wrapper for a repaired reference.
+type AssetReferenceRepairData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config *common.ResourceConfig `protobuf:"bytes,1,opt,name=config,proto3" form:"config" json:"config,omitempty" query:"config"`
+	Asset  *common.FileAsset      `protobuf:"bytes,2,opt,name=asset,proto3" form:"asset" json:"asset,omitempty" query:"asset"`
+}
+
+func (x *AssetReferenceRepairData) Reset() {
+	*x = AssetReferenceRepairData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_asset_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetReferenceRepairData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetReferenceRepairData) ProtoMessage() {}
+
+func (x *AssetReferenceRepairData) ProtoReflect() protoreflect.Message {
+	mi := &file_asset_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetReferenceRepairData.ProtoReflect.Descriptor instead.
+func (*AssetReferenceRepairData) Descriptor() ([]byte, []int) {
+	return file_asset_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AssetReferenceRepairData) GetConfig() *common.ResourceConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *AssetReferenceRepairData) GetAsset() *common.FileAsset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+// AssetReferenceCheckResponse is a unified response for asset reference checks.
+// Format: { code, msg, data: { configs, references, total, list } }
+type AssetReferenceCheckResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code  int32                    `protobuf:"varint,1,opt,name=code,proto3" form:"code" json:"code,omitempty" query:"code"`
+	Msg   string                   `protobuf:"bytes,2,opt,name=msg,proto3" form:"msg" json:"msg,omitempty" query:"msg"`
+	Error string                   `protobuf:"bytes,3,opt,name=error,proto3" form:"error" json:"error,omitempty" query:"error"`
+	Data  *AssetReferenceCheckData `protobuf:"bytes,4,opt,name=data,proto3" form:"data" json:"data,omitempty" query:"data"`
+}
+
+func (x *AssetReferenceCheckResponse) Reset() {
+	*x = AssetReferenceCheckResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_asset_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetReferenceCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetReferenceCheckResponse) ProtoMessage() {}
+
+func (x *AssetReferenceCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_asset_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetReferenceCheckResponse.ProtoReflect.Descriptor instead.
+func (*AssetReferenceCheckResponse) Descriptor() ([]byte, []int) {
+	return file_asset_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AssetReferenceCheckResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *AssetReferenceCheckResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *AssetReferenceCheckResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AssetReferenceCheckResponse) GetData() *AssetReferenceCheckData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// AssetReferenceRepairResponse is a unified response for reference repairs.
+// Format: { code, msg, data: { config, asset } }
+type AssetReferenceRepairResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code  int32                     `protobuf:"varint,1,opt,name=code,proto3" form:"code" json:"code,omitempty" query:"code"`
+	Msg   string                    `protobuf:"bytes,2,opt,name=msg,proto3" form:"msg" json:"msg,omitempty" query:"msg"`
+	Error string                    `protobuf:"bytes,3,opt,name=error,proto3" form:"error" json:"error,omitempty" query:"error"`
+	Data  *AssetReferenceRepairData `protobuf:"bytes,4,opt,name=data,proto3" form:"data" json:"data,omitempty" query:"data"`
+	// Set with code 202 when the config update was queued as a change request.
+	ChangeRequestId int64 `protobuf:"varint,5,opt,name=change_request_id,json=changeRequestId,proto3" form:"change_request_id" json:"change_request_id,omitempty" query:"change_request_id"`
+}
+
+func (x *AssetReferenceRepairResponse) Reset() {
+	*x = AssetReferenceRepairResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_asset_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetReferenceRepairResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetReferenceRepairResponse) ProtoMessage() {}
+
+func (x *AssetReferenceRepairResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_asset_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetReferenceRepairResponse.ProtoReflect.Descriptor instead.
+func (*AssetReferenceRepairResponse) Descriptor() ([]byte, []int) {
+	return file_asset_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AssetReferenceRepairResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *AssetReferenceRepairResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *AssetReferenceRepairResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AssetReferenceRepairResponse) GetData() *AssetReferenceRepairData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *AssetReferenceRepairResponse) GetChangeRequestId() int64 {
+	if x != nil {
+		return x.ChangeRequestId
+	}
+	return 0
+}
+
 var File_asset_proto protoreflect.FileDescriptor
 
 var file_asset_proto_rawDesc = []byte{
@@ -423,29 +981,127 @@ var file_asset_proto_rawDesc = []byte{
 	0x73, 0x65, 0x74, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64,
 	0x61, 0x74, 0x61, 0x22, 0x29, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x32, 0x97,
-	0x02, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x51, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0xca, 0xc1, 0x18, 0x12,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2f, 0x6c, 0x69,
-	0x73, 0x74, 0x12, 0x57, 0x0a, 0x06, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x17, 0x2e, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2e, 0x55, 0x70,
-	0x6c, 0x6f, 0x61, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x18, 0xd2, 0xc1, 0x18, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x2f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x5b, 0x0a, 0x07, 0x47,
-	0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x15, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2e, 0x47,
-	0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0xca, 0xc1, 0x18, 0x1c, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x7b,
-	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x79, 0x69, 0x2d, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
-	0x2f, 0x72, 0x61, 0x69, 0x6e, 0x62, 0x6f, 0x77, 0x5f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2f,
-	0x62, 0x69, 0x7a, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x69,
+	0x0a, 0x1b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a,
+	0x0f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4b, 0x65, 0x79, 0x22, 0xda, 0x02, 0x0a, 0x13, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x6e, 0x76, 0x69,
+	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a,
+	0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x69,
+	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c,
+	0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x27, 0x0a,
+	0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
+	0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x17, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x2e, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x04, 0x6c, 0x69,
+	0x73, 0x74, 0x22, 0xe3, 0x01, 0x0a, 0x1b, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x70,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x65,
+	0x79, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x73, 0x0a, 0x18, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x27, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x46, 0x69, 0x6c,
+	0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x22, 0x8d, 0x01,
+	0x0a, 0x1b, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x32, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2e,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xbb, 0x01,
+	0x0a, 0x1c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x70, 0x61, 0x69, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x2a, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x32, 0xa5, 0x04, 0x0a, 0x0c,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x04,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0xca, 0xc1, 0x18, 0x12, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12,
+	0x57, 0x0a, 0x06, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x17, 0x2e, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18,
+	0xd2, 0xc1, 0x18, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x2f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x7d, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x22, 0xca, 0xc1, 0x18, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x7f, 0x0a, 0x0f, 0x52, 0x65, 0x70, 0x61, 0x69,
+	0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x2e, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x23, 0xd2, 0xc1, 0x18, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x2f, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x12, 0x69, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x46,
+	0x69, 0x6c, 0x65, 0x12, 0x15, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2e, 0xca, 0xc1, 0x18, 0x2a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x7b, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x3d, 0x2a, 0x7d, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x79, 0x69, 0x2d, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x2f, 0x72, 0x61, 0x69, 0x6e,
+	0x62, 0x6f, 0x77, 0x5f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2f, 0x62, 0x69, 0x7a, 0x2f, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -460,33 +1116,52 @@ func file_asset_proto_rawDescGZIP() []byte {
 	return file_asset_proto_rawDescData
 }
 
-var file_asset_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_asset_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_asset_proto_goTypes = []interface{}{
-	(*ListAssetRequest)(nil),       // 0: asset.ListAssetRequest
-	(*AssetData)(nil),              // 1: asset.AssetData
-	(*AssetListData)(nil),          // 2: asset.AssetListData
-	(*AssetListResponse)(nil),      // 3: asset.AssetListResponse
-	(*UploadAssetResponse)(nil),    // 4: asset.UploadAssetResponse
-	(*GetFileRequest)(nil),         // 5: asset.GetFileRequest
-	(*common.FileAsset)(nil),       // 6: common.FileAsset
-	(*common.OperateResponse)(nil), // 7: common.OperateResponse
+	(*ListAssetRequest)(nil),             // 0: asset.ListAssetRequest
+	(*AssetData)(nil),                    // 1: asset.AssetData
+	(*AssetListData)(nil),                // 2: asset.AssetListData
+	(*AssetListResponse)(nil),            // 3: asset.AssetListResponse
+	(*UploadAssetResponse)(nil),          // 4: asset.UploadAssetResponse
+	(*GetFileRequest)(nil),               // 5: asset.GetFileRequest
+	(*CheckAssetReferencesRequest)(nil),  // 6: asset.CheckAssetReferencesRequest
+	(*AssetReferenceIssue)(nil),          // 7: asset.AssetReferenceIssue
+	(*AssetReferenceCheckData)(nil),      // 8: asset.AssetReferenceCheckData
+	(*RepairAssetReferenceRequest)(nil),  // 9: asset.RepairAssetReferenceRequest
+	(*AssetReferenceRepairData)(nil),     // 10: asset.AssetReferenceRepairData
+	(*AssetReferenceCheckResponse)(nil),  // 11: asset.AssetReferenceCheckResponse
+	(*AssetReferenceRepairResponse)(nil), // 12: asset.AssetReferenceRepairResponse
+	(*common.FileAsset)(nil),             // 13: common.FileAsset
+	(*common.ResourceConfig)(nil),        // 14: common.ResourceConfig
+	(*common.OperateResponse)(nil),       // 15: common.OperateResponse
 }
 var file_asset_proto_depIdxs = []int32{
-	6, // 0: asset.AssetData.asset:type_name -> common.FileAsset
-	6, // 1: asset.AssetListData.list:type_name -> common.FileAsset
-	2, // 2: asset.AssetListResponse.data:type_name -> asset.AssetListData
-	1, // 3: asset.UploadAssetResponse.data:type_name -> asset.AssetData
-	0, // 4: asset.AssetService.List:input_type -> asset.ListAssetRequest
-	0, // 5: asset.AssetService.Upload:input_type -> asset.ListAssetRequest
-	5, // 6: asset.AssetService.GetFile:input_type -> asset.GetFileRequest
-	3, // 7: asset.AssetService.List:output_type -> asset.AssetListResponse
-	4, // 8: asset.AssetService.Upload:output_type -> asset.UploadAssetResponse
-	7, // 9: asset.AssetService.GetFile:output_type -> common.OperateResponse
-	7, // [7:10] is the sub-list for method output_type
-	4, // [4:7] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	13, // 0: asset.AssetData.asset:type_name -> common.FileAsset
+	13, // 1: asset.AssetListData.list:type_name -> common.FileAsset
+	2,  // 2: asset.AssetListResponse.data:type_name -> asset.AssetListData
+	1,  // 3: asset.UploadAssetResponse.data:type_name -> asset.AssetData
+	13, // 4: asset.AssetReferenceIssue.asset:type_name -> common.FileAsset
+	13, // 5: asset.AssetReferenceIssue.candidates:type_name -> common.FileAsset
+	7,  // 6: asset.AssetReferenceCheckData.list:type_name -> asset.AssetReferenceIssue
+	14, // 7: asset.AssetReferenceRepairData.config:type_name -> common.ResourceConfig
+	13, // 8: asset.AssetReferenceRepairData.asset:type_name -> common.FileAsset
+	8,  // 9: asset.AssetReferenceCheckResponse.data:type_name -> asset.AssetReferenceCheckData
+	10, // 10: asset.AssetReferenceRepairResponse.data:type_name -> asset.AssetReferenceRepairData
+	0,  // 11: asset.AssetService.List:input_type -> asset.ListAssetRequest
+	0,  // 12: asset.AssetService.Upload:input_type -> asset.ListAssetRequest
+	6,  // 13: asset.AssetService.CheckReferences:input_type -> asset.CheckAssetReferencesRequest
+	9,  // 14: asset.AssetService.RepairReference:input_type -> asset.RepairAssetReferenceRequest
+	5,  // 15: asset.AssetService.GetFile:input_type -> asset.GetFileRequest
+	3,  // 16: asset.AssetService.List:output_type -> asset.AssetListResponse
+	4,  // 17: asset.AssetService.Upload:output_type -> asset.UploadAssetResponse
+	11, // 18: asset.AssetService.CheckReferences:output_type -> asset.AssetReferenceCheckResponse
+	12, // 19: asset.AssetService.RepairReference:output_type -> asset.AssetReferenceRepairResponse
+	15, // 20: asset.AssetService.GetFile:output_type -> common.OperateResponse
+	16, // [16:21] is the sub-list for method output_type
+	11, // [11:16] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_asset_proto_init() }
@@ -567,6 +1242,90 @@ func file_asset_proto_init() {
 				return nil
 			}
 		}
+		file_asset_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckAssetReferencesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_asset_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetReferenceIssue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_asset_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetReferenceCheckData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_asset_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepairAssetReferenceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_asset_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetReferenceRepairData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_asset_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetReferenceCheckResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_asset_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetReferenceRepairResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -574,7 +1333,7 @@ func file_asset_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_asset_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
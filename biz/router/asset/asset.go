@@ -29,6 +29,11 @@ func Register(r *server.Hertz) {
 					_file := _asset.Group("/file", _fileMw()...)
 					_file.GET("/*filepath", append(_getfileMw(), asset.GetFile)...)
 				}
+				{
+					_references := _asset.Group("/references", _referencesMw()...)
+					_references.GET("/check", append(_checkreferencesMw(), asset.CheckReferences)...)
+					_references.POST("/repair", append(_repairreferenceMw(), asset.RepairReference)...)
+				}
 			}
 		}
 	}
@@ -45,3 +45,17 @@ func _getfileMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _referencesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _checkreferencesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _repairreferenceMw() []app.HandlerFunc {
+	return middleware.WriteLockMw()
+}
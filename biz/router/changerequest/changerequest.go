@@ -0,0 +1,34 @@
+// Code generated by hertz generator. DO NOT EDIT.
+
+package changerequest
+
+import (
+	"github.com/cloudwego/hertz/pkg/app/server"
+	changerequest "github.com/yi-nology/rainbow_bridge/biz/handler/changerequest"
+)
+
+/*
+ This file will register all the routes of the services in the master idl.
+ And it will update automatically when you use the "update" command for the idl.
+ So don't modify the contents of the file, or your code will be deleted when it is updated.
+*/
+
+// Register register routes based on the IDL 'api.${HTTP Method}' annotation.
+func Register(r *server.Hertz) {
+
+	root := r.Group("/", rootMw()...)
+	{
+		_api := root.Group("/api", _apiMw()...)
+		{
+			_v1 := _api.Group("/v1", _v1Mw()...)
+			{
+				_change_request := _v1.Group("/change-request", _change_requestMw()...)
+				_change_request.POST("/approve", append(_approveMw(), changerequest.Approve)...)
+				_change_request.POST("/comment", append(_commentMw(), changerequest.Comment)...)
+				_change_request.GET("/detail", append(_detailMw(), changerequest.Detail)...)
+				_change_request.GET("/list", append(_listMw(), changerequest.List)...)
+				_change_request.POST("/reject", append(_rejectMw(), changerequest.Reject)...)
+			}
+		}
+	}
+}
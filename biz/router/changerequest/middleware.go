@@ -0,0 +1,50 @@
+// Code generated by hertz generator.
+
+package changerequest
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/yi-nology/rainbow_bridge/biz/middleware"
+)
+
+func rootMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _apiMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _v1Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _change_requestMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _approveMw() []app.HandlerFunc {
+	return middleware.WriteLockMw()
+}
+
+func _commentMw() []app.HandlerFunc {
+	return middleware.WriteLockMw()
+}
+
+func _detailMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _rejectMw() []app.HandlerFunc {
+	return middleware.WriteLockMw()
+}
@@ -23,11 +23,31 @@ func Register(r *server.Hertz) {
 			_v1 := _api.Group("/v1", _v1Mw()...)
 			{
 				_config := _v1.Group("/config", _configMw()...)
+				_config.POST("/batch", append(_batchMw(), config.Batch)...)
 				_config.POST("/create", append(_createMw(), config.Create)...)
 				_config.POST("/delete", append(_deleteMw(), config.Delete)...)
 				_config.GET("/detail", append(_detailMw(), config.Detail)...)
+				_config.GET("/history", append(_historyMw(), config.History)...)
+				_config.POST("/labels", append(_updatelabelsMw(), config.UpdateLabels)...)
 				_config.GET("/list", append(_listMw(), config.List)...)
+				_config.GET("/preview", append(_previewMw(), config.Preview)...)
+				_config.POST("/reveal", append(_revealMw(), config.Reveal)...)
+				_config.POST("/rollback", append(_rollbackMw(), config.Rollback)...)
+				_config.GET("/schedule", append(_scheduleMw(), config.Schedule)...)
+				_config.GET("/search", append(_searchMw(), config.Search)...)
+				_config.POST("/translations", append(_updatetranslationsMw(), config.UpdateTranslations)...)
+				_translations := _config.Group("/translations", _translationsMw()...)
+				_translations.GET("/missing", append(_missingtranslationsMw(), config.MissingTranslations)...)
 				_config.POST("/update", append(_updateMw(), config.Update)...)
+				{
+					_alias := _config.Group("/alias", _aliasMw()...)
+					_alias.GET("/redirects", append(_listaliasredirectsMw(), config.ListAliasRedirects)...)
+					_alias.POST("/rename", append(_renamealiasMw(), config.RenameAlias)...)
+				}
+				{
+					_secret := _config.Group("/secret", _secretMw()...)
+					_secret.POST("/rotate", append(_rotatesecretsMw(), config.RotateSecrets)...)
+				}
 			}
 		}
 	}
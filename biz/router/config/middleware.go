@@ -48,3 +48,75 @@ func _listMw() []app.HandlerFunc {
 func _updateMw() []app.HandlerFunc {
 	return middleware.WriteLockMw()
 }
+
+func _historyMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _rollbackMw() []app.HandlerFunc {
+	return middleware.WriteLockMw()
+}
+
+func _previewMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _revealMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _secretMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _rotatesecretsMw() []app.HandlerFunc {
+	return middleware.WriteLockMw()
+}
+
+func _updatelabelsMw() []app.HandlerFunc {
+	return middleware.WriteLockMw()
+}
+
+func _searchMw() []app.HandlerFunc {
+	return nil
+}
+
+func _scheduleMw() []app.HandlerFunc {
+	return nil
+}
+
+func _batchMw() []app.HandlerFunc {
+	return middleware.WriteLockMw()
+}
+
+func _aliasMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listaliasredirectsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _renamealiasMw() []app.HandlerFunc {
+	return middleware.WriteLockMw()
+}
+
+func _translationsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updatetranslationsMw() []app.HandlerFunc {
+	return middleware.WriteLockMw()
+}
+
+func _missingtranslationsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
@@ -28,6 +28,14 @@ func Register(r *server.Hertz) {
 				_environment.GET("/detail", append(_detailMw(), environment.Detail)...)
 				_environment.GET("/list", append(_listMw(), environment.List)...)
 				_environment.POST("/update", append(_updateMw(), environment.Update)...)
+				{
+					_freeze := _environment.Group("/freeze", _freezeMw()...)
+					_freeze.POST("/create", append(_createfreezeMw(), environment.CreateFreeze)...)
+					_freeze.POST("/delete", append(_deletefreezeMw(), environment.DeleteFreeze)...)
+					_freeze.GET("/list", append(_listfreezesMw(), environment.ListFreezes)...)
+					_freeze.GET("/overrides", append(_listfreezeoverridesMw(), environment.ListFreezeOverrides)...)
+					_freeze.POST("/update", append(_updatefreezeMw(), environment.UpdateFreeze)...)
+				}
 			}
 		}
 	}
@@ -48,3 +48,30 @@ func _listMw() []app.HandlerFunc {
 func _updateMw() []app.HandlerFunc {
 	return middleware.WriteLockMw()
 }
+
+func _freezeMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createfreezeMw() []app.HandlerFunc {
+	return middleware.WriteLockMw()
+}
+
+func _deletefreezeMw() []app.HandlerFunc {
+	return middleware.WriteLockMw()
+}
+
+func _listfreezesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listfreezeoverridesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updatefreezeMw() []app.HandlerFunc {
+	return middleware.WriteLockMw()
+}
@@ -48,3 +48,29 @@ func _listMw() []app.HandlerFunc {
 func _updateMw() []app.HandlerFunc {
 	return middleware.WriteLockMw()
 }
+
+func _apikeyMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createapikeyMw() []app.HandlerFunc {
+	return middleware.WriteLockMw()
+}
+
+func _setapikeyexpiryMw() []app.HandlerFunc {
+	return middleware.WriteLockMw()
+}
+
+func _listapikeysMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _revokeapikeyMw() []app.HandlerFunc {
+	return middleware.WriteLockMw()
+}
+
+func _rotateapikeyMw() []app.HandlerFunc {
+	return middleware.WriteLockMw()
+}
@@ -28,6 +28,14 @@ func Register(r *server.Hertz) {
 				_pipeline.GET("/detail", append(_detailMw(), pipeline.Detail)...)
 				_pipeline.GET("/list", append(_listMw(), pipeline.List)...)
 				_pipeline.POST("/update", append(_updateMw(), pipeline.Update)...)
+				{
+					_apikey := _pipeline.Group("/apikey", _apikeyMw()...)
+					_apikey.POST("/create", append(_createapikeyMw(), pipeline.CreateAPIKey)...)
+					_apikey.POST("/expiry", append(_setapikeyexpiryMw(), pipeline.SetAPIKeyExpiry)...)
+					_apikey.GET("/list", append(_listapikeysMw(), pipeline.ListAPIKeys)...)
+					_apikey.POST("/revoke", append(_revokeapikeyMw(), pipeline.RevokeAPIKey)...)
+					_apikey.POST("/rotate", append(_rotateapikeyMw(), pipeline.RotateAPIKey)...)
+				}
 			}
 		}
 	}
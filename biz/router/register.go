@@ -6,16 +6,24 @@ import (
 	"github.com/cloudwego/hertz/pkg/app/server"
 	"github.com/yi-nology/rainbow_bridge/biz/handler"
 	"github.com/yi-nology/rainbow_bridge/biz/handler/asset"
+	changerequesthandler "github.com/yi-nology/rainbow_bridge/biz/handler/changerequest"
 	"github.com/yi-nology/rainbow_bridge/biz/handler/config"
 	environmenthandler "github.com/yi-nology/rainbow_bridge/biz/handler/environment"
 	pipelinehandler "github.com/yi-nology/rainbow_bridge/biz/handler/pipeline"
+	releasehandler "github.com/yi-nology/rainbow_bridge/biz/handler/release"
+	rollouthandler "github.com/yi-nology/rainbow_bridge/biz/handler/rollout"
 	runtimehandler "github.com/yi-nology/rainbow_bridge/biz/handler/runtime"
+	schemahandler "github.com/yi-nology/rainbow_bridge/biz/handler/schema"
 	"github.com/yi-nology/rainbow_bridge/biz/handler/transfer"
 	assetrouter "github.com/yi-nology/rainbow_bridge/biz/router/asset"
+	changerequestrouter "github.com/yi-nology/rainbow_bridge/biz/router/changerequest"
 	configrouter "github.com/yi-nology/rainbow_bridge/biz/router/config"
 	"github.com/yi-nology/rainbow_bridge/biz/router/environment"
 	"github.com/yi-nology/rainbow_bridge/biz/router/pipeline"
+	releaserouter "github.com/yi-nology/rainbow_bridge/biz/router/release"
+	rolloutrouter "github.com/yi-nology/rainbow_bridge/biz/router/rollout"
 	runtimerouter "github.com/yi-nology/rainbow_bridge/biz/router/runtime"
+	schemarouter "github.com/yi-nology/rainbow_bridge/biz/router/schema"
 	transferrouter "github.com/yi-nology/rainbow_bridge/biz/router/transfer"
 	version "github.com/yi-nology/rainbow_bridge/biz/router/version"
 	"github.com/yi-nology/rainbow_bridge/biz/service"
@@ -29,6 +37,10 @@ func InitHandlers(svc *service.Service) {
 	environmenthandler.SetService(svc)
 	pipelinehandler.SetService(svc)
 	runtimehandler.SetService(svc)
+	releasehandler.SetService(svc)
+	rollouthandler.SetService(svc)
+	schemahandler.SetService(svc)
+	changerequesthandler.SetService(svc)
 }
 
 // GeneratedRegister registers routers generated by IDL.
@@ -45,6 +57,10 @@ func GeneratedRegister(r *server.Hertz) {
 	assetrouter.Register(r)
 	configrouter.Register(r)
 	transferrouter.Register(r)
+	releaserouter.Register(r)
+	rolloutrouter.Register(r)
+	schemarouter.Register(r)
+	changerequestrouter.Register(r)
 
 	// Health Check
 	r.GET("/ping", handler.Ping)
@@ -0,0 +1,51 @@
+// Code generated by hertz generator.
+
+package release
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/yi-nology/rainbow_bridge/biz/middleware"
+)
+
+func rootMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _apiMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _v1Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _releaseMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _activateMw() []app.HandlerFunc {
+	return middleware.WriteLockMw()
+}
+
+func _compareMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _detailMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _publishMw() []app.HandlerFunc {
+	return middleware.WriteLockMw()
+}
@@ -0,0 +1,34 @@
+// Code generated by hertz generator. DO NOT EDIT.
+
+package release
+
+import (
+	"github.com/cloudwego/hertz/pkg/app/server"
+	release "github.com/yi-nology/rainbow_bridge/biz/handler/release"
+)
+
+/*
+ This file will register all the routes of the services in the master idl.
+ And it will update automatically when you use the "update" command for the idl.
+ So don't modify the contents of the file, or your code will be deleted when it is updated.
+*/
+
+// Register register routes based on the IDL 'api.${HTTP Method}' annotation.
+func Register(r *server.Hertz) {
+
+	root := r.Group("/", rootMw()...)
+	{
+		_api := root.Group("/api", _apiMw()...)
+		{
+			_v1 := _api.Group("/v1", _v1Mw()...)
+			{
+				_release := _v1.Group("/release", _releaseMw()...)
+				_release.POST("/activate", append(_activateMw(), release.Activate)...)
+				_release.GET("/compare", append(_compareMw(), release.Compare)...)
+				_release.GET("/detail", append(_detailMw(), release.Detail)...)
+				_release.GET("/list", append(_listMw(), release.List)...)
+				_release.POST("/publish", append(_publishMw(), release.Publish)...)
+			}
+		}
+	}
+}
@@ -0,0 +1,53 @@
+// Code generated by hertz generator.
+
+package rollout
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/yi-nology/rainbow_bridge/biz/middleware"
+)
+
+func rootMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _apiMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _v1Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _rolloutMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _abortMw() []app.HandlerFunc {
+	return middleware.WriteLockMw()
+}
+
+func _createMw() []app.HandlerFunc {
+	return middleware.WriteLockMw()
+}
+
+func _listMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _pauseMw() []app.HandlerFunc {
+	return middleware.WriteLockMw()
+}
+
+func _promoteMw() []app.HandlerFunc {
+	return middleware.WriteLockMw()
+}
+
+func _rampMw() []app.HandlerFunc {
+	return middleware.WriteLockMw()
+}
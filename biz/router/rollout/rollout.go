@@ -0,0 +1,35 @@
+// Code generated by hertz generator. DO NOT EDIT.
+
+package rollout
+
+import (
+	"github.com/cloudwego/hertz/pkg/app/server"
+	rollout "github.com/yi-nology/rainbow_bridge/biz/handler/rollout"
+)
+
+/*
+ This file will register all the routes of the services in the master idl.
+ And it will update automatically when you use the "update" command for the idl.
+ So don't modify the contents of the file, or your code will be deleted when it is updated.
+*/
+
+// Register register routes based on the IDL 'api.${HTTP Method}' annotation.
+func Register(r *server.Hertz) {
+
+	root := r.Group("/", rootMw()...)
+	{
+		_api := root.Group("/api", _apiMw()...)
+		{
+			_v1 := _api.Group("/v1", _v1Mw()...)
+			{
+				_rollout := _v1.Group("/rollout", _rolloutMw()...)
+				_rollout.POST("/abort", append(_abortMw(), rollout.Abort)...)
+				_rollout.POST("/create", append(_createMw(), rollout.Create)...)
+				_rollout.GET("/list", append(_listMw(), rollout.List)...)
+				_rollout.POST("/pause", append(_pauseMw(), rollout.Pause)...)
+				_rollout.POST("/promote", append(_promoteMw(), rollout.Promote)...)
+				_rollout.POST("/ramp", append(_rampMw(), rollout.Ramp)...)
+			}
+		}
+	}
+}
@@ -4,6 +4,8 @@ package runtime
 
 import (
 	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/yi-nology/rainbow_bridge/biz/middleware"
+	"github.com/yi-nology/rainbow_bridge/pkg/common"
 )
 
 func rootMw() []app.HandlerFunc {
@@ -27,16 +29,30 @@ func _runtimeMw() []app.HandlerFunc {
 }
 
 func _getconfigMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return []app.HandlerFunc{middleware.APIKeyAuth(common.APIKeyScopeRuntimeRead)}
 }
 
 func _exportstaticMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return []app.HandlerFunc{middleware.APIKeyAuth(common.APIKeyScopeStaticExport)}
 }
 
+// _getoverviewMw leaves the overview public on purpose: it lists environment
+// and pipeline keys and names only, and is not scoped to one pipeline, which
+// API keys are.
 func _getoverviewMw() []app.HandlerFunc {
-	// your code...
+	return nil
+}
+
+func _watchMw() []app.HandlerFunc {
+	return []app.HandlerFunc{middleware.APIKeyAuth(common.APIKeyScopeRuntimeRead)}
+}
+
+func _streamMw() []app.HandlerFunc {
+	return []app.HandlerFunc{middleware.APIKeyAuth(common.APIKeyScopeRuntimeRead)}
+}
+
+// _getsigningkeysMw leaves the signing keys public on purpose: they are
+// public keys that clients need to verify static packages.
+func _getsigningkeysMw() []app.HandlerFunc {
 	return nil
 }
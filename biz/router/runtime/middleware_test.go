@@ -0,0 +1,30 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// TestRuntimeRouteAuth checks which runtime routes require an API key; the
+// overview and signing keys serve no pipeline data and stay public.
+func TestRuntimeRouteAuth(t *testing.T) {
+	for name, mw := range map[string]func() []app.HandlerFunc{
+		"config": _getconfigMw,
+		"static": _exportstaticMw,
+		"watch":  _watchMw,
+		"stream": _streamMw,
+	} {
+		if len(mw()) != 1 {
+			t.Errorf("%s route middleware = %d handlers, want the API key check", name, len(mw()))
+		}
+	}
+	for name, mw := range map[string]func() []app.HandlerFunc{
+		"overview":     _getoverviewMw,
+		"signing-keys": _getsigningkeysMw,
+	} {
+		if len(mw()) != 0 {
+			t.Errorf("%s route middleware = %d handlers, want it public", name, len(mw()))
+		}
+	}
+}
@@ -25,7 +25,10 @@ func Register(r *server.Hertz) {
 				_runtime := _v1.Group("/runtime", _runtimeMw()...)
 				_runtime.GET("/config", append(_getconfigMw(), runtime.GetConfig)...)
 				_runtime.GET("/overview", append(_getoverviewMw(), runtime.GetOverview)...)
+				_runtime.GET("/signing-keys", append(_getsigningkeysMw(), runtime.GetSigningKeys)...)
 				_runtime.GET("/static", append(_exportstaticMw(), runtime.ExportStatic)...)
+				_runtime.GET("/stream", append(_streamMw(), runtime.Stream)...)
+				_runtime.GET("/watch", append(_watchMw(), runtime.Watch)...)
 			}
 		}
 	}
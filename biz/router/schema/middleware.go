@@ -0,0 +1,50 @@
+// Code generated by hertz generator.
+
+package schema
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/yi-nology/rainbow_bridge/biz/middleware"
+)
+
+func rootMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _apiMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _v1Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _schemaMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createMw() []app.HandlerFunc {
+	return middleware.WriteLockMw()
+}
+
+func _deleteMw() []app.HandlerFunc {
+	return middleware.WriteLockMw()
+}
+
+func _listMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _revalidateMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updateMw() []app.HandlerFunc {
+	return middleware.WriteLockMw()
+}
@@ -0,0 +1,34 @@
+// Code generated by hertz generator. DO NOT EDIT.
+
+package schema
+
+import (
+	"github.com/cloudwego/hertz/pkg/app/server"
+	schema "github.com/yi-nology/rainbow_bridge/biz/handler/schema"
+)
+
+/*
+ This file will register all the routes of the services in the master idl.
+ And it will update automatically when you use the "update" command for the idl.
+ So don't modify the contents of the file, or your code will be deleted when it is updated.
+*/
+
+// Register register routes based on the IDL 'api.${HTTP Method}' annotation.
+func Register(r *server.Hertz) {
+
+	root := r.Group("/", rootMw()...)
+	{
+		_api := root.Group("/api", _apiMw()...)
+		{
+			_v1 := _api.Group("/v1", _v1Mw()...)
+			{
+				_schema := _v1.Group("/schema", _schemaMw()...)
+				_schema.POST("/create", append(_createMw(), schema.Create)...)
+				_schema.POST("/delete", append(_deleteMw(), schema.Delete)...)
+				_schema.GET("/list", append(_listMw(), schema.List)...)
+				_schema.POST("/revalidate", append(_revalidateMw(), schema.Revalidate)...)
+				_schema.POST("/update", append(_updateMw(), schema.Update)...)
+			}
+		}
+	}
+}
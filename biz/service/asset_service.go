@@ -1,9 +1,11 @@
 package service
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -12,7 +14,9 @@ import (
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	assetpb "github.com/yi-nology/rainbow_bridge/biz/model/asset"
 	"github.com/yi-nology/rainbow_bridge/biz/model/common"
+	"gorm.io/gorm"
 )
 
 // --------------------- MinIO helper functions ---------------------
@@ -73,6 +77,11 @@ func (s *Service) UploadAsset(ctx context.Context, input *FileUploadInput) (*com
 	if input.EnvironmentKey == "" || input.PipelineKey == "" {
 		return nil, "", errors.New("environment_key and pipeline_key are required")
 	}
+	// 冻结期内先拒绝，避免文件已写入存储后才失败
+	ctx = withFreezeScope(ctx)
+	if err := s.logic.precheckEnvironmentWritable(ctx, "asset.create", input.EnvironmentKey); err != nil {
+		return nil, "", err
+	}
 
 	fileID := uuid.NewString()
 	fileName := input.FileName
@@ -82,36 +91,9 @@ func (s *Service) UploadAsset(ctx context.Context, input *FileUploadInput) (*com
 
 	contentType := detectContentType(input.ContentType, input.Data)
 	fileSize := int64(len(input.Data))
-	var relativePath string
-
-	// 根据配置选择存储方式
-	if s.config != nil && s.config.Storage.Type == "minio" {
-		// 使用 MinIO 存储
-		client, err := s.getMinioClient()
-		if err != nil {
-			return nil, "", err
-		}
-
-		objectName := filepath.Join(input.EnvironmentKey, input.PipelineKey, fileID, fileName)
-		_, err = client.PutObject(ctx, s.config.Storage.Minio.Bucket, objectName, strings.NewReader(string(input.Data)), fileSize, minio.PutObjectOptions{
-			ContentType: contentType,
-		})
-		if err != nil {
-			return nil, "", err
-		}
-
-		relativePath = objectName
-	} else {
-		// 使用本地存储
-		if err := ensureUploadDir(fileID); err != nil {
-			return nil, "", err
-		}
-
-		relativePath = filepath.Join(uploadDirectory, fileID, fileName)
-		fullPath := filepath.Join(dataDirectory, relativePath)
-		if err := os.WriteFile(fullPath, input.Data, 0o644); err != nil {
-			return nil, "", err
-		}
+	relativePath, err := s.storeAssetFile(ctx, input.EnvironmentKey, input.PipelineKey, fileID, fileName, contentType, input.Data)
+	if err != nil {
+		return nil, "", err
 	}
 
 	asset := &model.Asset{
@@ -126,14 +108,7 @@ func (s *Service) UploadAsset(ctx context.Context, input *FileUploadInput) (*com
 	}
 	if err := s.logic.CreateAsset(ctx, asset); err != nil {
 		// 清理已上传的文件
-		if s.config != nil && s.config.Storage.Type == "minio" {
-			client, _ := s.getMinioClient()
-			if client != nil {
-				_ = client.RemoveObject(ctx, s.config.Storage.Minio.Bucket, relativePath, minio.RemoveObjectOptions{})
-			}
-		} else {
-			_ = os.Remove(filepath.Join(dataDirectory, relativePath))
-		}
+		s.removeAssetFile(ctx, relativePath)
 		return nil, "", err
 	}
 
@@ -189,3 +164,289 @@ func (s *Service) GetAssetFile(ctx context.Context, fileID string) (*model.Asset
 		return asset, fullPath, nil
 	}
 }
+
+// storeAssetFile writes the data of a new asset to the configured storage and
+// returns its path there.
+func (s *Service) storeAssetFile(ctx context.Context, environmentKey, pipelineKey, fileID, fileName, contentType string, data []byte) (string, error) {
+	if s.config != nil && s.config.Storage.Type == "minio" {
+		// 使用 MinIO 存储
+		client, err := s.getMinioClient()
+		if err != nil {
+			return "", err
+		}
+
+		objectName := filepath.Join(environmentKey, pipelineKey, fileID, fileName)
+		_, err = client.PutObject(ctx, s.config.Storage.Minio.Bucket, objectName, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
+			ContentType: contentType,
+		})
+		if err != nil {
+			return "", err
+		}
+		return objectName, nil
+	}
+
+	// 使用本地存储
+	if err := ensureUploadDir(fileID); err != nil {
+		return "", err
+	}
+	relativePath := filepath.Join(uploadDirectory, fileID, fileName)
+	if err := os.WriteFile(filepath.Join(dataDirectory, relativePath), data, 0o644); err != nil {
+		return "", err
+	}
+	return relativePath, nil
+}
+
+// removeAssetFile removes a file written by storeAssetFile, ignoring errors.
+func (s *Service) removeAssetFile(ctx context.Context, relativePath string) {
+	if s.config != nil && s.config.Storage.Type == "minio" {
+		client, _ := s.getMinioClient()
+		if client != nil {
+			_ = client.RemoveObject(ctx, s.config.Storage.Minio.Bucket, relativePath, minio.RemoveObjectOptions{})
+		}
+		return
+	}
+	_ = os.Remove(filepath.Join(dataDirectory, relativePath))
+}
+
+// readAssetFile returns the data of an asset from the configured storage.
+func (s *Service) readAssetFile(ctx context.Context, asset *model.Asset) ([]byte, error) {
+	if s.config != nil && s.config.Storage.Type == "minio" {
+		client, err := s.getMinioClient()
+		if err != nil {
+			return nil, err
+		}
+		object, err := client.GetObject(ctx, s.config.Storage.Minio.Bucket, asset.Path, minio.GetObjectOptions{})
+		if err != nil {
+			return nil, err
+		}
+		defer closeQuietly(object)
+		return io.ReadAll(object)
+	}
+	return os.ReadFile(filepath.Join(dataDirectory, asset.Path))
+}
+
+// assetFileExists reports whether the file of an asset is present in the
+// configured storage.
+func (s *Service) assetFileExists(ctx context.Context, asset *model.Asset) (bool, error) {
+	if s.config != nil && s.config.Storage.Type == "minio" {
+		client, err := s.getMinioClient()
+		if err != nil {
+			return false, err
+		}
+		if _, err := client.StatObject(ctx, s.config.Storage.Minio.Bucket, asset.Path, minio.StatObjectOptions{}); err != nil {
+			if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+				return false, nil
+			}
+			return false, err
+		}
+		return true, nil
+	}
+	info, err := os.Stat(filepath.Join(dataDirectory, asset.Path))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return !info.IsDir(), nil
+}
+
+// --------------------- Asset reference operations ---------------------
+
+// CheckAssetReferences reports the broken asset references of configs, see
+// Logic.CheckAssetReferences.
+func (s *Service) CheckAssetReferences(ctx context.Context, environmentKey, pipelineKey string) (*assetpb.AssetReferenceCheckData, error) {
+	report, err := s.logic.CheckAssetReferences(ctx, strings.TrimSpace(environmentKey), strings.TrimSpace(pipelineKey), s.assetFileExists)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*assetpb.AssetReferenceIssue, 0, len(report.Issues))
+	for i := range report.Issues {
+		list = append(list, s.assetReferenceIssueToPB(&report.Issues[i]))
+	}
+	return &assetpb.AssetReferenceCheckData{
+		Configs:    int32(report.Configs),    // #nosec G115 -- count will not exceed int32
+		References: int32(report.References), // #nosec G115 -- count will not exceed int32
+		Total:      int32(len(list)),         // #nosec G115 -- count will not exceed int32
+		List:       list,
+	}, nil
+}
+
+// RepairAssetReference repairs a broken asset reference of a config: relink
+// points it at another asset of the config's pipeline, copy copies a foreign
+// asset into the config's pipeline first. The config is updated like any
+// other config update, so it is recorded in its history and may be queued
+// for approval.
+func (s *Service) RepairAssetReference(ctx context.Context, req *assetpb.RepairAssetReferenceRequest) (*common.ResourceConfig, *common.FileAsset, error) {
+	environmentKey := strings.TrimSpace(req.GetEnvironmentKey())
+	pipelineKey := strings.TrimSpace(req.GetPipelineKey())
+	resourceKey := strings.TrimSpace(req.GetResourceKey())
+	fileID := strings.TrimSpace(req.GetFileId())
+	if environmentKey == "" || pipelineKey == "" || resourceKey == "" || fileID == "" {
+		return nil, nil, fmt.Errorf("%w: environment_key、pipeline_key、resource_key 和 file_id 不能为空", ErrAssetRepairInvalid)
+	}
+	action := strings.TrimSpace(req.GetAction())
+	if action != AssetRepairRelink && action != AssetRepairCopy {
+		return nil, nil, fmt.Errorf("%w: 不支持的操作 %q", ErrAssetRepairInvalid, action)
+	}
+	ctx = withFreezeScope(ctx)
+	if err := s.logic.precheckEnvironmentWritable(ctx, "config.update", environmentKey); err != nil {
+		return nil, nil, err
+	}
+
+	cfg, err := s.logic.configDAO.GetByResourceKey(ctx, s.logic.db, environmentKey, pipelineKey, resourceKey)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, ErrResourceNotFound
+		}
+		return nil, nil, err
+	}
+	if err := configVisibilityFor(ctx).check(cfg); err != nil {
+		return nil, nil, err
+	}
+	report, err := s.logic.checkConfigAssetReferences(ctx, []model.Config{*cfg}, s.assetFileExists)
+	if err != nil {
+		return nil, nil, err
+	}
+	var issue *AssetReferenceIssue
+	for i := range report.Issues {
+		if report.Issues[i].FileID == fileID {
+			issue = &report.Issues[i]
+		}
+	}
+	if issue == nil {
+		for _, ref := range configAssetReferences(cfg) {
+			if ref.FileID == fileID {
+				return nil, nil, fmt.Errorf("%w: 该资源引用正常，无需修复", ErrAssetRepairInvalid)
+			}
+		}
+		return nil, nil, ErrAssetReferenceNotFound
+	}
+
+	var target *model.Asset
+	copied := false
+	switch action {
+	case AssetRepairRelink:
+		target, err = s.assetRelinkTarget(ctx, cfg, issue, strings.TrimSpace(req.GetTargetFileId()))
+	case AssetRepairCopy:
+		if issue.Problem != AssetProblemForeignAsset {
+			return nil, nil, fmt.Errorf("%w: 只能复制其他环境或渠道的资源", ErrAssetRepairInvalid)
+		}
+		target, err = s.copyAsset(ctx, issue.Asset, environmentKey, pipelineKey)
+		copied = err == nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updated := *cfg
+	if _, err := rewriteAssetReferences(&updated, fileID, target); err != nil {
+		return nil, nil, err
+	}
+	if err := s.queueConfigChange(ctx, model.ChangeRequestConfigUpdate, &updated); err != nil {
+		// 进入审批时保留复制的资源，供审批通过后的配置引用
+		var pending *ChangeRequestPendingError
+		if copied && !errors.As(err, &pending) {
+			s.discardAsset(ctx, target)
+		}
+		return nil, nil, err
+	}
+	if err := s.logic.UpdateConfig(ctx, &updated, cfg.Revision); err != nil {
+		if copied {
+			s.discardAsset(ctx, target)
+		}
+		return nil, nil, err
+	}
+	result, err := s.logic.GetConfig(ctx, environmentKey, pipelineKey, resourceKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.decorateConfig(modelConfigToPB(result)), s.decorateAsset(assetModelToPB(target)), nil
+}
+
+// assetRelinkTarget returns the asset a broken reference is re-linked to:
+// the asset targetFileID, or the only same-named candidate of the issue.
+func (s *Service) assetRelinkTarget(ctx context.Context, cfg *model.Config, issue *AssetReferenceIssue, targetFileID string) (*model.Asset, error) {
+	if targetFileID == "" {
+		switch len(issue.Candidates) {
+		case 0:
+			return nil, fmt.Errorf("%w: 当前渠道没有同名资源，请指定 target_file_id", ErrAssetRepairInvalid)
+		case 1:
+			return &issue.Candidates[0], nil
+		default:
+			return nil, fmt.Errorf("%w: 当前渠道存在多个同名资源，请指定 target_file_id", ErrAssetRepairInvalid)
+		}
+	}
+	if targetFileID == issue.FileID {
+		return nil, fmt.Errorf("%w: 目标资源与原资源相同", ErrAssetRepairInvalid)
+	}
+	target, err := s.logic.GetAsset(ctx, targetFileID)
+	if err != nil {
+		return nil, err
+	}
+	if !assetInConfigScope(target, cfg) {
+		return nil, fmt.Errorf("%w: 目标资源不属于配置所在的环境和渠道", ErrAssetRepairInvalid)
+	}
+	ok, err := s.assetFileExists(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: 目标资源文件不存在", ErrAssetRepairInvalid)
+	}
+	return target, nil
+}
+
+// copyAsset copies an asset with its file into a pipeline under a new file ID.
+func (s *Service) copyAsset(ctx context.Context, source *model.Asset, environmentKey, pipelineKey string) (*model.Asset, error) {
+	data, err := s.readAssetFile(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+	fileID := uuid.NewString()
+	relativePath, err := s.storeAssetFile(ctx, environmentKey, pipelineKey, fileID, source.FileName, source.ContentType, data)
+	if err != nil {
+		return nil, err
+	}
+	asset := &model.Asset{
+		FileID:         fileID,
+		EnvironmentKey: environmentKey,
+		PipelineKey:    pipelineKey,
+		FileName:       source.FileName,
+		ContentType:    source.ContentType,
+		FileSize:       int64(len(data)),
+		Path:           relativePath,
+		Remark:         source.Remark,
+	}
+	if err := s.logic.CreateAsset(ctx, asset); err != nil {
+		s.removeAssetFile(ctx, relativePath)
+		return nil, err
+	}
+	return asset, nil
+}
+
+// discardAsset removes an asset created by copyAsset, ignoring errors.
+func (s *Service) discardAsset(ctx context.Context, asset *model.Asset) {
+	if err := s.logic.assetDAO.DeleteByFileID(ctx, s.logic.db, asset.FileID); err == nil {
+		s.logic.recordAssetEvent(ctx, model.ChangeEventDeleted, asset)
+	}
+	s.removeAssetFile(ctx, asset.Path)
+}
+
+func (s *Service) assetReferenceIssueToPB(issue *AssetReferenceIssue) *assetpb.AssetReferenceIssue {
+	item := &assetpb.AssetReferenceIssue{
+		EnvironmentKey: issue.Config.EnvironmentKey,
+		PipelineKey:    issue.Config.PipelineKey,
+		ResourceKey:    issue.Config.ResourceKey,
+		Alias:          issue.Config.Alias,
+		Name:           issue.Config.Name,
+		FileId:         issue.FileID,
+		Problem:        issue.Problem,
+		FileName:       issue.FileName,
+		Candidates:     s.decorateAssetList(assetSliceToPB(issue.Candidates)),
+	}
+	if issue.Asset != nil {
+		item.Asset = s.decorateAsset(assetModelToPB(issue.Asset))
+	}
+	return item
+}
@@ -0,0 +1,420 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	changepb "github.com/yi-nology/rainbow_bridge/biz/model/changerequest"
+	"github.com/yi-nology/rainbow_bridge/pkg/util"
+
+	"gorm.io/gorm"
+)
+
+// changeRequestPayload is the input a change request is applied with. Config
+// writes carry a single config; deletes only need its keys.
+type changeRequestPayload struct {
+	Configs    []model.Config         `json:"configs"`
+	Overwrite  bool                   `json:"overwrite,omitempty"`
+	Operations []ConfigBatchOperation `json:"operations,omitempty"`
+	Rename     *ConfigAliasRename     `json:"rename,omitempty"`
+	RevisionID uint                   `json:"revision_id,omitempty"`
+	Rollout    *rolloutChange         `json:"rollout,omitempty"`
+	Release    *releaseChange         `json:"release,omitempty"`
+}
+
+// rolloutChange is a rollout write queued as a change request: a new rollout,
+// or a ramp or promotion of the rollout ID.
+type rolloutChange struct {
+	ID         uint          `json:"id,omitempty"`
+	Input      *RolloutInput `json:"input,omitempty"`
+	Percentage int           `json:"percentage,omitempty"`
+}
+
+// releaseChange is a release write queued as a change request: publishing the
+// working configs, or activating the release Version.
+type releaseChange struct {
+	EnvironmentKey string `json:"environment_key"`
+	PipelineKey    string `json:"pipeline_key"`
+	Version        int    `json:"version,omitempty"`
+	Description    string `json:"description,omitempty"`
+}
+
+// --------------------- Change request operations ---------------------
+
+// submitChangeRequest queues a write to environments that require approval.
+// The write is dry-run to validate it and to record the configs it changes;
+// the returned *ChangeRequestPendingError reports the new request. summary
+// describes the write, and is derived from the changes when empty.
+func (s *Service) submitChangeRequest(ctx context.Context, operation, summary string, payload *changeRequestPayload, environmentKeys []string) error {
+	items, err := s.planChangeRequest(ctx, operation, payload)
+	if err != nil {
+		return err
+	}
+	if summary == "" {
+		summary = changeRequestSummary(operation, items)
+	}
+	return s.logic.createChangeRequest(ctx, operation, summary, payload, items, environmentKeys)
+}
+
+// planChangeRequest dry-runs a change request and returns the configs it writes.
+func (s *Service) planChangeRequest(ctx context.Context, operation string, payload *changeRequestPayload) ([]model.ConfigChangeRequestItem, error) {
+	items, err := s.logic.dryRunConfigChanges(func(dry *Logic) error {
+		return s.runChangeRequest(ctx, dry, operation, payload, true)
+	})
+	// 预演写入的行已回滚，不能保留其主键
+	for i := range payload.Configs {
+		clearConfigRow(&payload.Configs[i])
+	}
+	for i := range payload.Operations {
+		clearConfigRow(&payload.Operations[i].Config)
+	}
+	return items, err
+}
+
+func clearConfigRow(cfg *model.Config) {
+	cfg.ID = 0
+	cfg.CreatedAt = time.Time{}
+	cfg.UpdatedAt = time.Time{}
+}
+
+// runChangeRequest applies a change request through l. Config writes prepare
+// the payload in place (normalised, secrets encrypted), so that the payload of
+// a dry run can be stored and applied later with the same result.
+func (s *Service) runChangeRequest(ctx context.Context, l *Logic, operation string, payload *changeRequestPayload, dryRun bool) error {
+	switch operation {
+	case model.ChangeRequestConfigImport:
+		return l.ImportConfigs(ctx, payload.Configs, payload.Overwrite)
+	case model.ChangeRequestConfigBatch:
+		results, err := l.ApplyConfigBatch(ctx, payload.Operations)
+		if errors.Is(err, ErrConfigBatchFailed) {
+			return configBatchError(results)
+		}
+		return err
+	case model.ChangeRequestConfigRename:
+		if payload.Rename == nil {
+			return fmt.Errorf("%w: missing rename", ErrChangeRequestInvalid)
+		}
+		_, err := l.RenameConfigAlias(ctx, *payload.Rename)
+		return err
+	case model.ChangeRequestConfigRollback:
+		_, err := l.RollbackConfig(ctx, payload.RevisionID)
+		return err
+	case model.ChangeRequestRolloutCreate, model.ChangeRequestRolloutRamp, model.ChangeRequestRolloutPromote:
+		return runRolloutChange(ctx, l, operation, payload.Rollout)
+	case model.ChangeRequestReleasePublish, model.ChangeRequestReleaseActivate:
+		return runReleaseChange(ctx, l, operation, payload.Release)
+	case model.ChangeRequestTransferMigrate:
+		for i := range payload.Configs {
+			cfg := payload.Configs[i]
+			if !dryRun {
+				// 资源文件只在真正执行时复制
+				if err := s.copyConfigAssets(ctx, &cfg, cfg.EnvironmentKey, cfg.PipelineKey); err != nil {
+					return fmt.Errorf("%s: %w", cfg.Alias, err)
+				}
+			}
+			_, err := l.configDAO.GetByResourceKey(ctx, l.db, cfg.EnvironmentKey, cfg.PipelineKey, cfg.ResourceKey)
+			switch {
+			case err == nil:
+				err = l.updateConfig(ctx, &cfg, model.ConfigRevisionActionUpdate, 0)
+			case errors.Is(err, gorm.ErrRecordNotFound):
+				err = l.addConfig(ctx, &cfg, model.ConfigRevisionActionCreate)
+			}
+			if err != nil {
+				return fmt.Errorf("%s: %w", cfg.Alias, err)
+			}
+		}
+		return nil
+	}
+
+	if len(payload.Configs) != 1 {
+		return fmt.Errorf("%w: expected a single config", ErrChangeRequestInvalid)
+	}
+	cfg := &payload.Configs[0]
+	switch operation {
+	case model.ChangeRequestConfigCreate:
+		return l.addConfig(ctx, cfg, model.ConfigRevisionActionCreate)
+	case model.ChangeRequestConfigUpdate:
+		return l.updateConfig(ctx, cfg, model.ConfigRevisionActionUpdate, 0)
+	case model.ChangeRequestConfigDelete:
+		return l.DeleteConfig(ctx, cfg.EnvironmentKey, cfg.PipelineKey, cfg.ResourceKey, 0)
+	case model.ChangeRequestConfigTranslate:
+		translations, err := util.ParseTranslations(cfg.Translations)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrChangeRequestInvalid, err)
+		}
+		_, err = l.UpdateConfigTranslations(ctx, cfg.EnvironmentKey, cfg.PipelineKey, cfg.ResourceKey, translations)
+		return err
+	default:
+		return fmt.Errorf("%w: unknown operation %q", ErrChangeRequestInvalid, operation)
+	}
+}
+
+// runRolloutChange applies a queued rollout write through l. The candidate of
+// a new rollout is kept as stored, so secrets are encrypted in the payload.
+func runRolloutChange(ctx context.Context, l *Logic, operation string, change *rolloutChange) error {
+	if change == nil {
+		return fmt.Errorf("%w: missing rollout", ErrChangeRequestInvalid)
+	}
+	switch operation {
+	case model.ChangeRequestRolloutCreate:
+		if change.Input == nil {
+			return fmt.Errorf("%w: missing rollout", ErrChangeRequestInvalid)
+		}
+		rollout, err := l.CreateRollout(ctx, change.Input)
+		if err != nil {
+			return err
+		}
+		change.Input.CandidateContent = rollout.CandidateContent
+		return nil
+	case model.ChangeRequestRolloutRamp:
+		_, err := l.RampRollout(ctx, change.ID, change.Percentage)
+		return err
+	default:
+		_, err := l.PromoteRollout(ctx, change.ID)
+		return err
+	}
+}
+
+// runReleaseChange applies a queued release write through l.
+func runReleaseChange(ctx context.Context, l *Logic, operation string, change *releaseChange) error {
+	if change == nil {
+		return fmt.Errorf("%w: missing release", ErrChangeRequestInvalid)
+	}
+	if operation == model.ChangeRequestReleasePublish {
+		_, err := l.PublishRelease(ctx, change.EnvironmentKey, change.PipelineKey, change.Description)
+		return err
+	}
+	_, err := l.ActivateRelease(ctx, change.EnvironmentKey, change.PipelineKey, change.Version)
+	return err
+}
+
+// ListChangeRequests returns change requests, newest first, without their diffs.
+func (s *Service) ListChangeRequests(ctx context.Context, environmentKey, status string, page, pageSize int) ([]*changepb.ChangeRequest, int64, error) {
+	requests, total, err := s.logic.ListChangeRequests(ctx, environmentKey, status, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	list := make([]*changepb.ChangeRequest, 0, len(requests))
+	for i := range requests {
+		items, err := s.logic.ListChangeRequestItems(ctx, requests[i].ID)
+		if err != nil {
+			return nil, 0, err
+		}
+		list = append(list, changeRequestModelToPB(&requests[i], items))
+	}
+	return list, total, nil
+}
+
+// GetChangeRequest returns a change request with its content diff and comments.
+func (s *Service) GetChangeRequest(ctx context.Context, id int64) (*changepb.ChangeRequest, error) {
+	if id <= 0 {
+		return nil, ErrChangeRequestNotFound
+	}
+	request, err := s.logic.GetChangeRequest(ctx, uint(id))
+	if err != nil {
+		return nil, err
+	}
+	return s.changeRequestDetail(ctx, request)
+}
+
+// ApproveChangeRequest applies a pending change request on behalf of a user
+// other than its requester. The request is dry-run again first; if the configs
+// it writes changed in the meantime it is refused as stale, so that only the
+// reviewed diff is ever applied. The request is claimed before it is applied,
+// so concurrent reviews cannot apply or reject it twice; a failed apply leaves
+// the request pending.
+func (s *Service) ApproveChangeRequest(ctx context.Context, id int64, comment string) (*changepb.ChangeRequest, error) {
+	if id <= 0 {
+		return nil, ErrChangeRequestNotFound
+	}
+	request, err := s.logic.reviewableChangeRequest(ctx, uint(id), true)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.logic.claimChangeRequest(ctx, request); err != nil {
+		return nil, err
+	}
+	if err := s.applyChangeRequest(ctx, request); err != nil {
+		s.logic.releaseChangeRequest(ctx, request)
+		return nil, err
+	}
+	if err := s.logic.finishChangeRequest(ctx, request, model.ConfigChangeRequestApproved, comment); err != nil {
+		return nil, err
+	}
+	return s.changeRequestDetail(ctx, request)
+}
+
+// applyChangeRequest applies a claimed change request unless the configs it
+// writes changed since it was submitted.
+func (s *Service) applyChangeRequest(ctx context.Context, request *model.ConfigChangeRequest) error {
+	var payload changeRequestPayload
+	if err := json.Unmarshal([]byte(request.Payload), &payload); err != nil {
+		return fmt.Errorf("%w: %v", ErrChangeRequestInvalid, err)
+	}
+	reviewed, err := s.logic.ListChangeRequestItems(ctx, request.ID)
+	if err != nil {
+		return err
+	}
+	planned, err := s.planChangeRequest(ctx, request.Operation, &payload)
+	if err != nil {
+		// 待修改或删除的配置已不存在
+		if errors.Is(err, ErrResourceNotFound) {
+			return ErrChangeRequestStale
+		}
+		return err
+	}
+	if !s.logic.sameChangeRequestItems(reviewed, planned) {
+		return ErrChangeRequestStale
+	}
+	return s.runChangeRequest(withFreezeScope(ctx), s.logic, request.Operation, &payload, false)
+}
+
+// RejectChangeRequest closes a pending change request without applying it.
+func (s *Service) RejectChangeRequest(ctx context.Context, id int64, comment string) (*changepb.ChangeRequest, error) {
+	if id <= 0 {
+		return nil, ErrChangeRequestNotFound
+	}
+	request, err := s.logic.RejectChangeRequest(ctx, uint(id), comment)
+	if err != nil {
+		return nil, err
+	}
+	return s.changeRequestDetail(ctx, request)
+}
+
+// CommentChangeRequest adds a comment to a change request.
+func (s *Service) CommentChangeRequest(ctx context.Context, id int64, content string) (*changepb.ChangeRequestComment, error) {
+	if id <= 0 {
+		return nil, ErrChangeRequestNotFound
+	}
+	comment, err := s.logic.CommentChangeRequest(ctx, uint(id), content)
+	if err != nil {
+		return nil, err
+	}
+	return changeRequestCommentToPB(comment), nil
+}
+
+func (s *Service) changeRequestDetail(ctx context.Context, request *model.ConfigChangeRequest) (*changepb.ChangeRequest, error) {
+	items, err := s.logic.ListChangeRequestItems(ctx, request.ID)
+	if err != nil {
+		return nil, err
+	}
+	comments, err := s.logic.ListChangeRequestComments(ctx, request.ID)
+	if err != nil {
+		return nil, err
+	}
+	item := changeRequestModelToPB(request, items)
+	visibility := configVisibilityFor(ctx)
+	for i := range items {
+		diff, err := s.changeRequestDiff(visibility, &items[i])
+		if err != nil {
+			return nil, err
+		}
+		item.Changes = append(item.Changes, diff)
+	}
+	for i := range comments {
+		item.Comments = append(item.Comments, changeRequestCommentToPB(&comments[i]))
+	}
+	return item, nil
+}
+
+// changeRequestDiff describes an item; snapshots hidden from the caller are
+// left out, and so is their content from the diff.
+func (s *Service) changeRequestDiff(visibility configVisibility, item *model.ConfigChangeRequestItem) (*changepb.ChangeRequestDiff, error) {
+	before, err := unmarshalConfigSnapshot(item.Before)
+	if err != nil {
+		return nil, err
+	}
+	after, err := unmarshalConfigSnapshot(item.After)
+	if err != nil {
+		return nil, err
+	}
+	diff := &changepb.ChangeRequestDiff{
+		EnvironmentKey: item.EnvironmentKey,
+		PipelineKey:    item.PipelineKey,
+		ResourceKey:    item.ResourceKey,
+		Alias:          item.Alias,
+		Change:         changeRequestChange(item),
+		Before:         s.decorateConfig(modelConfigToPB(visibility.redact(before))),
+		After:          s.decorateConfig(modelConfigToPB(visibility.redact(after))),
+	}
+	diff.Diff = util.LineDiff(diff.GetBefore().GetContent(), diff.GetAfter().GetContent())
+	return diff, nil
+}
+
+// changeRequestChange classifies an item as create, update or delete by its
+// snapshots, since imported configs are recorded with the import action.
+func changeRequestChange(item *model.ConfigChangeRequestItem) string {
+	switch {
+	case item.Before == "":
+		return model.ConfigRevisionActionCreate
+	case item.After == "":
+		return model.ConfigRevisionActionDelete
+	default:
+		return model.ConfigRevisionActionUpdate
+	}
+}
+
+func changeRequestSummary(operation string, items []model.ConfigChangeRequestItem) string {
+	if len(items) == 0 {
+		return operation
+	}
+	target := fmt.Sprintf("%s（%s/%s）", items[0].Alias, items[0].EnvironmentKey, items[0].PipelineKey)
+	switch operation {
+	case model.ChangeRequestConfigCreate:
+		return "新增配置 " + target
+	case model.ChangeRequestConfigUpdate:
+		return "修改配置 " + target
+	case model.ChangeRequestConfigDelete:
+		return "删除配置 " + target
+	case model.ChangeRequestConfigTranslate:
+		return "修改配置翻译 " + target
+	case model.ChangeRequestConfigRollback:
+		return "回滚配置 " + target
+	case model.ChangeRequestRolloutCreate:
+		return "灰度发布配置 " + target
+	case model.ChangeRequestRolloutPromote:
+		return "全量发布灰度 " + target
+	default:
+		return fmt.Sprintf("%s：%d 个配置变更", operation, len(items))
+	}
+}
+
+func changeRequestModelToPB(request *model.ConfigChangeRequest, items []model.ConfigChangeRequestItem) *changepb.ChangeRequest {
+	item := &changepb.ChangeRequest{
+		Id:            int64(request.ID),
+		Operation:     request.Operation,
+		Status:        request.Status,
+		Summary:       request.Summary,
+		RequesterId:   int64(request.RequesterID),
+		RequesterName: request.RequesterName,
+		ReviewerId:    int64(request.ReviewerID),
+		ReviewerName:  request.ReviewerName,
+		ReviewComment: request.ReviewComment,
+		CreatedAt:     request.CreatedAt.Format(time.RFC3339),
+	}
+	if request.ReviewedAt != nil {
+		item.ReviewedAt = request.ReviewedAt.Format(time.RFC3339)
+	}
+	seen := make(map[string]bool)
+	for i := range items {
+		if !seen[items[i].EnvironmentKey] {
+			seen[items[i].EnvironmentKey] = true
+			item.EnvironmentKeys = append(item.EnvironmentKeys, items[i].EnvironmentKey)
+		}
+	}
+	return item
+}
+
+func changeRequestCommentToPB(comment *model.ConfigChangeRequestComment) *changepb.ChangeRequestComment {
+	return &changepb.ChangeRequestComment{
+		Id:         int64(comment.ID),
+		AuthorId:   int64(comment.AuthorID),
+		AuthorName: comment.AuthorName,
+		Content:    comment.Content,
+		CreatedAt:  comment.CreatedAt.Format(time.RFC3339),
+	}
+}
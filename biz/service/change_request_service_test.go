@@ -0,0 +1,441 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/db"
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"github.com/yi-nology/rainbow_bridge/biz/model/common"
+	configpb "github.com/yi-nology/rainbow_bridge/biz/model/config"
+	envpb "github.com/yi-nology/rainbow_bridge/biz/model/environment"
+	rolloutpb "github.com/yi-nology/rainbow_bridge/biz/model/rollout"
+	"github.com/yi-nology/rainbow_bridge/biz/model/transfer"
+	pkgcommon "github.com/yi-nology/rainbow_bridge/pkg/common"
+	"github.com/yi-nology/rainbow_bridge/pkg/config"
+)
+
+// TestChangeRequestRollbackAndRollouts checks that rollbacks and rollout
+// writes in an environment requiring approval wait for a second user.
+func TestChangeRequestRollbackAndRollouts(t *testing.T) {
+	gdb := db.SetupTestDB(t)
+	defer db.CleanupTestDB(t, gdb)
+	s := NewService(gdb, nil, "", &config.Config{})
+
+	requester := pkgcommon.ContextWithUsername(pkgcommon.ContextWithUserID(context.Background(), 1), "alice")
+	reviewer := pkgcommon.ContextWithUsername(pkgcommon.ContextWithUserID(context.Background(), 2), "bob")
+	if err := s.AddEnvironment(requester, &envpb.Environment{EnvironmentKey: "prod", EnvironmentName: "Prod", IsActive: true}); err != nil {
+		t.Fatalf("AddEnvironment failed: %v", err)
+	}
+	greeting, err := s.AddConfig(requester, &common.ResourceConfig{
+		EnvironmentKey: "prod", PipelineKey: "default", Name: "Greeting", Alias: "greeting", Type: "text", Content: "hello",
+	})
+	if err != nil {
+		t.Fatalf("AddConfig failed: %v", err)
+	}
+	greeting.Content = "hi"
+	if _, err := s.UpdateConfig(requester, greeting, 0); err != nil {
+		t.Fatalf("UpdateConfig failed: %v", err)
+	}
+	history, _, err := s.ListConfigHistory(requester, "prod", "default", greeting.GetResourceKey(), 1, 10)
+	if err != nil || len(history) != 2 {
+		t.Fatalf("ListConfigHistory = %v, %v; want two revisions", history, err)
+	}
+	token, err := s.AddConfig(requester, &common.ResourceConfig{
+		EnvironmentKey: "prod", PipelineKey: "default", Name: "Token", Alias: "token", Type: "text", Content: "secret", IsPerm: true,
+	})
+	if err != nil {
+		t.Fatalf("AddConfig token failed: %v", err)
+	}
+	tokenHistory, _, err := s.ListConfigHistory(requester, "prod", "default", token.GetResourceKey(), 1, 10)
+	if err != nil || len(tokenHistory) != 1 {
+		t.Fatalf("ListConfigHistory token = %v, %v; want one revision", tokenHistory, err)
+	}
+	if err := s.logic.environmentDAO.SetRequireApproval(requester, gdb, "prod", true); err != nil {
+		t.Fatalf("SetRequireApproval failed: %v", err)
+	}
+
+	content := func() string {
+		t.Helper()
+		cfg, err := s.GetConfigDetail(requester, "prod", "default", greeting.GetResourceKey())
+		if err != nil {
+			t.Fatalf("GetConfigDetail failed: %v", err)
+		}
+		return cfg.GetContent()
+	}
+	rollouts := func() []*rolloutpb.ConfigRollout {
+		t.Helper()
+		list, err := s.ListRollouts(requester, "prod", "default", "")
+		if err != nil {
+			t.Fatalf("ListRollouts failed: %v", err)
+		}
+		return list
+	}
+	approve := func(err error, operation string) {
+		t.Helper()
+		var pending *ChangeRequestPendingError
+		if !errors.As(err, &pending) {
+			t.Fatalf("expected %s to be queued, got %v", operation, err)
+		}
+		if pending.Request.Operation != operation {
+			t.Fatalf("queued %s, want %s", pending.Request.Operation, operation)
+		}
+		items, err := s.logic.ListChangeRequestItems(requester, pending.Request.ID)
+		if err != nil || len(items) != 1 || items[0].ResourceKey != greeting.GetResourceKey() {
+			t.Fatalf("change request items = %+v, %v; want the greeting config", items, err)
+		}
+		if _, err := s.ApproveChangeRequest(reviewer, int64(pending.Request.ID), ""); err != nil {
+			t.Fatalf("ApproveChangeRequest %s failed: %v", operation, err)
+		}
+	}
+
+	t.Run("Rollback", func(t *testing.T) {
+		_, err := s.RollbackConfig(requester, history[1].GetId())
+		if content() != "hi" {
+			t.Fatal("expected the rollback to wait for approval")
+		}
+		approve(err, model.ChangeRequestConfigRollback)
+		if got := content(); got != "hello" {
+			t.Fatalf("content after approval = %q, want hello", got)
+		}
+	})
+
+	t.Run("HiddenRollback", func(t *testing.T) {
+		// 无权查看受保护配置的调用方不能借回滚提交审批
+		if _, err := s.RollbackConfig(context.Background(), tokenHistory[0].GetId()); !errors.Is(err, ErrRevisionNotFound) {
+			t.Fatalf("RollbackConfig of a hidden revision: err = %v, want ErrRevisionNotFound", err)
+		}
+		var pending int64
+		if err := gdb.Model(&model.ConfigChangeRequest{}).Where("status = ?", model.ConfigChangeRequestPending).Count(&pending).Error; err != nil || pending != 0 {
+			t.Fatalf("pending change requests = %d, %v; want none", pending, err)
+		}
+	})
+
+	var rolloutID int64
+	t.Run("CreateRollout", func(t *testing.T) {
+		_, err := s.CreateRollout(requester, &rolloutpb.CreateRolloutRequest{
+			EnvironmentKey: "prod", PipelineKey: "default", Alias: "greeting", CandidateContent: "hey", Percentage: 10,
+		})
+		if len(rollouts()) != 0 {
+			t.Fatal("expected the rollout to wait for approval")
+		}
+		approve(err, model.ChangeRequestRolloutCreate)
+		list := rollouts()
+		if len(list) != 1 || list[0].Percentage != 10 || list[0].CandidateContent != "hey" {
+			t.Fatalf("rollouts after approval = %+v", list)
+		}
+		rolloutID = list[0].Id
+	})
+
+	t.Run("RampRollout", func(t *testing.T) {
+		_, err := s.RampRollout(requester, rolloutID, 50)
+		if rollouts()[0].Percentage != 10 {
+			t.Fatal("expected the ramp to wait for approval")
+		}
+		approve(err, model.ChangeRequestRolloutRamp)
+		if got := rollouts()[0].Percentage; got != 50 {
+			t.Fatalf("percentage after approval = %d, want 50", got)
+		}
+	})
+
+	t.Run("PromoteRollout", func(t *testing.T) {
+		_, err := s.PromoteRollout(requester, rolloutID)
+		if content() != "hello" {
+			t.Fatal("expected the promotion to wait for approval")
+		}
+		approve(err, model.ChangeRequestRolloutPromote)
+		if got := content(); got != "hey" {
+			t.Fatalf("content after approval = %q, want hey", got)
+		}
+		if status := rollouts()[0].Status; status != model.ConfigRolloutStatusCompleted {
+			t.Fatalf("rollout status = %s, want %s", status, model.ConfigRolloutStatusCompleted)
+		}
+	})
+}
+
+// TestChangeRequestPayloadSecrets checks that change requests store secrets
+// encrypted, whatever the write that queued them.
+func TestChangeRequestPayloadSecrets(t *testing.T) {
+	gdb := db.SetupTestDB(t)
+	defer db.CleanupTestDB(t, gdb)
+	s := NewService(gdb, nil, "", &config.Config{})
+	keyring, err := pkgcommon.NewSecretKeyring(bytes.Repeat([]byte{5}, 32))
+	if err != nil {
+		t.Fatalf("NewSecretKeyring failed: %v", err)
+	}
+	s.SetSecretKeyring(keyring)
+
+	requester := pkgcommon.ContextWithUsername(pkgcommon.ContextWithUserID(context.Background(), 1), "alice")
+	reviewer := pkgcommon.ContextWithUsername(pkgcommon.ContextWithUserID(context.Background(), 2), "bob")
+	secrets := map[string]string{"prod": "plain-token", "stage": "plain-migrate"}
+	var token *common.ResourceConfig
+	for _, key := range []string{"prod", "stage"} {
+		if err := s.AddEnvironment(requester, &envpb.Environment{EnvironmentKey: key, EnvironmentName: key, IsActive: true}); err != nil {
+			t.Fatalf("AddEnvironment %s failed: %v", key, err)
+		}
+		added, err := s.AddConfig(requester, &common.ResourceConfig{
+			EnvironmentKey: key, PipelineKey: "default", Name: "Token", Alias: "token", Type: "secret", Content: secrets[key],
+		})
+		if err != nil {
+			t.Fatalf("AddConfig %s failed: %v", key, err)
+		}
+		if key == "prod" {
+			token = added
+		}
+	}
+	if err := s.logic.environmentDAO.SetRequireApproval(requester, gdb, "prod", true); err != nil {
+		t.Fatalf("SetRequireApproval failed: %v", err)
+	}
+
+	secret := func(alias, content string) *common.ResourceConfig {
+		return &common.ResourceConfig{EnvironmentKey: "prod", PipelineKey: "default", Name: alias, Alias: alias, Type: "secret", Content: content}
+	}
+	writes := map[string]func() error{
+		"create": func() error {
+			_, err := s.AddConfig(requester, secret("created", "plain-create"))
+			return err
+		},
+		"update": func() error {
+			token.Content = "plain-update"
+			_, err := s.UpdateConfig(requester, token, 0)
+			return err
+		},
+		"batch": func() error {
+			_, err := s.BatchConfigs(requester, []*configpb.BatchConfigOperation{{Action: "create", Config: secret("batched", "plain-batch")}})
+			return err
+		},
+		"import": func() error {
+			return s.ImportConfigs(requester, []*common.ResourceConfig{secret("imported", "plain-import")}, false)
+		},
+		"migrate": func() error {
+			_, err := s.MigrateConfigs(requester, &transfer.MigrateRequest{
+				SourceEnvironmentKey: "stage", SourcePipelineKey: "default", TargetEnvironmentKey: "prod", TargetPipelineKey: "default", Overwrite: true,
+			})
+			return err
+		},
+		"rollout": func() error {
+			_, err := s.CreateRollout(requester, &rolloutpb.CreateRolloutRequest{
+				EnvironmentKey: "prod", PipelineKey: "default", Alias: "token", CandidateContent: "plain-rollout", Percentage: 10,
+			})
+			return err
+		},
+	}
+	var created uint
+	for name, write := range writes {
+		var pending *ChangeRequestPendingError
+		if err := write(); !errors.As(err, &pending) {
+			t.Fatalf("expected the %s to be queued, got %v", name, err)
+		}
+		if name == "create" {
+			created = pending.Request.ID
+		}
+	}
+
+	var requests []model.ConfigChangeRequest
+	if err := gdb.Find(&requests).Error; err != nil || len(requests) != len(writes) {
+		t.Fatalf("found %d change requests, %v; want %d", len(requests), err, len(writes))
+	}
+	var items []model.ConfigChangeRequestItem
+	if err := gdb.Find(&items).Error; err != nil || len(items) == 0 {
+		t.Fatalf("found %d change request items, %v", len(items), err)
+	}
+	for i := range requests {
+		if strings.Contains(requests[i].Payload, "plain-") {
+			t.Errorf("%s payload holds a plaintext secret: %s", requests[i].Operation, requests[i].Payload)
+		}
+	}
+	for i := range items {
+		if strings.Contains(items[i].Before+items[i].After, "plain-") {
+			t.Errorf("item of %s holds a plaintext secret", items[i].Alias)
+		}
+	}
+
+	// 加密后的载荷批准时照常写入
+	if _, err := s.ApproveChangeRequest(reviewer, int64(created), ""); err != nil {
+		t.Fatalf("ApproveChangeRequest failed: %v", err)
+	}
+	list, err := s.ListConfigs(requester, "prod", "default", "", "", "", false, false)
+	if err != nil {
+		t.Fatalf("ListConfigs failed: %v", err)
+	}
+	for _, cfg := range list {
+		if cfg.GetAlias() != "created" {
+			continue
+		}
+		revealed, err := s.logic.RevealSecret(requester, "prod", "default", cfg.GetResourceKey())
+		if err != nil || revealed.Content != "plain-create" {
+			t.Fatalf("RevealSecret = %v, %v; want plain-create", revealed, err)
+		}
+		return
+	}
+	t.Fatal("expected the approved config to be created")
+}
+
+// TestApproveChangeRequestOnce checks that a change request is claimed before
+// it is applied, so that it is applied at most once and cannot be rejected
+// while its change goes live.
+func TestApproveChangeRequestOnce(t *testing.T) {
+	gdb := db.SetupTestDB(t)
+	defer db.CleanupTestDB(t, gdb)
+	s := NewService(gdb, nil, "", &config.Config{})
+
+	requester := pkgcommon.ContextWithUsername(pkgcommon.ContextWithUserID(context.Background(), 1), "alice")
+	reviewer := pkgcommon.ContextWithUsername(pkgcommon.ContextWithUserID(context.Background(), 2), "bob")
+	other := pkgcommon.ContextWithUsername(pkgcommon.ContextWithUserID(context.Background(), 3), "carol")
+	if err := s.AddEnvironment(requester, &envpb.Environment{EnvironmentKey: "prod", EnvironmentName: "Prod", IsActive: true}); err != nil {
+		t.Fatalf("AddEnvironment failed: %v", err)
+	}
+	greeting, err := s.AddConfig(requester, &common.ResourceConfig{
+		EnvironmentKey: "prod", PipelineKey: "default", Name: "Greeting", Alias: "greeting", Type: "text", Content: "hello",
+	})
+	if err != nil {
+		t.Fatalf("AddConfig failed: %v", err)
+	}
+	if err := s.logic.environmentDAO.SetRequireApproval(requester, gdb, "prod", true); err != nil {
+		t.Fatalf("SetRequireApproval failed: %v", err)
+	}
+	greeting.Content = "hi"
+	var pending *ChangeRequestPendingError
+	if _, err := s.UpdateConfig(requester, greeting, 0); !errors.As(err, &pending) {
+		t.Fatalf("expected the update to be queued, got %v", err)
+	}
+	id := int64(pending.Request.ID)
+	status := func(id int64) string {
+		t.Helper()
+		request, err := s.logic.GetChangeRequest(requester, uint(id))
+		if err != nil {
+			t.Fatalf("GetChangeRequest failed: %v", err)
+		}
+		return request.Status
+	}
+	revisions := func() int {
+		t.Helper()
+		history, _, err := s.ListConfigHistory(requester, "prod", "default", greeting.GetResourceKey(), 1, 10)
+		if err != nil {
+			t.Fatalf("ListConfigHistory failed: %v", err)
+		}
+		return len(history)
+	}
+
+	// 另一个批准正在执行时，再次批准或拒绝均被拒绝
+	claimed := pending.Request
+	if err := s.logic.claimChangeRequest(reviewer, &claimed); err != nil {
+		t.Fatalf("claimChangeRequest failed: %v", err)
+	}
+	if _, err := s.ApproveChangeRequest(other, id, ""); !errors.Is(err, ErrChangeRequestNotPending) {
+		t.Fatalf("ApproveChangeRequest of a claimed request: err = %v, want ErrChangeRequestNotPending", err)
+	}
+	if _, err := s.RejectChangeRequest(other, id, ""); !errors.Is(err, ErrChangeRequestNotPending) {
+		t.Fatalf("RejectChangeRequest of a claimed request: err = %v, want ErrChangeRequestNotPending", err)
+	}
+	s.logic.releaseChangeRequest(reviewer, &claimed)
+	if got := status(id); got != model.ConfigChangeRequestPending {
+		t.Fatalf("status after release = %s, want pending", got)
+	}
+
+	if _, err := s.ApproveChangeRequest(reviewer, id, ""); err != nil {
+		t.Fatalf("ApproveChangeRequest failed: %v", err)
+	}
+	if _, err := s.ApproveChangeRequest(other, id, ""); !errors.Is(err, ErrChangeRequestNotPending) {
+		t.Fatalf("second ApproveChangeRequest: err = %v, want ErrChangeRequestNotPending", err)
+	}
+	if got := status(id); got != model.ConfigChangeRequestApproved {
+		t.Fatalf("status = %s, want approved", got)
+	}
+	if got := revisions(); got != 2 {
+		t.Fatalf("recorded %d revisions, want the update applied once", got)
+	}
+
+	// 执行失败时申请回到待审批
+	greeting.Content = "hey"
+	if _, err := s.UpdateConfig(requester, greeting, 0); !errors.As(err, &pending) {
+		t.Fatalf("expected the update to be queued, got %v", err)
+	}
+	if err := s.logic.environmentDAO.SetRequireApproval(requester, gdb, "prod", false); err != nil {
+		t.Fatalf("SetRequireApproval failed: %v", err)
+	}
+	greeting.Content = "yo"
+	if _, err := s.UpdateConfig(requester, greeting, 0); err != nil {
+		t.Fatalf("UpdateConfig failed: %v", err)
+	}
+	if _, err := s.ApproveChangeRequest(reviewer, int64(pending.Request.ID), ""); !errors.Is(err, ErrChangeRequestStale) {
+		t.Fatalf("ApproveChangeRequest of a stale request: err = %v, want ErrChangeRequestStale", err)
+	}
+	if got := status(int64(pending.Request.ID)); got != model.ConfigChangeRequestPending {
+		t.Fatalf("stale request status = %s, want pending", got)
+	}
+}
+
+// TestChangeRequestReleases checks that publishing and activating releases in
+// an environment requiring approval wait for a second user, and that the
+// request shows how the served configs change.
+func TestChangeRequestReleases(t *testing.T) {
+	gdb := db.SetupTestDB(t)
+	defer db.CleanupTestDB(t, gdb)
+	s := NewService(gdb, nil, "", &config.Config{})
+
+	requester := pkgcommon.ContextWithUsername(pkgcommon.ContextWithUserID(context.Background(), 1), "alice")
+	reviewer := pkgcommon.ContextWithUsername(pkgcommon.ContextWithUserID(context.Background(), 2), "bob")
+	if err := s.AddEnvironment(requester, &envpb.Environment{EnvironmentKey: "prod", EnvironmentName: "Prod", IsActive: true}); err != nil {
+		t.Fatalf("AddEnvironment failed: %v", err)
+	}
+	greeting, err := s.AddConfig(requester, &common.ResourceConfig{
+		EnvironmentKey: "prod", PipelineKey: "default", Name: "Greeting", Alias: "greeting", Type: "text", Content: "hello",
+	})
+	if err != nil {
+		t.Fatalf("AddConfig failed: %v", err)
+	}
+	if _, err := s.PublishRelease(requester, "prod", "default", "v1"); err != nil {
+		t.Fatalf("PublishRelease failed: %v", err)
+	}
+	greeting.Content = "hi"
+	if _, err := s.UpdateConfig(requester, greeting, 0); err != nil {
+		t.Fatalf("UpdateConfig failed: %v", err)
+	}
+	if err := s.logic.environmentDAO.SetRequireApproval(requester, gdb, "prod", true); err != nil {
+		t.Fatalf("SetRequireApproval failed: %v", err)
+	}
+	active := func() int {
+		t.Helper()
+		release, err := s.logic.releaseDAO.GetActive(requester, gdb, "prod", "default")
+		if err != nil {
+			t.Fatalf("GetActive failed: %v", err)
+		}
+		return release.Version
+	}
+	approve := func(err error, operation string) {
+		t.Helper()
+		var pending *ChangeRequestPendingError
+		if !errors.As(err, &pending) || pending.Request.Operation != operation {
+			t.Fatalf("expected %s to be queued, got %v", operation, err)
+		}
+		items, err := s.logic.ListChangeRequestItems(requester, pending.Request.ID)
+		if err != nil || len(items) != 1 || items[0].ResourceKey != greeting.GetResourceKey() || items[0].Action != model.ConfigRevisionActionRelease {
+			t.Fatalf("change request items = %+v, %v; want the greeting config", items, err)
+		}
+		if _, err := s.ApproveChangeRequest(reviewer, int64(pending.Request.ID), ""); err != nil {
+			t.Fatalf("ApproveChangeRequest %s failed: %v", operation, err)
+		}
+	}
+
+	_, err = s.PublishRelease(requester, "prod", "default", "v2")
+	if got := active(); got != 1 {
+		t.Fatalf("active release before approval = %d, want 1", got)
+	}
+	approve(err, model.ChangeRequestReleasePublish)
+	if got := active(); got != 2 {
+		t.Fatalf("active release after approval = %d, want 2", got)
+	}
+
+	_, err = s.ActivateRelease(requester, "prod", "default", 1)
+	if got := active(); got != 2 {
+		t.Fatalf("active release before approval = %d, want 2", got)
+	}
+	approve(err, model.ChangeRequestReleaseActivate)
+	if got := active(); got != 1 {
+		t.Fatalf("active release after approval = %d, want 1", got)
+	}
+}
@@ -3,44 +3,264 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
+	"github.com/yi-nology/rainbow_bridge/biz/dal/db"
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
 	"github.com/yi-nology/rainbow_bridge/biz/model/common"
+	configpb "github.com/yi-nology/rainbow_bridge/biz/model/config"
+	"github.com/yi-nology/rainbow_bridge/pkg/util"
 )
 
+// Search page sizes.
+const (
+	defaultSearchPageSize = 20
+	maxSearchPageSize     = 200
+)
+
+// ErrInvalidSearchFilter is returned for malformed label selectors or time bounds.
+var ErrInvalidSearchFilter = errors.New("invalid search filter")
+
 // --------------------- Config operations ---------------------
 
 func (s *Service) AddConfig(ctx context.Context, req *common.ResourceConfig) (*common.ResourceConfig, error) {
 	if req == nil {
 		return nil, errors.New("config payload required")
 	}
-	model := pbConfigToModel(req)
-	if err := s.logic.AddConfig(ctx, model); err != nil {
+	cfg, err := pbConfigToModel(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.queueConfigChange(ctx, model.ChangeRequestConfigCreate, cfg); err != nil {
+		return nil, err
+	}
+	if err := s.logic.AddConfig(ctx, cfg); err != nil {
 		return nil, err
 	}
-	return s.decorateConfig(modelConfigToPB(model)), nil
+	return s.decorateConfig(modelConfigToPB(cfg)), nil
 }
 
-func (s *Service) UpdateConfig(ctx context.Context, req *common.ResourceConfig) (*common.ResourceConfig, error) {
+// UpdateConfig updates a config. A non-zero expectedRevision is the revision
+// the update is based on; see Logic.UpdateConfig.
+func (s *Service) UpdateConfig(ctx context.Context, req *common.ResourceConfig, expectedRevision int64) (*common.ResourceConfig, error) {
 	if req == nil {
 		return nil, errors.New("config payload required")
 	}
-	model := pbConfigToModel(req)
-	if err := s.logic.UpdateConfig(ctx, model); err != nil {
+	cfg, err := pbConfigToModel(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.logic.checkConfigRevision(ctx, cfg.EnvironmentKey, cfg.PipelineKey, cfg.ResourceKey, expectedRevision); err != nil {
+		return nil, err
+	}
+	if err := s.queueConfigChange(ctx, model.ChangeRequestConfigUpdate, cfg); err != nil {
+		return nil, err
+	}
+	if err := s.logic.UpdateConfig(ctx, cfg, expectedRevision); err != nil {
 		return nil, err
 	}
-	updated, err := s.logic.GetConfig(ctx, model.EnvironmentKey, model.PipelineKey, model.ResourceKey)
+	updated, err := s.logic.GetConfig(ctx, cfg.EnvironmentKey, cfg.PipelineKey, cfg.ResourceKey)
 	if err != nil {
 		return nil, err
 	}
 	return s.decorateConfig(modelConfigToPB(updated)), nil
 }
 
-func (s *Service) DeleteConfig(ctx context.Context, environmentKey, pipelineKey, resourceKey string) error {
-	return s.logic.DeleteConfig(ctx, environmentKey, pipelineKey, resourceKey)
+// DeleteConfig deletes a config. A non-zero expectedRevision is the revision
+// the delete is based on; see Logic.DeleteConfig.
+func (s *Service) DeleteConfig(ctx context.Context, environmentKey, pipelineKey, resourceKey string, expectedRevision int64) error {
+	if err := s.logic.checkConfigRevision(ctx, environmentKey, pipelineKey, resourceKey, expectedRevision); err != nil {
+		return err
+	}
+	target := &model.Config{EnvironmentKey: environmentKey, PipelineKey: pipelineKey, ResourceKey: resourceKey}
+	if err := s.queueConfigChange(ctx, model.ChangeRequestConfigDelete, target); err != nil {
+		return err
+	}
+	return s.logic.DeleteConfig(ctx, environmentKey, pipelineKey, resourceKey, expectedRevision)
+}
+
+// BatchConfigs applies a batch of config writes atomically. If any operation
+// fails nothing is written, and the returned data reports the error of each
+// operation along with ErrConfigBatchFailed wrapping the first of them.
+func (s *Service) BatchConfigs(ctx context.Context, req []*configpb.BatchConfigOperation) (*configpb.BatchConfigData, error) {
+	operations := make([]ConfigBatchOperation, len(req))
+	results := make([]ConfigBatchResult, len(req))
+	failed := false
+	for i, op := range req {
+		cfg, err := pbConfigToModel(op.GetConfig())
+		if err != nil {
+			results[i].Err = err
+			failed = true
+			continue
+		}
+		operations[i] = ConfigBatchOperation{
+			Action:           strings.ToLower(strings.TrimSpace(op.GetAction())),
+			Config:           *cfg,
+			ExpectedRevision: op.GetExpectedRevision(),
+		}
+	}
+	if failed {
+		return s.batchConfigData(operations, results), configBatchError(results)
+	}
+
+	environmentKeys := make([]string, 0, len(operations))
+	for i := range operations {
+		environmentKeys = append(environmentKeys, operations[i].Config.EnvironmentKey)
+	}
+	required, err := s.logic.approvalEnvironments(ctx, environmentKeys...)
+	if err != nil {
+		return nil, err
+	}
+	if len(required) > 0 {
+		summary := fmt.Sprintf("批量变更 %d 个配置", len(operations))
+		return nil, s.submitChangeRequest(ctx, model.ChangeRequestConfigBatch, summary, &changeRequestPayload{Operations: operations}, required)
+	}
+
+	results, err = s.logic.ApplyConfigBatch(ctx, operations)
+	if err != nil {
+		if errors.Is(err, ErrConfigBatchFailed) {
+			return s.batchConfigData(operations, results), configBatchError(results)
+		}
+		return nil, err
+	}
+	return s.batchConfigData(operations, results), nil
+}
+
+func (s *Service) batchConfigData(operations []ConfigBatchOperation, results []ConfigBatchResult) *configpb.BatchConfigData {
+	failed := false
+	for i := range results {
+		failed = failed || results[i].Err != nil
+	}
+	data := &configpb.BatchConfigData{
+		Total: int32(len(results)), // #nosec G115 -- count will not exceed int32
+		Items: make([]*configpb.BatchConfigResult, 0, len(results)),
+	}
+	for i := range results {
+		item := &configpb.BatchConfigResult{
+			Index:  int32(i), // #nosec G115 -- count will not exceed int32
+			Action: operations[i].Action,
+		}
+		switch {
+		case results[i].Err != nil:
+			item.Status = "failed"
+			item.Error = results[i].Err.Error()
+			var schemaErr *SchemaValidationError
+			if errors.As(results[i].Err, &schemaErr) {
+				item.Violations = SchemaViolationsToPB(schemaErr.Violations)
+			}
+			data.Failed++
+		case failed:
+			item.Status = "skipped"
+		default:
+			item.Status = "succeeded"
+			item.Config = s.decorateConfig(modelConfigToPB(results[i].Config))
+			data.Succeeded++
+		}
+		data.Items = append(data.Items, item)
+	}
+	return data
+}
+
+// RenameConfigAlias renames an alias, see Logic.RenameConfigAlias. In
+// environments that require approval the rename is queued as a change request.
+func (s *Service) RenameConfigAlias(ctx context.Context, req *configpb.RenameConfigAliasRequest) (*configpb.ConfigAliasRenameData, error) {
+	maxDays := int32(MaxAliasRedirect / (24 * time.Hour))
+	if days := req.GetRedirectDays(); days < 0 || days > maxDays {
+		return nil, fmt.Errorf("%w: redirect_days must be between 0 and %d", ErrConfigAliasRenameInvalid, maxDays)
+	}
+	input := ConfigAliasRename{
+		EnvironmentKey: strings.TrimSpace(req.GetEnvironmentKey()),
+		PipelineKey:    strings.TrimSpace(req.GetPipelineKey()),
+		Alias:          strings.TrimSpace(req.GetAlias()),
+		NewAlias:       strings.TrimSpace(req.GetNewAlias()),
+		AllPipelines:   req.GetAllPipelines(),
+		RedirectFor:    time.Duration(req.GetRedirectDays()) * 24 * time.Hour,
+	}
+	if input.AllPipelines {
+		input.PipelineKey = ""
+	}
+	if err := validateAliasRename(input); err != nil {
+		return nil, err
+	}
+
+	required, err := s.logic.approvalEnvironments(ctx, input.EnvironmentKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(required) > 0 {
+		summary := fmt.Sprintf("重命名别名 %s → %s（%s）", input.Alias, input.NewAlias, input.EnvironmentKey)
+		return nil, s.submitChangeRequest(ctx, model.ChangeRequestConfigRename, summary, &changeRequestPayload{Rename: &input}, required)
+	}
+
+	result, err := s.logic.RenameConfigAlias(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	data := &configpb.ConfigAliasRenameData{
+		Renamed:   s.decorateConfigList(configSliceToPB(result.Renamed)),
+		Rewritten: s.decorateConfigList(configSliceToPB(result.Rewritten)),
+		Redirects: make([]*configpb.ConfigAliasRedirect, 0, len(result.Redirects)),
+	}
+	for i := range result.Redirects {
+		data.Redirects = append(data.Redirects, aliasRedirectModelToPB(&result.Redirects[i]))
+	}
+	return data, nil
 }
 
-func (s *Service) ListConfigs(ctx context.Context, environmentKey, pipelineKey, typ, minVer, maxVer string, isLatest bool) ([]*common.ResourceConfig, error) {
-	configs, err := s.logic.ListConfigs(ctx, environmentKey, pipelineKey, minVer, maxVer, typ, isLatest)
+// ListAliasRedirects returns the deprecated aliases of an environment still served.
+func (s *Service) ListAliasRedirects(ctx context.Context, environmentKey, pipelineKey string) ([]*configpb.ConfigAliasRedirect, error) {
+	if strings.TrimSpace(environmentKey) == "" {
+		return nil, fmt.Errorf("%w: environment_key is required", ErrConfigAliasRenameInvalid)
+	}
+	redirects, err := s.logic.ListAliasRedirects(ctx, strings.TrimSpace(environmentKey), strings.TrimSpace(pipelineKey))
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*configpb.ConfigAliasRedirect, 0, len(redirects))
+	for i := range redirects {
+		list = append(list, aliasRedirectModelToPB(&redirects[i]))
+	}
+	return list, nil
+}
+
+func aliasRedirectModelToPB(redirect *model.ConfigAliasRedirect) *configpb.ConfigAliasRedirect {
+	return &configpb.ConfigAliasRedirect{
+		EnvironmentKey: redirect.EnvironmentKey,
+		PipelineKey:    redirect.PipelineKey,
+		OldAlias:       redirect.OldAlias,
+		NewAlias:       redirect.NewAlias,
+		ExpiresAt:      redirect.ExpiresAt.UTC().Format(time.RFC3339),
+		OperatorName:   redirect.OperatorName,
+		CreatedAt:      redirect.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// RevisionConflictConfig returns the current server value carried by a
+// revision conflict, or nil when err is not one or the config is hidden from
+// the caller.
+func (s *Service) RevisionConflictConfig(ctx context.Context, err error) *common.ResourceConfig {
+	var conflict *ConfigRevisionConflictError
+	if !errors.As(err, &conflict) || !configVisibilityFor(ctx).visible(conflict.Current) {
+		return nil
+	}
+	return s.decorateConfig(modelConfigToPB(conflict.Current))
+}
+
+// queueConfigChange turns a write to an environment that requires approval
+// into a change request and returns the *ChangeRequestPendingError reporting
+// it; it returns nil when the write may go ahead.
+func (s *Service) queueConfigChange(ctx context.Context, operation string, cfg *model.Config) error {
+	environmentKeys, err := s.logic.approvalEnvironments(ctx, cfg.EnvironmentKey)
+	if err != nil || len(environmentKeys) == 0 {
+		return err
+	}
+	return s.submitChangeRequest(ctx, operation, "", &changeRequestPayload{Configs: []model.Config{*cfg}}, environmentKeys)
+}
+
+func (s *Service) ListConfigs(ctx context.Context, environmentKey, pipelineKey, typ, minVer, maxVer string, isLatest, includeInherited bool) ([]*common.ResourceConfig, error) {
+	configs, err := s.logic.ListConfigs(ctx, environmentKey, pipelineKey, minVer, maxVer, typ, isLatest, includeInherited)
 	if err != nil {
 		return nil, err
 	}
@@ -52,5 +272,230 @@ func (s *Service) GetConfigDetail(ctx context.Context, environmentKey, pipelineK
 	if err != nil {
 		return nil, err
 	}
+	if err := configVisibilityFor(ctx).check(cfg); err != nil {
+		return nil, err
+	}
+	return s.decorateConfig(modelConfigToPB(cfg)), nil
+}
+
+// SearchConfigs finds configs across environments and pipelines. Results are
+// always paginated: page defaults to 1 and page_size to 20 (at most 200).
+func (s *Service) SearchConfigs(ctx context.Context, req *configpb.SearchConfigRequest) ([]*common.ResourceConfig, int64, error) {
+	filter := db.ConfigSearchFilter{
+		EnvironmentKey: strings.TrimSpace(req.GetEnvironmentKey()),
+		PipelineKey:    strings.TrimSpace(req.GetPipelineKey()),
+		Type:           strings.TrimSpace(req.GetType()),
+		Keyword:        strings.TrimSpace(req.GetKeyword()),
+	}
+	labels, err := util.ParseLabelSelector(req.GetLabelSelector())
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %v", ErrInvalidSearchFilter, err)
+	}
+	filter.Labels = labels
+	if filter.UpdatedAfter, err = parseSearchTime("updated_after", req.GetUpdatedAfter()); err != nil {
+		return nil, 0, err
+	}
+	if filter.UpdatedBefore, err = parseSearchTime("updated_before", req.GetUpdatedBefore()); err != nil {
+		return nil, 0, err
+	}
+
+	page, pageSize := int(req.GetPage()), int(req.GetPageSize())
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultSearchPageSize
+	}
+	if pageSize > maxSearchPageSize {
+		pageSize = maxSearchPageSize
+	}
+	configs, total, err := s.logic.SearchConfigs(ctx, filter, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	return s.decorateConfigList(configSliceToPB(configs)), total, nil
+}
+
+func parseSearchTime(field, value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %s must be an RFC 3339 time", ErrInvalidSearchFilter, field)
+	}
+	return parsed, nil
+}
+
+// ListScheduledChanges returns the upcoming activations and expiries of an
+// environment, optionally narrowed to a pipeline and bounded by until (RFC 3339).
+func (s *Service) ListScheduledChanges(ctx context.Context, environmentKey, pipelineKey, until string) ([]*configpb.ScheduledChange, error) {
+	var bound time.Time
+	if until = strings.TrimSpace(until); until != "" {
+		parsed, err := time.Parse(time.RFC3339, until)
+		if err != nil {
+			return nil, fmt.Errorf("%w: until must be an RFC 3339 time", ErrConfigScheduleInvalid)
+		}
+		bound = parsed
+	}
+	changes, err := s.logic.ListScheduledChanges(ctx, environmentKey, pipelineKey, bound)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*configpb.ScheduledChange, 0, len(changes))
+	for i := range changes {
+		list = append(list, &configpb.ScheduledChange{
+			Action: changes[i].Action,
+			At:     util.FormatScheduleTime(&changes[i].At, changes[i].Config.ScheduleTimezone),
+			Config: s.decorateConfig(modelConfigToPB(&changes[i].Config)),
+		})
+	}
+	return list, nil
+}
+
+// UpdateConfigLabels replaces the labels of a config.
+func (s *Service) UpdateConfigLabels(ctx context.Context, req *configpb.UpdateConfigLabelsRequest) (*common.ResourceConfig, error) {
+	cfg, err := s.logic.UpdateConfigLabels(ctx, req.GetEnvironmentKey(), req.GetPipelineKey(), req.GetResourceKey(), req.GetLabels())
+	if err != nil {
+		return nil, err
+	}
+	return s.decorateConfig(modelConfigToPB(cfg)), nil
+}
+
+// UpdateConfigTranslations replaces the translations of a config. In
+// environments that require approval the change is queued instead.
+func (s *Service) UpdateConfigTranslations(ctx context.Context, req *configpb.UpdateConfigTranslationsRequest) (*common.ResourceConfig, error) {
+	translations, err := util.NormalizeTranslations(req.GetTranslations())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrConfigTranslationsInvalid, err)
+	}
+	target := &model.Config{
+		EnvironmentKey: req.GetEnvironmentKey(),
+		PipelineKey:    req.GetPipelineKey(),
+		ResourceKey:    req.GetResourceKey(),
+		Translations:   util.FormatTranslations(translations),
+	}
+	if err := s.queueConfigChange(ctx, model.ChangeRequestConfigTranslate, target); err != nil {
+		return nil, err
+	}
+	cfg, err := s.logic.UpdateConfigTranslations(ctx, target.EnvironmentKey, target.PipelineKey, target.ResourceKey, translations)
+	if err != nil {
+		return nil, err
+	}
+	return s.decorateConfig(modelConfigToPB(cfg)), nil
+}
+
+// ListMissingTranslations reports, per locale of a pipeline, the text-like
+// configs without a translation into it.
+func (s *Service) ListMissingTranslations(ctx context.Context, req *configpb.MissingTranslationsRequest) (*configpb.MissingTranslationsData, error) {
+	if req.GetEnvironmentKey() == "" {
+		return nil, errors.New("environment_key is required")
+	}
+	if req.GetPipelineKey() == "" {
+		return nil, ErrPipelineKeyRequired
+	}
+	defaultLocale, missing, err := s.logic.ListMissingTranslations(ctx, req.GetEnvironmentKey(), req.GetPipelineKey(), req.GetLocale())
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*configpb.MissingTranslation, 0, len(missing))
+	for i := range missing {
+		list = append(list, &configpb.MissingTranslation{
+			Locale:  missing[i].Locale,
+			Total:   int32(len(missing[i].Configs)), // #nosec G115 -- count will not exceed int32
+			Configs: s.decorateConfigList(configSliceToPB(missing[i].Configs)),
+		})
+	}
+	return &configpb.MissingTranslationsData{DefaultLocale: defaultLocale, List: list}, nil
+}
+
+// PreviewConfig returns a config with its references resolved, plus the raw
+// content and the references it contains.
+func (s *Service) PreviewConfig(ctx context.Context, environmentKey, pipelineKey, resourceKey string) (*configpb.ConfigPreviewData, error) {
+	preview, err := s.logic.PreviewConfig(ctx, environmentKey, pipelineKey, resourceKey)
+	if err != nil {
+		return nil, err
+	}
+	references := make([]string, 0, len(preview.References))
+	seen := make(map[string]bool, len(preview.References))
+	for _, ref := range preview.References {
+		if name := ref.String(); !seen[name] {
+			seen[name] = true
+			references = append(references, name)
+		}
+	}
+	return &configpb.ConfigPreviewData{
+		Config:     s.decorateConfig(modelConfigToPB(&preview.Config)),
+		RawContent: preview.RawContent,
+		References: references,
+	}, nil
+}
+
+// ListConfigHistory returns the recorded revisions of a config, newest first.
+// Snapshots of the config while it was hidden from the caller are left out.
+func (s *Service) ListConfigHistory(ctx context.Context, environmentKey, pipelineKey, resourceKey string, page, pageSize int) ([]*configpb.ConfigRevision, int64, error) {
+	revisions, total, err := s.logic.ListConfigRevisions(ctx, environmentKey, pipelineKey, resourceKey, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	visibility := configVisibilityFor(ctx)
+	list := make([]*configpb.ConfigRevision, 0, len(revisions))
+	for i := range revisions {
+		item, err := s.revisionModelToPB(visibility, &revisions[i])
+		if err != nil {
+			return nil, 0, err
+		}
+		list = append(list, item)
+	}
+	return list, total, nil
+}
+
+// RollbackConfig restores a config to the state recorded by a revision. In
+// environments that require approval the rollback is queued as a change request.
+func (s *Service) RollbackConfig(ctx context.Context, revisionID int64) (*common.ResourceConfig, error) {
+	if revisionID <= 0 {
+		return nil, ErrRevisionNotFound
+	}
+	// 审批前同样检查调用方能否查看该历史版本
+	target, _, err := s.logic.rollbackTarget(ctx, uint(revisionID))
+	if err != nil {
+		return nil, err
+	}
+	required, err := s.logic.approvalEnvironments(ctx, target.EnvironmentKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(required) > 0 {
+		return nil, s.submitChangeRequest(ctx, model.ChangeRequestConfigRollback, "", &changeRequestPayload{RevisionID: uint(revisionID)}, required)
+	}
+	cfg, err := s.logic.RollbackConfig(ctx, uint(revisionID))
+	if err != nil {
+		return nil, err
+	}
 	return s.decorateConfig(modelConfigToPB(cfg)), nil
 }
+
+func (s *Service) revisionModelToPB(visibility configVisibility, revision *model.ConfigRevision) (*configpb.ConfigRevision, error) {
+	before, err := unmarshalConfigSnapshot(revision.Before)
+	if err != nil {
+		return nil, err
+	}
+	after, err := unmarshalConfigSnapshot(revision.After)
+	if err != nil {
+		return nil, err
+	}
+	return &configpb.ConfigRevision{
+		Id:             int64(revision.ID),
+		EnvironmentKey: revision.EnvironmentKey,
+		PipelineKey:    revision.PipelineKey,
+		ResourceKey:    revision.ResourceKey,
+		Alias:          revision.Alias,
+		Action:         revision.Action,
+		Before:         s.decorateConfig(modelConfigToPB(visibility.redact(before))),
+		After:          s.decorateConfig(modelConfigToPB(visibility.redact(after))),
+		OperatorId:     int32(revision.OperatorID), // #nosec G115 -- user IDs fit in int32
+		OperatorName:   revision.OperatorName,
+		CreatedAt:      revision.CreatedAt.Format(time.RFC3339),
+	}, nil
+}
@@ -3,9 +3,11 @@ package service
 import (
 	"context"
 	"errors"
+	"slices"
 
 	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
 	envpb "github.com/yi-nology/rainbow_bridge/biz/model/environment"
+	pkgcommon "github.com/yi-nology/rainbow_bridge/pkg/common"
 	"gorm.io/gorm"
 )
 
@@ -13,6 +15,12 @@ var (
 	ErrEnvironmentNotFound    = errors.New("environment not found")
 	ErrEnvironmentKeyRequired = errors.New("environment_key is required")
 	ErrEnvironmentKeyExists   = errors.New("environment_key already exists")
+	// ErrApprovalDisableForbidden is returned when a user without an admin
+	// role turns off the approval requirement of an environment.
+	ErrApprovalDisableForbidden = errors.New("only admins may turn off approval of an environment")
+	// ErrEnvironmentDeleteForbidden is returned when a user without an admin
+	// role deletes an environment that requires approval.
+	ErrEnvironmentDeleteForbidden = errors.New("only admins may delete an environment that requires approval")
 )
 
 // AddEnvironment creates a new environment with default pipeline.
@@ -35,6 +43,7 @@ func (s *Service) AddEnvironment(ctx context.Context, env *envpb.Environment) er
 		Description:     env.GetDescription(),
 		SortOrder:       int(env.GetSortOrder()),
 		IsActive:        env.GetIsActive(),
+		RequireApproval: env.GetRequireApproval(),
 	}
 
 	// Use transaction to ensure atomicity
@@ -59,19 +68,31 @@ func (s *Service) AddEnvironment(ctx context.Context, env *envpb.Environment) er
 	})
 }
 
-// UpdateEnvironment updates an existing environment.
+// UpdateEnvironment updates an existing environment. The approval requirement
+// is only changed when sent; turning it off needs an admin role and is
+// subject to the freeze windows of the environment.
 func (s *Service) UpdateEnvironment(ctx context.Context, env *envpb.Environment) error {
 	if env == nil || env.GetEnvironmentKey() == "" {
 		return ErrEnvironmentKeyRequired
 	}
 
-	_, err := s.logic.environmentDAO.GetByKey(ctx, s.logic.db, env.GetEnvironmentKey())
+	current, err := s.logic.environmentDAO.GetByKey(ctx, s.logic.db, env.GetEnvironmentKey())
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return ErrEnvironmentNotFound
 		}
 		return err
 	}
+	var overrides []model.EnvironmentFreezeOverride
+	if env.RequireApproval != nil && !env.GetRequireApproval() && current.RequireApproval {
+		if !s.isAdmin(ctx) {
+			return ErrApprovalDisableForbidden
+		}
+		overrides, err = s.logic.checkEnvironmentWritable(ctx, "environment.approval", current.EnvironmentKey)
+		if err != nil {
+			return err
+		}
+	}
 
 	entity := &model.Environment{
 		EnvironmentKey:  env.GetEnvironmentKey(),
@@ -80,24 +101,52 @@ func (s *Service) UpdateEnvironment(ctx context.Context, env *envpb.Environment)
 		SortOrder:       int(env.GetSortOrder()),
 		IsActive:        env.GetIsActive(),
 	}
-	return s.logic.environmentDAO.Update(ctx, s.logic.db, entity)
+	err = s.logic.transactWithOverrides(ctx, overrides, func(tx *gorm.DB) error {
+		if err := s.logic.environmentDAO.Update(ctx, tx, entity); err != nil {
+			return err
+		}
+		if env.RequireApproval != nil {
+			// Updates skips false, so the approval flag is written on its own
+			if err := s.logic.environmentDAO.SetRequireApproval(ctx, tx, entity.EnvironmentKey, env.GetRequireApproval()); err != nil {
+				return err
+			}
+		}
+		// 运行时响应包含环境信息
+		return s.logic.recordRuntimeChange(ctx, tx, entity.EnvironmentKey, model.BasePipelineKey)
+	})
+	if err != nil {
+		return err
+	}
+	s.logic.announceRuntimeChange(ctx, entity.EnvironmentKey, model.BasePipelineKey)
+	return nil
 }
 
-// DeleteEnvironment deletes an environment by key.
+// DeleteEnvironment deletes an environment by key. Deleting is subject to the
+// freeze windows of the environment and, since the environment could be added
+// back without approval, needs an admin role when it requires approval.
 func (s *Service) DeleteEnvironment(ctx context.Context, environmentKey string) error {
 	if environmentKey == "" {
 		return ErrEnvironmentKeyRequired
 	}
 
-	_, err := s.logic.environmentDAO.GetByKey(ctx, s.logic.db, environmentKey)
+	current, err := s.logic.environmentDAO.GetByKey(ctx, s.logic.db, environmentKey)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return ErrEnvironmentNotFound
 		}
 		return err
 	}
+	if current.RequireApproval && !s.isAdmin(ctx) {
+		return ErrEnvironmentDeleteForbidden
+	}
+	overrides, err := s.logic.checkEnvironmentWritable(ctx, "environment.delete", environmentKey)
+	if err != nil {
+		return err
+	}
 
-	return s.logic.environmentDAO.Delete(ctx, s.logic.db, environmentKey)
+	return s.logic.transactWithOverrides(ctx, overrides, func(tx *gorm.DB) error {
+		return s.logic.environmentDAO.Delete(ctx, tx, environmentKey)
+	})
 }
 
 // GetEnvironment returns an environment by key.
@@ -127,6 +176,15 @@ func (s *Service) ListEnvironments(ctx context.Context, isActive *bool) ([]*envp
 	return environmentSliceToPB(entities), nil
 }
 
+// isAdmin reports whether the authenticated role is listed in auth.admin_roles.
+func (s *Service) isAdmin(ctx context.Context) bool {
+	role := pkgcommon.GetUserRole(ctx)
+	if role == "" || s.config == nil {
+		return false
+	}
+	return slices.Contains(s.config.Auth.AdminRoles, role)
+}
+
 func modelEnvironmentToPB(env *model.Environment) *envpb.Environment {
 	if env == nil {
 		return nil
@@ -137,6 +195,7 @@ func modelEnvironmentToPB(env *model.Environment) *envpb.Environment {
 		Description:     env.Description,
 		SortOrder:       int32(env.SortOrder),
 		IsActive:        env.IsActive,
+		RequireApproval: &env.RequireApproval,
 	}
 }
 
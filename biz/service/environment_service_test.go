@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/db"
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	envpb "github.com/yi-nology/rainbow_bridge/biz/model/environment"
+	pkgcommon "github.com/yi-nology/rainbow_bridge/pkg/common"
+	"github.com/yi-nology/rainbow_bridge/pkg/config"
+)
+
+// TestUpdateEnvironmentApproval checks that updates keep the approval
+// requirement unless they send it, and that only admins outside freeze windows
+// may turn it off.
+func TestUpdateEnvironmentApproval(t *testing.T) {
+	gdb := db.SetupTestDB(t)
+	defer db.CleanupTestDB(t, gdb)
+	s := NewService(gdb, nil, "", &config.Config{Auth: config.AuthConfig{AdminRoles: []string{"admin"}}})
+
+	user := pkgcommon.ContextWithUsername(pkgcommon.ContextWithUserID(context.Background(), 1), "alice")
+	admin := pkgcommon.ContextWithUserRole(user, "admin")
+	on, off := true, false
+	if err := s.AddEnvironment(user, &envpb.Environment{EnvironmentKey: "prod", EnvironmentName: "Prod", IsActive: true, RequireApproval: &on}); err != nil {
+		t.Fatalf("AddEnvironment failed: %v", err)
+	}
+	requireApproval := func() bool {
+		t.Helper()
+		env, err := s.GetEnvironment(user, "prod")
+		if err != nil {
+			t.Fatalf("GetEnvironment failed: %v", err)
+		}
+		return env.GetRequireApproval()
+	}
+
+	if err := s.UpdateEnvironment(user, &envpb.Environment{EnvironmentKey: "prod", EnvironmentName: "Production", IsActive: true}); err != nil {
+		t.Fatalf("UpdateEnvironment failed: %v", err)
+	}
+	if !requireApproval() {
+		t.Fatal("expected an update without require_approval to keep it")
+	}
+	if err := s.UpdateEnvironment(user, &envpb.Environment{EnvironmentKey: "prod", IsActive: true, RequireApproval: &off}); !errors.Is(err, ErrApprovalDisableForbidden) {
+		t.Fatalf("UpdateEnvironment by a user: err = %v, want ErrApprovalDisableForbidden", err)
+	}
+	if !requireApproval() {
+		t.Fatal("expected a user to be unable to turn off approval")
+	}
+
+	starts, ends := time.Now().Add(-time.Hour), time.Now().Add(time.Hour)
+	freeze := &model.EnvironmentFreeze{EnvironmentKey: "prod", Name: "Release", Recurrence: model.EnvironmentFreezeOnce, StartsAt: &starts, EndsAt: &ends}
+	if err := s.logic.CreateEnvironmentFreeze(admin, freeze); err != nil {
+		t.Fatalf("CreateEnvironmentFreeze failed: %v", err)
+	}
+	if err := s.UpdateEnvironment(admin, &envpb.Environment{EnvironmentKey: "prod", IsActive: true, RequireApproval: &off}); !errors.Is(err, ErrEnvironmentFrozen) {
+		t.Fatalf("UpdateEnvironment in a freeze window: err = %v, want ErrEnvironmentFrozen", err)
+	}
+	if err := s.logic.freezeDAO.Delete(admin, gdb, freeze.ID); err != nil {
+		t.Fatalf("delete freeze: %v", err)
+	}
+
+	if err := s.UpdateEnvironment(admin, &envpb.Environment{EnvironmentKey: "prod", IsActive: true, RequireApproval: &off}); err != nil {
+		t.Fatalf("UpdateEnvironment by an admin failed: %v", err)
+	}
+	if requireApproval() {
+		t.Fatal("expected an admin to turn off approval")
+	}
+	if err := s.UpdateEnvironment(user, &envpb.Environment{EnvironmentKey: "prod", IsActive: true, RequireApproval: &on}); err != nil || !requireApproval() {
+		t.Fatalf("expected a user to turn approval back on, err = %v", err)
+	}
+}
+
+// TestDeleteEnvironmentApproval checks that only admins outside freeze windows
+// may delete an environment that requires approval, so that approval cannot be
+// dropped by adding it back.
+func TestDeleteEnvironmentApproval(t *testing.T) {
+	gdb := db.SetupTestDB(t)
+	defer db.CleanupTestDB(t, gdb)
+	s := NewService(gdb, nil, "", &config.Config{Auth: config.AuthConfig{AdminRoles: []string{"admin"}}})
+
+	user := pkgcommon.ContextWithUsername(pkgcommon.ContextWithUserID(context.Background(), 1), "alice")
+	admin := pkgcommon.ContextWithUserRole(user, "admin")
+	on := true
+	if err := s.AddEnvironment(user, &envpb.Environment{EnvironmentKey: "prod", EnvironmentName: "Prod", IsActive: true, RequireApproval: &on}); err != nil {
+		t.Fatalf("AddEnvironment failed: %v", err)
+	}
+	if err := s.AddEnvironment(user, &envpb.Environment{EnvironmentKey: "dev", EnvironmentName: "Dev", IsActive: true}); err != nil {
+		t.Fatalf("AddEnvironment failed: %v", err)
+	}
+
+	if err := s.DeleteEnvironment(user, "prod"); !errors.Is(err, ErrEnvironmentDeleteForbidden) {
+		t.Fatalf("DeleteEnvironment by a user: err = %v, want ErrEnvironmentDeleteForbidden", err)
+	}
+	if _, err := s.GetEnvironment(user, "prod"); err != nil {
+		t.Fatalf("expected prod to be kept, got %v", err)
+	}
+	if err := s.DeleteEnvironment(user, "dev"); err != nil {
+		t.Fatalf("DeleteEnvironment of an environment without approval failed: %v", err)
+	}
+
+	starts, ends := time.Now().Add(-time.Hour), time.Now().Add(time.Hour)
+	freeze := &model.EnvironmentFreeze{EnvironmentKey: "prod", Name: "Release", Recurrence: model.EnvironmentFreezeOnce, StartsAt: &starts, EndsAt: &ends}
+	if err := s.logic.CreateEnvironmentFreeze(admin, freeze); err != nil {
+		t.Fatalf("CreateEnvironmentFreeze failed: %v", err)
+	}
+	if err := s.DeleteEnvironment(admin, "prod"); !errors.Is(err, ErrEnvironmentFrozen) {
+		t.Fatalf("DeleteEnvironment in a freeze window: err = %v, want ErrEnvironmentFrozen", err)
+	}
+	if err := s.logic.freezeDAO.Delete(admin, gdb, freeze.ID); err != nil {
+		t.Fatalf("delete freeze: %v", err)
+	}
+	if err := s.DeleteEnvironment(admin, "prod"); err != nil {
+		t.Fatalf("DeleteEnvironment by an admin failed: %v", err)
+	}
+	if _, err := s.GetEnvironment(user, "prod"); !errors.Is(err, ErrEnvironmentNotFound) {
+		t.Fatalf("GetEnvironment after delete: err = %v, want ErrEnvironmentNotFound", err)
+	}
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	envpb "github.com/yi-nology/rainbow_bridge/biz/model/environment"
+	"github.com/yi-nology/rainbow_bridge/pkg/util"
+)
+
+// --------------------- Environment freeze operations ---------------------
+
+// ListEnvironmentFreezes returns the freeze windows of an environment with
+// their current state.
+func (s *Service) ListEnvironmentFreezes(ctx context.Context, environmentKey string) ([]*envpb.EnvironmentFreeze, error) {
+	if environmentKey == "" {
+		return nil, ErrEnvironmentKeyRequired
+	}
+	freezes, err := s.logic.ListEnvironmentFreezes(ctx, environmentKey)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	list := make([]*envpb.EnvironmentFreeze, 0, len(freezes))
+	for i := range freezes {
+		list = append(list, modelFreezeToPB(&freezes[i], now))
+	}
+	return list, nil
+}
+
+// CreateEnvironmentFreeze adds a freeze window to an environment.
+func (s *Service) CreateEnvironmentFreeze(ctx context.Context, req *envpb.EnvironmentFreeze) (*envpb.EnvironmentFreeze, error) {
+	if req == nil || req.GetEnvironmentKey() == "" {
+		return nil, ErrEnvironmentKeyRequired
+	}
+	freeze, err := pbFreezeToModel(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.logic.CreateEnvironmentFreeze(ctx, freeze); err != nil {
+		return nil, err
+	}
+	return modelFreezeToPB(freeze, time.Now()), nil
+}
+
+// UpdateEnvironmentFreeze replaces a freeze window.
+func (s *Service) UpdateEnvironmentFreeze(ctx context.Context, req *envpb.EnvironmentFreeze) (*envpb.EnvironmentFreeze, error) {
+	if req == nil || req.GetId() <= 0 {
+		return nil, ErrEnvironmentFreezeNotFound
+	}
+	freeze, err := pbFreezeToModel(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.logic.UpdateEnvironmentFreeze(ctx, freeze); err != nil {
+		return nil, err
+	}
+	return modelFreezeToPB(freeze, time.Now()), nil
+}
+
+// DeleteEnvironmentFreeze removes a freeze window.
+func (s *Service) DeleteEnvironmentFreeze(ctx context.Context, id int64) error {
+	if id <= 0 {
+		return ErrEnvironmentFreezeNotFound
+	}
+	return s.logic.DeleteEnvironmentFreeze(ctx, uint(id))
+}
+
+// ListFreezeOverrides returns the overrides recorded for an environment, newest first.
+func (s *Service) ListFreezeOverrides(ctx context.Context, environmentKey string, page, pageSize int) ([]*envpb.FreezeOverride, int64, error) {
+	if environmentKey == "" {
+		return nil, 0, ErrEnvironmentKeyRequired
+	}
+	overrides, total, err := s.logic.ListFreezeOverrides(ctx, environmentKey, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	list := make([]*envpb.FreezeOverride, 0, len(overrides))
+	for i := range overrides {
+		list = append(list, &envpb.FreezeOverride{
+			Id:             int64(overrides[i].ID),
+			FreezeId:       int64(overrides[i].FreezeID),
+			EnvironmentKey: overrides[i].EnvironmentKey,
+			Operation:      overrides[i].Operation,
+			Reason:         overrides[i].Reason,
+			OperatorId:     int64(overrides[i].OperatorID),
+			OperatorName:   overrides[i].OperatorName,
+			CreatedAt:      overrides[i].CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return list, total, nil
+}
+
+func pbFreezeToModel(req *envpb.EnvironmentFreeze) (*model.EnvironmentFreeze, error) {
+	startsAt, err := util.ParseScheduleTime(req.GetStartsAt(), req.GetTimezone())
+	if err != nil {
+		return nil, fmt.Errorf("%w: starts_at: %v", ErrEnvironmentFreezeInvalid, err)
+	}
+	endsAt, err := util.ParseScheduleTime(req.GetEndsAt(), req.GetTimezone())
+	if err != nil {
+		return nil, fmt.Errorf("%w: ends_at: %v", ErrEnvironmentFreezeInvalid, err)
+	}
+	return &model.EnvironmentFreeze{
+		ID:             uint(req.GetId()),
+		EnvironmentKey: req.GetEnvironmentKey(),
+		Name:           req.GetName(),
+		Reason:         strings.TrimSpace(req.GetReason()),
+		Recurrence:     req.GetRecurrence(),
+		StartsAt:       startsAt,
+		EndsAt:         endsAt,
+		WeeklyStart:    req.GetWeeklyStart(),
+		WeeklyEnd:      req.GetWeeklyEnd(),
+		Timezone:       req.GetTimezone(),
+		AllowedUsers:   strings.Join(req.GetAllowedUsers(), ","),
+	}, nil
+}
+
+func modelFreezeToPB(freeze *model.EnvironmentFreeze, now time.Time) *envpb.EnvironmentFreeze {
+	item := &envpb.EnvironmentFreeze{
+		Id:             int64(freeze.ID),
+		EnvironmentKey: freeze.EnvironmentKey,
+		Name:           freeze.Name,
+		Reason:         freeze.Reason,
+		Recurrence:     freeze.Recurrence,
+		StartsAt:       util.FormatScheduleTime(freeze.StartsAt, freeze.Timezone),
+		EndsAt:         util.FormatScheduleTime(freeze.EndsAt, freeze.Timezone),
+		WeeklyStart:    freeze.WeeklyStart,
+		WeeklyEnd:      freeze.WeeklyEnd,
+		Timezone:       freeze.Timezone,
+		AllowedUsers:   freeze.AllowedUserList(),
+		CreatedBy:      freeze.CreatedBy,
+	}
+	if _, end, ok := freeze.ActiveWindow(now); ok {
+		item.Active = true
+		item.ActiveUntil = util.FormatScheduleTime(&end, freeze.Timezone)
+	}
+	return item
+}
@@ -5,13 +5,57 @@ import (
 
 	"github.com/redis/go-redis/v9"
 	"github.com/yi-nology/rainbow_bridge/biz/dal/db"
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"github.com/yi-nology/rainbow_bridge/pkg/common"
+	"github.com/yi-nology/rainbow_bridge/pkg/notify"
+	appredis "github.com/yi-nology/rainbow_bridge/pkg/redis"
 	"gorm.io/gorm"
 )
 
 var (
-	ErrResourceNotFound  = errors.New("resource not found")
-	ErrAssetNotFound     = errors.New("asset not found")
-	ErrConfigAliasExists = errors.New("该环境和渠道下已存在相同别名的配置")
+	ErrResourceNotFound           = errors.New("resource not found")
+	ErrAssetNotFound              = errors.New("asset not found")
+	ErrConfigAliasExists          = errors.New("该环境和渠道下已存在相同别名的配置")
+	ErrRevisionNotFound           = errors.New("config revision not found")
+	ErrConfigVersionRangeOverlap  = errors.New("该别名下已存在客户端版本范围重叠的配置")
+	ErrReleaseNotFound            = errors.New("config release not found")
+	ErrRolloutNotFound            = errors.New("config rollout not found")
+	ErrRolloutExists              = errors.New("该配置已有进行中的灰度发布")
+	ErrRolloutNotOpen             = errors.New("灰度发布当前状态不支持该操作")
+	ErrRolloutInvalidPercentage   = errors.New("灰度比例必须在 0 到 100 之间")
+	ErrRolloutAmbiguousAlias      = errors.New("该别名存在多个版本变体，请指定 resource_key")
+	ErrConfigSchemaNotFound       = errors.New("config schema not found")
+	ErrConfigSchemaExists         = errors.New("该环境和渠道下已存在相同别名规则的 Schema")
+	ErrConfigSchemaInvalid        = errors.New("JSON Schema 无效")
+	ErrConfigSchemaPatternInvalid = errors.New("别名规则无效")
+	ErrConfigReferenceDangling    = errors.New("引用的配置别名或字段不存在")
+	ErrConfigReferenceCycle       = errors.New("配置引用存在循环")
+	ErrConfigReferenced           = errors.New("该配置仍被其他配置引用")
+	ErrConfigReferenceSecret      = errors.New("不能引用密钥类型的配置")
+	ErrConfigNotSecret            = errors.New("该配置不是密钥类型")
+	ErrSecretKeyNotConfigured     = errors.New("未配置密钥加密密钥（secret.key）")
+	ErrConfigLabelsInvalid        = errors.New("配置标签无效")
+	ErrConfigScheduleInvalid      = errors.New("配置生效时间无效")
+	ErrEnvironmentFrozen          = errors.New("环境处于变更冻结期")
+	ErrEnvironmentFreezeInvalid   = errors.New("冻结窗口无效")
+	ErrEnvironmentFreezeNotFound  = errors.New("environment freeze not found")
+	ErrChangeRequestPending       = errors.New("变更需审批，已提交变更申请")
+	ErrChangeRequestNotFound      = errors.New("change request not found")
+	ErrChangeRequestNotPending    = errors.New("变更申请已处理")
+	ErrChangeRequestSelfApproval  = errors.New("不能审批自己提交的变更申请")
+	ErrChangeRequestAnonymous     = errors.New("需要审批的环境要求请求携带用户身份")
+	ErrChangeRequestStale         = errors.New("变更申请提交后相关配置已被修改，请重新提交")
+	ErrChangeRequestInvalid       = errors.New("变更申请无效")
+	ErrConfigRevisionConflict     = errors.New("配置已被修改，请刷新后重试")
+	ErrConfigBatchInvalid         = errors.New("批量操作无效")
+	ErrConfigBatchFailed          = errors.New("批量操作校验失败，未做任何修改")
+	ErrConfigAliasRenameInvalid   = errors.New("别名重命名无效")
+	ErrConfigTranslationsInvalid  = errors.New("配置多语言翻译无效")
+	ErrAssetReferenceNotFound     = errors.New("配置未引用该资源")
+	ErrAssetRepairInvalid         = errors.New("资源引用修复无效")
+	ErrAPIKeyNotFound             = errors.New("api key not found")
+	ErrAPIKeyInvalid              = errors.New("API Key 参数无效")
+	ErrAPIKeyRevoked              = errors.New("API Key 已吊销")
 )
 
 // Logic contains business rules on top of data persistence.
@@ -22,6 +66,34 @@ type Logic struct {
 	assetDAO       *db.AssetDAO
 	environmentDAO *db.EnvironmentDAO
 	pipelineDAO    *db.PipelineDAO
+	revisionDAO    *db.ConfigRevisionDAO
+	releaseDAO     *db.ConfigReleaseDAO
+	rolloutDAO     *db.ConfigRolloutDAO
+	schemaDAO      *db.ConfigSchemaDAO
+	freezeDAO      *db.EnvironmentFreezeDAO
+	changeDAO      *db.ConfigChangeRequestDAO
+	redirectDAO    *db.ConfigAliasRedirectDAO
+	runtimeDAO     *db.RuntimeChangeDAO
+	eventDAO       *db.ChangeEventDAO
+	apiKeyDAO      *db.PipelineAPIKeyDAO
+	// apiKeys caches API key verifications; see AuthenticateAPIKey.
+	apiKeys *apiKeyCache
+	// notifier wakes up the clients watching runtime changes.
+	notifier *notify.Notifier
+	// secretKeyring encrypts secret configs; nil when no key is configured.
+	secretKeyring *common.SecretKeyring
+	// dryRunRevisions collects the revisions of a dry run instead of storing
+	// them; see dryRunConfigChanges.
+	dryRunRevisions *[]model.ConfigRevision
+	// runtimeChanges collects the runtime changes of a batch to announce once
+	// it is committed; see ApplyConfigBatch.
+	runtimeChanges *[]model.RuntimeChangeLog
+	// snapshots keeps the last known good runtime source of each pipeline
+	// served; see LoadRuntimeSnapshot.
+	snapshots *runtimeSnapshotStore
+	// schedules keeps the runtime schedule of each pipeline served; see
+	// loadRuntimeSchedule.
+	schedules *runtimeScheduleCache
 }
 
 func NewLogic(dbConn *gorm.DB, redisClient *redis.Client) *Logic {
@@ -32,5 +104,19 @@ func NewLogic(dbConn *gorm.DB, redisClient *redis.Client) *Logic {
 		assetDAO:       db.NewAssetDAO(),
 		environmentDAO: db.NewEnvironmentDAO(),
 		pipelineDAO:    db.NewPipelineDAO(),
+		revisionDAO:    db.NewConfigRevisionDAO(),
+		releaseDAO:     db.NewConfigReleaseDAO(),
+		rolloutDAO:     db.NewConfigRolloutDAO(),
+		schemaDAO:      db.NewConfigSchemaDAO(),
+		freezeDAO:      db.NewEnvironmentFreezeDAO(),
+		changeDAO:      db.NewConfigChangeRequestDAO(),
+		redirectDAO:    db.NewConfigAliasRedirectDAO(),
+		runtimeDAO:     db.NewRuntimeChangeDAO(),
+		eventDAO:       db.NewChangeEventDAO(),
+		apiKeyDAO:      db.NewPipelineAPIKeyDAO(),
+		apiKeys:        newAPIKeyCache(),
+		snapshots:      newRuntimeSnapshotStore(),
+		schedules:      newRuntimeScheduleCache(),
+		notifier:       notify.New(redisClient, appredis.RuntimeChangeChannel),
 	}
 }
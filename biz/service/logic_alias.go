@@ -0,0 +1,404 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"github.com/yi-nology/rainbow_bridge/pkg/common"
+	"github.com/yi-nology/rainbow_bridge/pkg/redis"
+	"github.com/yi-nology/rainbow_bridge/pkg/util"
+
+	"gorm.io/gorm"
+)
+
+// MaxAliasRedirect bounds how long the old alias of a rename stays served.
+const MaxAliasRedirect = 365 * 24 * time.Hour
+
+// configAliasRegexp matches the aliases a rename may produce: the ones other
+// configs can reference.
+var configAliasRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// ConfigAliasRename renames the alias of a config, see Logic.RenameConfigAlias.
+type ConfigAliasRename struct {
+	EnvironmentKey string `json:"environment_key"`
+	// PipelineKey is the pipeline whose alias is renamed; ignored with AllPipelines.
+	PipelineKey string `json:"pipeline_key,omitempty"`
+	Alias       string `json:"alias"`
+	NewAlias    string `json:"new_alias"`
+	// AllPipelines renames the alias in every pipeline of the environment that
+	// has it, environment base configs included.
+	AllPipelines bool `json:"all_pipelines,omitempty"`
+	// RedirectFor keeps serving the config under Alias, marked deprecated, for
+	// this long after the rename; zero drops the old alias at once.
+	RedirectFor time.Duration `json:"redirect_for,omitempty"`
+}
+
+// ConfigAliasRenameResult lists what a rename wrote.
+type ConfigAliasRenameResult struct {
+	// Renamed are the variants now carrying the new alias.
+	Renamed []model.Config
+	// Rewritten are the configs whose references now point to the new alias.
+	Rewritten []model.Config
+	Redirects []model.ConfigAliasRedirect
+}
+
+// --------------------- Config Alias Operations ---------------------
+
+// RenameConfigAlias moves every variant of an alias to a new alias. References
+// to the old alias are rewritten in the configs that resolve them to the
+// renamed config: those of the same pipeline and, for environment base
+// configs, those of the pipelines inheriting them. Open rollouts follow the
+// rename. Every written config gets a rename revision.
+func (l *Logic) RenameConfigAlias(ctx context.Context, input ConfigAliasRename) (*ConfigAliasRenameResult, error) {
+	input.EnvironmentKey = strings.TrimSpace(input.EnvironmentKey)
+	input.PipelineKey = strings.TrimSpace(input.PipelineKey)
+	input.Alias = strings.TrimSpace(input.Alias)
+	input.NewAlias = strings.TrimSpace(input.NewAlias)
+	if err := validateAliasRename(input); err != nil {
+		return nil, err
+	}
+	overrides, err := l.checkEnvironmentWritable(ctx, "config."+model.ConfigRevisionActionRename, input.EnvironmentKey)
+	if err != nil {
+		return nil, err
+	}
+
+	configs, err := l.configDAO.ListByEnvironment(ctx, l.db, input.EnvironmentKey)
+	if err != nil {
+		return nil, err
+	}
+	defines := make(map[string]map[string]bool)
+	for i := range configs {
+		if defines[configs[i].PipelineKey] == nil {
+			defines[configs[i].PipelineKey] = make(map[string]bool)
+		}
+		defines[configs[i].PipelineKey][configs[i].Alias] = true
+	}
+	var pipelines []string
+	if input.AllPipelines {
+		for pipelineKey, aliases := range defines {
+			if aliases[input.Alias] {
+				pipelines = append(pipelines, pipelineKey)
+			}
+		}
+		sort.Strings(pipelines)
+	} else if defines[input.PipelineKey][input.Alias] {
+		pipelines = []string{input.PipelineKey}
+	}
+	if len(pipelines) == 0 {
+		return nil, ErrResourceNotFound
+	}
+
+	renamed := make(map[string]bool, len(pipelines))
+	for _, pipelineKey := range pipelines {
+		renamed[pipelineKey] = true
+		if defines[pipelineKey][input.NewAlias] {
+			return nil, fmt.Errorf("%w: %s/%s", ErrConfigAliasExists, pipelineKey, input.NewAlias)
+		}
+	}
+	if renamed[model.BasePipelineKey] {
+		// 继承该基础配置的渠道若已有新别名，会遮蔽改名后的基础配置
+		for pipelineKey, aliases := range defines {
+			if !renamed[pipelineKey] && !aliases[input.Alias] && aliases[input.NewAlias] {
+				return nil, fmt.Errorf("%w: %s/%s", ErrConfigAliasExists, pipelineKey, input.NewAlias)
+			}
+		}
+	}
+	// follows reports whether references in a pipeline resolve to the renamed config
+	follows := func(pipelineKey string) bool {
+		return renamed[pipelineKey] || (renamed[model.BasePipelineKey] && !defines[pipelineKey][input.Alias])
+	}
+
+	result := &ConfigAliasRenameResult{}
+	now := time.Now()
+	err = l.transactWithOverrides(ctx, overrides, func(tx *gorm.DB) error {
+		for _, pipelineKey := range pipelines {
+			if err := l.renameAliasIn(ctx, tx, input, pipelineKey, now, result); err != nil {
+				return err
+			}
+		}
+
+		configTypes := make(map[string]string, len(configs))
+		for i := range configs {
+			configTypes[configs[i].PipelineKey+"/"+configs[i].ResourceKey] = configs[i].Type
+			if !follows(configs[i].PipelineKey) || !supportsConfigReferences(configs[i].Type) {
+				continue
+			}
+			if util.RenameConfigReferences(configs[i].Content, input.Alias, input.NewAlias) == configs[i].Content &&
+				renameTranslationReferences(configs[i].Translations, input.Alias, input.NewAlias) == configs[i].Translations {
+				continue
+			}
+			after, err := l.rewriteAliasReferences(ctx, tx, &configs[i], input.Alias, input.NewAlias)
+			if err != nil {
+				return err
+			}
+			result.Rewritten = append(result.Rewritten, *after)
+		}
+
+		for pipelineKey := range defines {
+			if !follows(pipelineKey) {
+				continue
+			}
+			rollouts, err := l.rolloutDAO.ListOpen(ctx, tx, input.EnvironmentKey, pipelineKey)
+			if err != nil {
+				return err
+			}
+			for i := range rollouts {
+				if !supportsConfigReferences(configTypes[pipelineKey+"/"+rollouts[i].ResourceKey]) {
+					continue
+				}
+				candidate := util.RenameConfigReferences(rollouts[i].CandidateContent, input.Alias, input.NewAlias)
+				if candidate == rollouts[i].CandidateContent {
+					continue
+				}
+				rollouts[i].CandidateContent = candidate
+				if err := l.rolloutDAO.Save(ctx, tx, &rollouts[i]); err != nil {
+					return err
+				}
+			}
+			if err := l.recordRuntimeChange(ctx, tx, input.EnvironmentKey, pipelineKey, input.Alias, input.NewAlias); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for pipelineKey := range defines {
+		if follows(pipelineKey) {
+			l.announceRuntimeChange(ctx, input.EnvironmentKey, pipelineKey)
+			l.invalidateAliasRedirectCache(ctx, input.EnvironmentKey, pipelineKey)
+		}
+	}
+	for _, cfg := range result.Renamed {
+		// 旧别名以重定向继续下发
+		l.invalidateConfigCache(ctx, cfg.EnvironmentKey, cfg.PipelineKey, cfg.ResourceKey)
+	}
+	for _, cfg := range result.Rewritten {
+		l.invalidateConfigCache(ctx, cfg.EnvironmentKey, cfg.PipelineKey, cfg.ResourceKey)
+	}
+	return result, nil
+}
+
+// renameAliasIn renames the variants of an alias in one pipeline and moves its
+// redirects along.
+func (l *Logic) renameAliasIn(ctx context.Context, tx *gorm.DB, input ConfigAliasRename, pipelineKey string, now time.Time, result *ConfigAliasRenameResult) error {
+	environmentKey := input.EnvironmentKey
+	variants, err := l.configDAO.ListByAlias(ctx, tx, environmentKey, pipelineKey, input.Alias)
+	if err != nil {
+		return err
+	}
+	if _, err := l.configDAO.RenameAlias(ctx, tx, environmentKey, pipelineKey, input.Alias, input.NewAlias); err != nil {
+		return err
+	}
+	for i := range variants {
+		after, err := l.configDAO.GetByResourceKey(ctx, tx, environmentKey, pipelineKey, variants[i].ResourceKey)
+		if err != nil {
+			return err
+		}
+		if err := l.recordConfigRevision(ctx, tx, model.ConfigRevisionActionRename, &variants[i], after); err != nil {
+			return err
+		}
+		result.Renamed = append(result.Renamed, *after)
+	}
+	if err := l.rolloutDAO.RenameAlias(ctx, tx, environmentKey, pipelineKey, input.Alias, input.NewAlias); err != nil {
+		return err
+	}
+
+	// 新别名不再是重定向；指向旧别名的重定向改为指向新别名
+	if err := l.redirectDAO.DeleteByOldAlias(ctx, tx, environmentKey, pipelineKey, input.NewAlias); err != nil {
+		return err
+	}
+	if err := l.redirectDAO.Retarget(ctx, tx, environmentKey, pipelineKey, input.Alias, input.NewAlias); err != nil {
+		return err
+	}
+	if input.RedirectFor <= 0 {
+		return l.redirectDAO.DeleteByOldAlias(ctx, tx, environmentKey, pipelineKey, input.Alias)
+	}
+	redirect := &model.ConfigAliasRedirect{
+		EnvironmentKey: environmentKey,
+		PipelineKey:    pipelineKey,
+		OldAlias:       input.Alias,
+		NewAlias:       input.NewAlias,
+		ExpiresAt:      now.Add(input.RedirectFor),
+		OperatorName:   common.GetUsername(ctx),
+	}
+	if err := l.redirectDAO.Put(ctx, tx, redirect); err != nil {
+		return err
+	}
+	result.Redirects = append(result.Redirects, *redirect)
+	return nil
+}
+
+// rewriteAliasReferences points the references of cfg to alias from at alias to.
+func (l *Logic) rewriteAliasReferences(ctx context.Context, tx *gorm.DB, cfg *model.Config, from, to string) (*model.Config, error) {
+	before, err := l.configDAO.GetByResourceKey(ctx, tx, cfg.EnvironmentKey, cfg.PipelineKey, cfg.ResourceKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := l.advanceConfigRevision(ctx, tx, cfg.EnvironmentKey, cfg.PipelineKey, cfg.ResourceKey, 0); err != nil {
+		return nil, err
+	}
+	if err := l.configDAO.UpdateContent(ctx, tx, before.ID, util.RenameConfigReferences(before.Content, from, to)); err != nil {
+		return nil, err
+	}
+	if translations := renameTranslationReferences(before.Translations, from, to); translations != before.Translations {
+		if err := l.configDAO.UpdateTranslations(ctx, tx, before.ID, translations); err != nil {
+			return nil, err
+		}
+	}
+	after, err := l.configDAO.GetByResourceKey(ctx, tx, cfg.EnvironmentKey, cfg.PipelineKey, cfg.ResourceKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := l.recordConfigRevision(ctx, tx, model.ConfigRevisionActionRename, before, after); err != nil {
+		return nil, err
+	}
+	return after, nil
+}
+
+// renameTranslationReferences points the references to alias from in stored
+// translations at alias to.
+func renameTranslationReferences(raw, from, to string) string {
+	translations, err := util.ParseTranslations(raw)
+	if err != nil || len(translations) == 0 {
+		return raw
+	}
+	for locale, value := range translations {
+		translations[locale] = util.RenameConfigReferences(value, from, to)
+	}
+	return util.FormatTranslations(translations)
+}
+
+func validateAliasRename(input ConfigAliasRename) error {
+	switch {
+	case input.EnvironmentKey == "":
+		return fmt.Errorf("%w: environment_key is required", ErrConfigAliasRenameInvalid)
+	case !input.AllPipelines && input.PipelineKey == "":
+		return fmt.Errorf("%w: pipeline_key is required", ErrConfigAliasRenameInvalid)
+	case input.Alias == "" || input.NewAlias == "":
+		return fmt.Errorf("%w: alias and new_alias are required", ErrConfigAliasRenameInvalid)
+	case input.Alias == input.NewAlias:
+		return fmt.Errorf("%w: new_alias equals alias", ErrConfigAliasRenameInvalid)
+	case !configAliasRegexp.MatchString(input.NewAlias):
+		return fmt.Errorf("%w: new_alias may only contain letters, digits, \"_\" and \"-\"", ErrConfigAliasRenameInvalid)
+	case input.RedirectFor < 0 || input.RedirectFor > MaxAliasRedirect:
+		return fmt.Errorf("%w: redirect period must be between 0 and %d days", ErrConfigAliasRenameInvalid, MaxAliasRedirect/(24*time.Hour))
+	}
+	return nil
+}
+
+// ListAliasRedirects returns the redirects of an environment still served. An
+// empty pipelineKey lists every pipeline.
+func (l *Logic) ListAliasRedirects(ctx context.Context, environmentKey, pipelineKey string) ([]model.ConfigAliasRedirect, error) {
+	return l.redirectDAO.ListActive(ctx, l.db, environmentKey, pipelineKey, time.Now())
+}
+
+// applyAliasRedirects adds the configs of renamed aliases under their old alias
+// while the redirect lasts. configs hold one variant per alias; a config that
+// still carries the old alias, e.g. in a release published before the rename,
+// takes precedence. Redirects of the pipeline win over inherited base ones.
+func (l *Logic) applyAliasRedirects(ctx context.Context, environmentKey, pipelineKey string, configs []model.Config) ([]model.Config, error) {
+	redirects, err := l.listRuntimeAliasRedirects(ctx, environmentKey, pipelineKey)
+	if err != nil {
+		return nil, err
+	}
+	return applyAliasRedirectList(redirects, configs), nil
+}
+
+// listRuntimeAliasRedirects returns the redirects of a pipeline followed by
+// those of its environment base.
+func (l *Logic) listRuntimeAliasRedirects(ctx context.Context, environmentKey, pipelineKey string) ([]model.ConfigAliasRedirect, error) {
+	redirects, err := l.listAliasRedirects(ctx, environmentKey, pipelineKey)
+	if err != nil {
+		return nil, err
+	}
+	if pipelineKey != model.BasePipelineKey {
+		baseRedirects, err := l.listAliasRedirects(ctx, environmentKey, model.BasePipelineKey)
+		if err != nil {
+			return nil, err
+		}
+		redirects = append(redirects, baseRedirects...)
+	}
+	return redirects, nil
+}
+
+// applyAliasRedirectList applies redirects listed by listRuntimeAliasRedirects,
+// see applyAliasRedirects.
+func applyAliasRedirectList(redirects []model.ConfigAliasRedirect, configs []model.Config) []model.Config {
+	if len(redirects) == 0 {
+		return configs
+	}
+
+	served := make(map[string]int, len(configs))
+	for i := range configs {
+		served[configs[i].Alias] = i
+	}
+	now := time.Now()
+	for _, redirect := range redirects {
+		if !redirect.ExpiresAt.After(now) {
+			continue
+		}
+		if _, ok := served[redirect.OldAlias]; ok {
+			continue
+		}
+		idx, ok := served[redirect.NewAlias]
+		if !ok {
+			continue
+		}
+		deprecated := configs[idx]
+		deprecated.Alias = redirect.OldAlias
+		deprecated.RenamedTo = redirect.NewAlias
+		until := redirect.ExpiresAt
+		deprecated.DeprecatedUntil = &until
+		configs = append(configs, deprecated)
+		served[redirect.OldAlias] = len(configs) - 1
+	}
+	return configs
+}
+
+func (l *Logic) listAliasRedirects(ctx context.Context, environmentKey, pipelineKey string) ([]model.ConfigAliasRedirect, error) {
+	// 生成缓存键
+	cacheKey := redis.GenerateAliasRedirectKey(environmentKey, pipelineKey)
+
+	// 尝试从缓存中获取
+	var cachedRedirects []model.ConfigAliasRedirect
+	found, err := redis.Get(ctx, l.redisClient, cacheKey, &cachedRedirects)
+	if err == nil && found {
+		return cachedRedirects, nil
+	}
+
+	now := time.Now()
+	redirects, err := l.redirectDAO.ListActive(ctx, l.db, environmentKey, pipelineKey, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// 存入缓存，设置过期时间为30分钟，且不晚于最早到期的重定向
+	var boundary time.Time
+	for i := range redirects {
+		if boundary.IsZero() || redirects[i].ExpiresAt.Before(boundary) {
+			boundary = redirects[i].ExpiresAt
+		}
+	}
+	if err := redis.Set(ctx, l.redisClient, cacheKey, redirects, redis.ExpirationUntil(30*time.Minute, now, boundary)); err != nil {
+		// 缓存错误不影响主流程，只记录错误
+		fmt.Printf("Failed to cache alias redirects: %v\n", err)
+	}
+	return redirects, nil
+}
+
+func (l *Logic) invalidateAliasRedirectCache(ctx context.Context, environmentKey, pipelineKey string) {
+	if l.redisClient == nil {
+		return
+	}
+	if err := redis.Delete(ctx, l.redisClient, redis.GenerateAliasRedirectKey(environmentKey, pipelineKey)); err != nil {
+		fmt.Printf("Failed to clear alias redirect cache: %v\n", err)
+	}
+}
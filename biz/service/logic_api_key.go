@@ -0,0 +1,342 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"github.com/yi-nology/rainbow_bridge/pkg/common"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// apiKeyCacheTTL bounds how long a verified key, or whether a pipeline
+	// requires keys, is trusted before it is checked against the database
+	// again. Changes made on another replica take effect after it.
+	apiKeyCacheTTL = 30 * time.Second
+	// maxAPIKeyRotationGrace bounds how long a rotated secret stays valid.
+	maxAPIKeyRotationGrace = 7 * 24 * time.Hour
+	// maxAPIKeyFailures is the number of failed verifications a key ID may
+	// have per apiKeyFailureWindow before further unverified tokens of it are
+	// rejected without a lookup or bcrypt comparison.
+	maxAPIKeyFailures   = 5
+	apiKeyFailureWindow = time.Minute
+)
+
+// apiKeyCache keeps the outcome of bcrypt verifications and of the key
+// requirement lookups of pipelines, so runtime reads do not pay for them on
+// every request, and counts the failed verifications of each key ID.
+type apiKeyCache struct {
+	mu       sync.Mutex
+	verified map[string]verifiedAPIKey
+	required map[string]requiredAPIKey
+	failures map[string]apiKeyFailures
+}
+
+type verifiedAPIKey struct {
+	key       model.PipelineAPIKey
+	previous  bool
+	checkedAt time.Time
+}
+
+type requiredAPIKey struct {
+	required  bool
+	checkedAt time.Time
+}
+
+type apiKeyFailures struct {
+	count int
+	since time.Time
+}
+
+func newAPIKeyCache() *apiKeyCache {
+	return &apiKeyCache{
+		verified: make(map[string]verifiedAPIKey),
+		required: make(map[string]requiredAPIKey),
+		failures: make(map[string]apiKeyFailures),
+	}
+}
+
+// reset forgets everything; called after every change to a key.
+func (c *apiKeyCache) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.verified = make(map[string]verifiedAPIKey)
+	c.required = make(map[string]requiredAPIKey)
+	c.failures = make(map[string]apiKeyFailures)
+}
+
+// limited reports whether a key ID failed verification too often recently.
+func (c *apiKeyCache) limited(keyID string, now time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	failures, ok := c.failures[keyID]
+	return ok && now.Sub(failures.since) < apiKeyFailureWindow && failures.count >= maxAPIKeyFailures
+}
+
+// fail counts a failed verification of a key ID.
+func (c *apiKeyCache) fail(keyID string, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	failures := c.failures[keyID]
+	if now.Sub(failures.since) >= apiKeyFailureWindow {
+		failures = apiKeyFailures{since: now}
+	}
+	failures.count++
+	c.failures[keyID] = failures
+}
+
+// --------------------- Pipeline API keys ---------------------
+
+// CreatePipelineAPIKey issues a new API key for a pipeline and returns the
+// token, which is not stored and cannot be shown again.
+func (l *Logic) CreatePipelineAPIKey(ctx context.Context, key *model.PipelineAPIKey) (string, error) {
+	key.Name = strings.TrimSpace(key.Name)
+	if key.Name == "" {
+		return "", fmt.Errorf("%w: name is required", ErrAPIKeyInvalid)
+	}
+	scopes, err := common.NormalizeAPIKeyScopes(key.ScopeList())
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrAPIKeyInvalid, err)
+	}
+	if len(scopes) == 0 {
+		return "", fmt.Errorf("%w: at least one scope is required", ErrAPIKeyInvalid)
+	}
+	key.Scopes = strings.Join(scopes, ",")
+	if key.ExpiresAt != nil && !key.ExpiresAt.After(time.Now()) {
+		return "", fmt.Errorf("%w: expires_at must be in the future", ErrAPIKeyInvalid)
+	}
+	if _, err := l.pipelineDAO.GetByKey(ctx, l.db, key.EnvironmentKey, key.PipelineKey); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", ErrPipelineNotFound
+		}
+		return "", err
+	}
+
+	keyID, err := common.GenerateAPIKeyID()
+	if err != nil {
+		return "", err
+	}
+	secret, hash, err := newAPIKeySecret()
+	if err != nil {
+		return "", err
+	}
+	key.ID = 0
+	key.KeyID = keyID
+	key.SecretHash = hash
+	key.RevokedAt = nil
+	key.RotatedAt = nil
+	key.PreviousSecretHash = ""
+	key.PreviousExpiresAt = nil
+	key.CreatedBy = common.GetUsername(ctx)
+	if err := l.apiKeyDAO.Create(ctx, l.db, key); err != nil {
+		return "", err
+	}
+	l.apiKeys.reset()
+	return common.FormatAPIKey(keyID, secret), nil
+}
+
+// RotatePipelineAPIKey replaces the secret of a key and returns the new token.
+// The previous secret stays valid for grace, so clients can switch over.
+func (l *Logic) RotatePipelineAPIKey(ctx context.Context, id uint, grace time.Duration) (*model.PipelineAPIKey, string, error) {
+	if grace < 0 || grace > maxAPIKeyRotationGrace {
+		return nil, "", fmt.Errorf("%w: grace period must be between 0 and %s", ErrAPIKeyInvalid, maxAPIKeyRotationGrace)
+	}
+	key, err := l.getAPIKeyForChange(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+	secret, hash, err := newAPIKeySecret()
+	if err != nil {
+		return nil, "", err
+	}
+	now := time.Now()
+	key.PreviousSecretHash = ""
+	key.PreviousExpiresAt = nil
+	if grace > 0 {
+		previousUntil := now.Add(grace)
+		key.PreviousSecretHash = key.SecretHash
+		key.PreviousExpiresAt = &previousUntil
+	}
+	key.SecretHash = hash
+	key.RotatedAt = &now
+	if err := l.apiKeyDAO.Save(ctx, l.db, key); err != nil {
+		return nil, "", err
+	}
+	l.apiKeys.reset()
+	return key, common.FormatAPIKey(key.KeyID, secret), nil
+}
+
+// SetPipelineAPIKeyExpiry changes when a key expires; nil keeps it valid until
+// revoked. A time in the past expires the key at once.
+func (l *Logic) SetPipelineAPIKeyExpiry(ctx context.Context, id uint, expiresAt *time.Time) (*model.PipelineAPIKey, error) {
+	key, err := l.getAPIKeyForChange(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	key.ExpiresAt = expiresAt
+	if err := l.apiKeyDAO.Save(ctx, l.db, key); err != nil {
+		return nil, err
+	}
+	l.apiKeys.reset()
+	return key, nil
+}
+
+// RevokePipelineAPIKey revokes a key for good, including a rotated secret
+// still in its grace period.
+func (l *Logic) RevokePipelineAPIKey(ctx context.Context, id uint) (*model.PipelineAPIKey, error) {
+	key, err := l.getAPIKeyForChange(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	key.RevokedAt = &now
+	key.PreviousSecretHash = ""
+	key.PreviousExpiresAt = nil
+	if err := l.apiKeyDAO.Save(ctx, l.db, key); err != nil {
+		return nil, err
+	}
+	l.apiKeys.reset()
+	return key, nil
+}
+
+// ListPipelineAPIKeys returns the API keys of a pipeline, revoked and expired
+// keys included.
+func (l *Logic) ListPipelineAPIKeys(ctx context.Context, environmentKey, pipelineKey string) ([]model.PipelineAPIKey, error) {
+	return l.apiKeyDAO.ListByPipeline(ctx, l.db, environmentKey, pipelineKey)
+}
+
+// revokePipelineAPIKeys revokes the keys of a deleted pipeline, so they do not
+// apply to a pipeline created later under the same key.
+func (l *Logic) revokePipelineAPIKeys(ctx context.Context, environmentKey, pipelineKey string) error {
+	if err := l.apiKeyDAO.RevokeByPipeline(ctx, l.db, environmentKey, pipelineKey, time.Now()); err != nil {
+		return err
+	}
+	l.apiKeys.reset()
+	return nil
+}
+
+// AuthenticateAPIKey returns the identity of a token. Unknown, revoked and
+// expired keys are all reported as common.ErrAPIKeyUnauthorized; the secret
+// is only compared for active keys whose ID did not fail verification
+// maxAPIKeyFailures times within apiKeyFailureWindow. While the
+// database is unavailable a token verified before keeps its last verification.
+func (l *Logic) AuthenticateAPIKey(ctx context.Context, token string) (*common.APIKeyIdentity, error) {
+	keyID, secret, err := common.ParseAPIKey(token)
+	if err != nil {
+		return nil, common.ErrAPIKeyUnauthorized
+	}
+	now := time.Now()
+	sum := sha256.Sum256([]byte(token))
+	cacheKey := hex.EncodeToString(sum[:])
+
+	l.apiKeys.mu.Lock()
+	cached, ok := l.apiKeys.verified[cacheKey]
+	l.apiKeys.mu.Unlock()
+	if !ok && l.apiKeys.limited(keyID, now) {
+		return nil, common.ErrAPIKeyUnauthorized
+	}
+	if !ok || now.Sub(cached.checkedAt) >= apiKeyCacheTTL {
+		key, err := l.apiKeyDAO.GetByKeyID(ctx, l.db, keyID)
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, common.ErrAPIKeyUnauthorized
+		case err != nil && !ok:
+			return nil, err
+		case err != nil:
+			// 数据库不可用时沿用上次的验证结果，与兜底快照一同维持运行时读取
+			fmt.Printf("Failed to check api key, using last verification: %v\n", err)
+		case !key.ActiveAt(now):
+			// 已吊销或过期的 Key 无需比对密钥
+			l.apiKeys.fail(keyID, now)
+			return nil, common.ErrAPIKeyUnauthorized
+		default:
+			cached = verifiedAPIKey{key: *key, checkedAt: now}
+			switch {
+			case common.CheckPasswordHash(secret, key.SecretHash):
+			case key.PreviousSecretActiveAt(now) && common.CheckPasswordHash(secret, key.PreviousSecretHash):
+				cached.previous = true
+			default:
+				l.apiKeys.fail(keyID, now)
+				return nil, common.ErrAPIKeyUnauthorized
+			}
+			l.apiKeys.mu.Lock()
+			l.apiKeys.verified[cacheKey] = cached
+			l.apiKeys.mu.Unlock()
+		}
+	}
+
+	if !cached.key.ActiveAt(now) || cached.previous && !cached.key.PreviousSecretActiveAt(now) {
+		return nil, common.ErrAPIKeyUnauthorized
+	}
+	return &common.APIKeyIdentity{
+		ID:             cached.key.ID,
+		KeyID:          cached.key.KeyID,
+		EnvironmentKey: cached.key.EnvironmentKey,
+		PipelineKey:    cached.key.PipelineKey,
+		Scopes:         cached.key.ScopeList(),
+	}, nil
+}
+
+// PipelineRequiresAPIKey reports whether the pipeline has active API keys;
+// runtime clients of such a pipeline must present one. While the database is
+// unavailable the last known answer is used.
+func (l *Logic) PipelineRequiresAPIKey(ctx context.Context, environmentKey, pipelineKey string) (bool, error) {
+	now := time.Now()
+	scope := environmentKey + "/" + pipelineKey
+	l.apiKeys.mu.Lock()
+	cached, ok := l.apiKeys.required[scope]
+	l.apiKeys.mu.Unlock()
+	if ok && now.Sub(cached.checkedAt) < apiKeyCacheTTL {
+		return cached.required, nil
+	}
+	count, err := l.apiKeyDAO.CountActive(ctx, l.db, environmentKey, pipelineKey, now)
+	if err != nil {
+		// 数据库不可用时沿用上次的结果，进程重启后取自兜底快照
+		if ok {
+			return cached.required, nil
+		}
+		if snapshot := l.lastRuntimeSnapshot(environmentKey, pipelineKey); snapshot != nil {
+			return snapshot.RequiresAPIKey, nil
+		}
+		return false, err
+	}
+	l.apiKeys.mu.Lock()
+	l.apiKeys.required[scope] = requiredAPIKey{required: count > 0, checkedAt: now}
+	l.apiKeys.mu.Unlock()
+	return count > 0, nil
+}
+
+func (l *Logic) getAPIKeyForChange(ctx context.Context, id uint) (*model.PipelineAPIKey, error) {
+	key, err := l.apiKeyDAO.GetByID(ctx, l.db, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAPIKeyNotFound
+		}
+		return nil, err
+	}
+	if key.RevokedAt != nil {
+		return nil, ErrAPIKeyRevoked
+	}
+	return key, nil
+}
+
+// newAPIKeySecret returns a new key secret and its bcrypt hash.
+func newAPIKeySecret() (string, string, error) {
+	secret, err := common.GenerateAPIKeySecret()
+	if err != nil {
+		return "", "", err
+	}
+	hash, err := common.HashPassword(secret)
+	if err != nil {
+		return "", "", err
+	}
+	return secret, hash, nil
+}
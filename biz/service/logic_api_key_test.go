@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/db"
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	envpb "github.com/yi-nology/rainbow_bridge/biz/model/environment"
+	pkgcommon "github.com/yi-nology/rainbow_bridge/pkg/common"
+	"github.com/yi-nology/rainbow_bridge/pkg/config"
+)
+
+// TestAuthenticateAPIKeyFailures checks that a key ID with too many failed
+// verifications rejects unverified tokens, and that revoked keys are rejected.
+func TestAuthenticateAPIKeyFailures(t *testing.T) {
+	gdb := db.SetupTestDB(t)
+	defer db.CleanupTestDB(t, gdb)
+	s := NewService(gdb, nil, "", &config.Config{})
+
+	ctx := pkgcommon.ContextWithUserID(context.Background(), 1)
+	if err := s.AddEnvironment(ctx, &envpb.Environment{EnvironmentKey: "prod", EnvironmentName: "Prod", IsActive: true}); err != nil {
+		t.Fatalf("AddEnvironment failed: %v", err)
+	}
+	key := &model.PipelineAPIKey{EnvironmentKey: "prod", PipelineKey: "default", Name: "Reader", Scopes: pkgcommon.APIKeyScopeRuntimeRead}
+	token, err := s.logic.CreatePipelineAPIKey(ctx, key)
+	if err != nil {
+		t.Fatalf("CreatePipelineAPIKey failed: %v", err)
+	}
+	authenticate := func(token string) error {
+		_, err := s.logic.AuthenticateAPIKey(ctx, token)
+		return err
+	}
+	wrong := pkgcommon.FormatAPIKey(key.KeyID, "wrong")
+
+	for i := 0; i < maxAPIKeyFailures; i++ {
+		if err := authenticate(wrong); !errors.Is(err, pkgcommon.ErrAPIKeyUnauthorized) {
+			t.Fatalf("wrong secret: err = %v, want unauthorized", err)
+		}
+	}
+	if err := authenticate(token); !errors.Is(err, pkgcommon.ErrAPIKeyUnauthorized) {
+		t.Fatalf("unverified token of a limited key: err = %v, want unauthorized", err)
+	}
+
+	// 已验证过的 Token 不受失败次数限制
+	s.logic.apiKeys.reset()
+	if err := authenticate(token); err != nil {
+		t.Fatalf("AuthenticateAPIKey failed: %v", err)
+	}
+	for i := 0; i < maxAPIKeyFailures; i++ {
+		_ = authenticate(wrong)
+	}
+	if err := authenticate(token); err != nil {
+		t.Fatalf("verified token of a limited key: err = %v, want it accepted", err)
+	}
+
+	if _, err := s.logic.RevokePipelineAPIKey(ctx, key.ID); err != nil {
+		t.Fatalf("RevokePipelineAPIKey failed: %v", err)
+	}
+	if err := authenticate(token); !errors.Is(err, pkgcommon.ErrAPIKeyUnauthorized) {
+		t.Fatalf("revoked key: err = %v, want unauthorized", err)
+	}
+}
@@ -3,29 +3,108 @@ package service
 import (
 	"context"
 	"errors"
+	"net/url"
+	"regexp"
 
 	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"github.com/yi-nology/rainbow_bridge/pkg/util"
 	"gorm.io/gorm"
 )
 
+// Asset reference problems reported by CheckAssetReferences.
+const (
+	AssetProblemMissingAsset = "missing_asset"
+	AssetProblemMissingFile  = "missing_file"
+	AssetProblemForeignAsset = "foreign_asset"
+)
+
+// Asset reference repair actions.
+const (
+	AssetRepairRelink = "relink"
+	AssetRepairCopy   = "copy"
+)
+
+// assetReferenceRegexp matches both asset reference forms, with the file name
+// segment of file URLs.
+var assetReferenceRegexp = regexp.MustCompile(`(asset://|/api/v1/asset/file/)([a-zA-Z0-9\-]+)(/[^/\s"'?#<>()\\]+)?`)
+
+// AssetReferenceIssue is an asset reference of a config that cannot be served.
+type AssetReferenceIssue struct {
+	Config  model.Config
+	FileID  string
+	Problem string
+	// Asset is the referenced asset, nil when it is missing.
+	Asset *model.Asset
+	// FileName is the name of the asset, or the name in the file URL when the
+	// asset is missing.
+	FileName string
+	// Candidates are the assets of the config's pipeline with the same file
+	// name whose files are present.
+	Candidates []model.Asset
+}
+
+// AssetReferenceReport is the result of an asset reference check.
+type AssetReferenceReport struct {
+	Configs    int
+	References int
+	Issues     []AssetReferenceIssue
+}
+
+// assetFileChecker reports whether the file of an asset is present in storage.
+type assetFileChecker func(ctx context.Context, asset *model.Asset) (bool, error)
+
 // --------------------- Asset Operations ---------------------
 
 func (l *Logic) CreateAsset(ctx context.Context, asset *model.Asset) error {
-	return l.assetDAO.Create(ctx, l.db, asset)
+	overrides, err := l.checkEnvironmentWritable(ctx, "asset.create", asset.EnvironmentKey)
+	if err != nil {
+		return err
+	}
+	err = l.transactWithOverrides(ctx, overrides, func(tx *gorm.DB) error {
+		return l.assetDAO.Create(ctx, tx, asset)
+	})
+	if err != nil {
+		return err
+	}
+	l.recordAssetEvent(ctx, model.ChangeEventCreated, asset)
+	return nil
 }
 
 func (l *Logic) UpdateAsset(ctx context.Context, asset *model.Asset) error {
-	if err := l.assetDAO.Update(ctx, l.db, asset); err != nil {
+	overrides, err := l.checkEnvironmentWritable(ctx, "asset.update", asset.EnvironmentKey)
+	if err != nil {
+		return err
+	}
+	err = l.transactWithOverrides(ctx, overrides, func(tx *gorm.DB) error {
+		return l.assetDAO.Update(ctx, tx, asset)
+	})
+	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return ErrAssetNotFound
 		}
 		return err
 	}
+	l.recordAssetEvent(ctx, model.ChangeEventUpdated, asset)
 	return nil
 }
 
 func (l *Logic) DeleteAsset(ctx context.Context, fileID string) error {
-	return l.assetDAO.DeleteByFileID(ctx, l.db, fileID)
+	asset, err := l.GetAsset(ctx, fileID)
+	if err != nil {
+		return err
+	}
+	overrides, err := l.checkEnvironmentWritable(ctx, "asset.delete", asset.EnvironmentKey)
+	if err != nil {
+		return err
+	}
+	err = l.transactWithOverrides(ctx, overrides, func(tx *gorm.DB) error {
+		return l.assetDAO.DeleteByFileID(ctx, tx, fileID)
+	})
+	if err != nil {
+		return err
+	}
+	l.recordAssetEvent(ctx, model.ChangeEventDeleted, asset)
+	return nil
 }
 
 func (l *Logic) GetAsset(ctx context.Context, fileID string) (*model.Asset, error) {
@@ -39,3 +118,217 @@ func (l *Logic) GetAsset(ctx context.Context, fileID string) (*model.Asset, erro
 func (l *Logic) ListAssetsByEnvironmentAndPipeline(ctx context.Context, environmentKey, pipelineKey string) ([]model.Asset, error) {
 	return l.assetDAO.ListByEnvironmentAndPipeline(ctx, l.db, environmentKey, pipelineKey, 0, 0)
 }
+
+// --------------------- Asset References ---------------------
+
+// CheckAssetReferences scans the configs of an environment (all environments
+// when empty), optionally limited to a pipeline, for asset references in
+// content and translations that cannot be served: missing assets, assets
+// whose file is missing and assets of another environment or pipeline.
+func (l *Logic) CheckAssetReferences(ctx context.Context, environmentKey, pipelineKey string, fileExists assetFileChecker) (*AssetReferenceReport, error) {
+	var (
+		configs []model.Config
+		err     error
+	)
+	if environmentKey == "" {
+		configs, err = l.configDAO.ListAll(ctx, l.db)
+	} else {
+		configs, err = l.configDAO.ListByEnvironment(ctx, l.db, environmentKey)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if pipelineKey != "" {
+		filtered := configs[:0]
+		for _, cfg := range configs {
+			if cfg.PipelineKey == pipelineKey {
+				filtered = append(filtered, cfg)
+			}
+		}
+		configs = filtered
+	}
+	return l.checkConfigAssetReferences(ctx, configs, fileExists)
+}
+
+func (l *Logic) checkConfigAssetReferences(ctx context.Context, configs []model.Config, fileExists assetFileChecker) (*AssetReferenceReport, error) {
+	references := make([][]assetReference, len(configs))
+	var fileIDs []string
+	for i := range configs {
+		references[i] = configAssetReferences(&configs[i])
+		for _, ref := range references[i] {
+			fileIDs = append(fileIDs, ref.FileID)
+		}
+	}
+	assets, err := l.assetDAO.ListByFileIDs(ctx, l.db, fileIDs)
+	if err != nil {
+		return nil, err
+	}
+	assetByID := make(map[string]*model.Asset, len(assets))
+	for i := range assets {
+		assetByID[assets[i].FileID] = &assets[i]
+	}
+
+	present := make(map[string]bool)
+	isPresent := func(asset *model.Asset) (bool, error) {
+		if ok, checked := present[asset.FileID]; checked {
+			return ok, nil
+		}
+		ok, err := fileExists(ctx, asset)
+		if err != nil {
+			return false, err
+		}
+		present[asset.FileID] = ok
+		return ok, nil
+	}
+	scopeAssets := make(map[string][]model.Asset)
+
+	report := &AssetReferenceReport{Configs: len(configs), Issues: []AssetReferenceIssue{}}
+	for i := range configs {
+		cfg := &configs[i]
+		for _, ref := range references[i] {
+			report.References++
+			issue := AssetReferenceIssue{Config: *cfg, FileID: ref.FileID, FileName: ref.FileName}
+			asset := assetByID[ref.FileID]
+			switch {
+			case asset == nil:
+				issue.Problem = AssetProblemMissingAsset
+			default:
+				issue.Asset = asset
+				issue.FileName = asset.FileName
+				ok, err := isPresent(asset)
+				if err != nil {
+					return nil, err
+				}
+				if !ok {
+					issue.Problem = AssetProblemMissingFile
+				} else if !assetInConfigScope(asset, cfg) {
+					issue.Problem = AssetProblemForeignAsset
+				}
+			}
+			if issue.Problem == "" {
+				continue
+			}
+			if issue.FileName != "" {
+				scope := cfg.EnvironmentKey + "/" + cfg.PipelineKey
+				candidates, ok := scopeAssets[scope]
+				if !ok {
+					candidates, err = l.listConfigScopeAssets(ctx, cfg)
+					if err != nil {
+						return nil, err
+					}
+					scopeAssets[scope] = candidates
+				}
+				for j := range candidates {
+					if candidates[j].FileName != issue.FileName || candidates[j].FileID == ref.FileID {
+						continue
+					}
+					ok, err := isPresent(&candidates[j])
+					if err != nil {
+						return nil, err
+					}
+					if ok {
+						issue.Candidates = append(issue.Candidates, candidates[j])
+					}
+				}
+			}
+			report.Issues = append(report.Issues, issue)
+		}
+	}
+	return report, nil
+}
+
+// listConfigScopeAssets returns the assets a config may reference: those of
+// its pipeline and, for pipeline configs, those of the environment base.
+func (l *Logic) listConfigScopeAssets(ctx context.Context, cfg *model.Config) ([]model.Asset, error) {
+	assets, err := l.assetDAO.ListByEnvironmentAndPipeline(ctx, l.db, cfg.EnvironmentKey, cfg.PipelineKey, 0, 0)
+	if err != nil || cfg.PipelineKey == model.BasePipelineKey {
+		return assets, err
+	}
+	base, err := l.assetDAO.ListByEnvironmentAndPipeline(ctx, l.db, cfg.EnvironmentKey, model.BasePipelineKey, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	return append(assets, base...), nil
+}
+
+// assetInConfigScope reports whether a config may reference the asset: it
+// belongs to the config's pipeline or the base of the config's environment.
+func assetInConfigScope(asset *model.Asset, cfg *model.Config) bool {
+	if asset.EnvironmentKey != cfg.EnvironmentKey {
+		return false
+	}
+	return asset.PipelineKey == cfg.PipelineKey || asset.PipelineKey == model.BasePipelineKey
+}
+
+// assetReference is an asset referenced by a config.
+type assetReference struct {
+	FileID string
+	// FileName is the name segment of a file URL, if any.
+	FileName string
+}
+
+// configAssetReferences returns the assets referenced in the content and
+// translations of a config, each once.
+func configAssetReferences(cfg *model.Config) []assetReference {
+	values := []string{cfg.Content}
+	if translations, err := util.ParseTranslations(cfg.Translations); err == nil {
+		for _, value := range translations {
+			values = append(values, value)
+		}
+	}
+	var references []assetReference
+	index := make(map[string]int)
+	for _, value := range values {
+		for _, match := range assetReferenceRegexp.FindAllStringSubmatch(value, -1) {
+			name := ""
+			if match[1] != assetScheme && match[3] != "" {
+				if unescaped, err := url.PathUnescape(match[3][1:]); err == nil {
+					name = unescaped
+				}
+			}
+			if i, ok := index[match[2]]; ok {
+				if references[i].FileName == "" {
+					references[i].FileName = name
+				}
+				continue
+			}
+			index[match[2]] = len(references)
+			references = append(references, assetReference{FileID: match[2], FileName: name})
+		}
+	}
+	return references
+}
+
+// rewriteAssetReferences points the references to fileID in the content and
+// translations of a config at asset; file URLs get the name of asset. It
+// reports whether anything changed.
+func rewriteAssetReferences(cfg *model.Config, fileID string, asset *model.Asset) (bool, error) {
+	rewrite := func(value string) string {
+		return assetReferenceRegexp.ReplaceAllStringFunc(value, func(ref string) string {
+			match := assetReferenceRegexp.FindStringSubmatch(ref)
+			if match[2] != fileID {
+				return ref
+			}
+			suffix := match[3]
+			if match[1] != assetScheme && suffix != "" {
+				suffix = "/" + url.PathEscape(asset.FileName)
+			}
+			return match[1] + asset.FileID + suffix
+		})
+	}
+	content := rewrite(cfg.Content)
+	translations, err := util.ParseTranslations(cfg.Translations)
+	if err != nil {
+		return false, err
+	}
+	for locale, value := range translations {
+		translations[locale] = rewrite(value)
+	}
+	rewritten := util.FormatTranslations(translations)
+	if content == cfg.Content && rewritten == cfg.Translations {
+		return false, nil
+	}
+	cfg.Content = content
+	cfg.Translations = rewritten
+	return true, nil
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"github.com/yi-nology/rainbow_bridge/pkg/redis"
+
+	"gorm.io/gorm"
+)
+
+// maxConfigBatchOperations bounds the number of operations of a config batch.
+const maxConfigBatchOperations = 1000
+
+// Config batch actions.
+const (
+	ConfigBatchCreate = "create"
+	ConfigBatchUpdate = "update"
+	ConfigBatchDelete = "delete"
+)
+
+// ConfigBatchOperation is one write of a config batch. Deletes only use the
+// keys of Config.
+type ConfigBatchOperation struct {
+	Action           string       `json:"action"`
+	Config           model.Config `json:"config"`
+	ExpectedRevision int64        `json:"expected_revision,omitempty"`
+}
+
+// ConfigBatchResult is the outcome of one operation of a config batch. Config
+// is the stored config after a create or update.
+type ConfigBatchResult struct {
+	Config *model.Config
+	Err    error
+}
+
+// --------------------- Config Batch Operations ---------------------
+
+// ApplyConfigBatch applies operations in order within one transaction. Every
+// operation is validated against the state left by the ones before it; if any
+// fails, nothing is applied and ErrConfigBatchFailed is returned along with
+// the error of each operation. Caches are cleared once after the commit.
+// Operations are prepared in place like single writes (normalised, secrets
+// encrypted).
+func (l *Logic) ApplyConfigBatch(ctx context.Context, operations []ConfigBatchOperation) ([]ConfigBatchResult, error) {
+	if len(operations) == 0 || len(operations) > maxConfigBatchOperations {
+		return nil, fmt.Errorf("%w: expected 1 to %d operations", ErrConfigBatchInvalid, maxConfigBatchOperations)
+	}
+	ctx = withFreezeScope(ctx)
+	results := make([]ConfigBatchResult, len(operations))
+	failed := false
+	var changes []model.RuntimeChangeLog
+	err := l.db.Transaction(func(tx *gorm.DB) error {
+		batch := *l
+		batch.db = tx
+		batch.redisClient = nil // 提交后统一清理缓存
+		batch.runtimeChanges = &changes
+		for i := range operations {
+			cfg, err := batch.applyConfigOperation(ctx, &operations[i])
+			results[i] = ConfigBatchResult{Config: cfg, Err: err}
+			failed = failed || err != nil
+		}
+		if failed {
+			return ErrConfigBatchFailed
+		}
+		return nil
+	})
+	if failed {
+		return results, ErrConfigBatchFailed
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	l.invalidateBatchCache(ctx, operations)
+	l.announceRuntimeChanges(ctx, changes)
+	return results, nil
+}
+
+// configBatchError returns ErrConfigBatchFailed wrapped with the error of the
+// first failed operation, or nil when every operation succeeded.
+func configBatchError(results []ConfigBatchResult) error {
+	for i := range results {
+		if results[i].Err != nil {
+			return fmt.Errorf("%w: operation %d: %w", ErrConfigBatchFailed, i, results[i].Err)
+		}
+	}
+	return nil
+}
+
+func (l *Logic) applyConfigOperation(ctx context.Context, op *ConfigBatchOperation) (*model.Config, error) {
+	cfg := &op.Config
+	if cfg.EnvironmentKey == "" || cfg.PipelineKey == "" {
+		return nil, fmt.Errorf("%w: environment_key and pipeline_key are required", ErrConfigBatchInvalid)
+	}
+	if op.Action != ConfigBatchCreate && cfg.ResourceKey == "" {
+		return nil, fmt.Errorf("%w: resource_key is required", ErrConfigBatchInvalid)
+	}
+
+	switch op.Action {
+	case ConfigBatchCreate:
+		if err := l.addConfig(ctx, cfg, model.ConfigRevisionActionCreate); err != nil {
+			return nil, err
+		}
+		return cfg, nil
+	case ConfigBatchUpdate:
+		if err := l.updateConfig(ctx, cfg, model.ConfigRevisionActionUpdate, op.ExpectedRevision); err != nil {
+			return nil, err
+		}
+		return l.configDAO.GetByResourceKey(ctx, l.db, cfg.EnvironmentKey, cfg.PipelineKey, cfg.ResourceKey)
+	case ConfigBatchDelete:
+		return nil, l.DeleteConfig(ctx, cfg.EnvironmentKey, cfg.PipelineKey, cfg.ResourceKey, op.ExpectedRevision)
+	default:
+		return nil, fmt.Errorf("%w: unknown action %q", ErrConfigBatchInvalid, op.Action)
+	}
+}
+
+// invalidateBatchCache clears the cached configs written by a batch, and the
+// list, map and runtime caches of each pipeline once. The changes of the batch
+// are recorded separately.
+func (l *Logic) invalidateBatchCache(ctx context.Context, operations []ConfigBatchOperation) {
+	if l.redisClient == nil {
+		return
+	}
+	pipelines := make(map[[2]string]bool)
+	for i := range operations {
+		cfg := &operations[i].Config
+		configKey := redis.GenerateConfigKey(cfg.EnvironmentKey, cfg.PipelineKey, cfg.ResourceKey)
+		if err := redis.Delete(ctx, l.redisClient, configKey); err != nil {
+			fmt.Printf("Failed to clear config cache: %v\n", err)
+		}
+		pipeline := [2]string{cfg.EnvironmentKey, cfg.PipelineKey}
+		if !pipelines[pipeline] {
+			pipelines[pipeline] = true
+			l.clearConfigCache(ctx, cfg.EnvironmentKey, cfg.PipelineKey, "")
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/db"
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	envpb "github.com/yi-nology/rainbow_bridge/biz/model/environment"
+	plpb "github.com/yi-nology/rainbow_bridge/biz/model/pipeline"
+	pkgcommon "github.com/yi-nology/rainbow_bridge/pkg/common"
+	"github.com/yi-nology/rainbow_bridge/pkg/config"
+)
+
+// TestApplyConfigBatch checks that a config batch is applied in order as one
+// transaction: all of it or nothing.
+func TestApplyConfigBatch(t *testing.T) {
+	gdb := db.SetupTestDB(t)
+	defer db.CleanupTestDB(t, gdb)
+	s := NewService(gdb, nil, "", &config.Config{})
+
+	user := pkgcommon.ContextWithUserID(context.Background(), 1)
+	for _, key := range []string{"prod", "stage"} {
+		if err := s.AddEnvironment(user, &envpb.Environment{EnvironmentKey: key, EnvironmentName: key, IsActive: true}); err != nil {
+			t.Fatalf("AddEnvironment %s failed: %v", key, err)
+		}
+	}
+	if err := s.AddPipeline(user, "prod", &plpb.Pipeline{PipelineKey: "web", PipelineName: "Web", IsActive: true}); err != nil {
+		t.Fatalf("AddPipeline failed: %v", err)
+	}
+
+	text := func(environmentKey, pipelineKey, resourceKey, alias, content string) model.Config {
+		return model.Config{
+			EnvironmentKey: environmentKey, PipelineKey: pipelineKey, ResourceKey: resourceKey,
+			Name: alias, Alias: alias, Type: "text", Content: content,
+		}
+	}
+	stored := func(environmentKey, pipelineKey string) map[string]model.Config {
+		t.Helper()
+		list, err := s.logic.ListConfigs(user, environmentKey, pipelineKey, "", "", "", false, false)
+		if err != nil {
+			t.Fatalf("ListConfigs failed: %v", err)
+		}
+		configs := make(map[string]model.Config, len(list))
+		for _, cfg := range list {
+			configs[cfg.Alias] = cfg
+		}
+		return configs
+	}
+
+	t.Run("FailedOperationRollsBack", func(t *testing.T) {
+		results, err := s.logic.ApplyConfigBatch(user, []ConfigBatchOperation{
+			{Action: ConfigBatchCreate, Config: text("prod", "default", "", "first", "1")},
+			{Action: ConfigBatchCreate, Config: text("prod", "default", "", "second", "2")},
+			{Action: ConfigBatchUpdate, Config: text("prod", "default", "missing", "missing", "3")},
+		})
+		if !errors.Is(err, ErrConfigBatchFailed) || len(results) != 3 {
+			t.Fatalf("ApplyConfigBatch = %d results, %v; want ErrConfigBatchFailed", len(results), err)
+		}
+		if results[0].Err != nil || results[1].Err != nil || results[2].Err == nil {
+			t.Fatalf("results = %+v, want only the update to fail", results)
+		}
+		if configs := stored("prod", "default"); len(configs) != 0 {
+			t.Fatalf("stored %v, want nothing committed", configs)
+		}
+		var revisions int64
+		if err := gdb.Model(&model.ConfigRevision{}).Count(&revisions).Error; err != nil || revisions != 0 {
+			t.Fatalf("recorded %d revisions, %v; want none", revisions, err)
+		}
+	})
+
+	t.Run("CreateThenUpdate", func(t *testing.T) {
+		results, err := s.logic.ApplyConfigBatch(user, []ConfigBatchOperation{
+			{Action: ConfigBatchCreate, Config: text("prod", "default", "greeting-key", "greeting", "hello")},
+			{Action: ConfigBatchUpdate, Config: text("prod", "default", "greeting-key", "greeting", "hi"), ExpectedRevision: 1},
+		})
+		if err != nil {
+			t.Fatalf("ApplyConfigBatch failed: %v", err)
+		}
+		if results[1].Config == nil || results[1].Config.Revision != 2 {
+			t.Fatalf("update result = %+v, want revision 2", results[1].Config)
+		}
+		greeting := stored("prod", "default")["greeting"]
+		if greeting.Content != "hi" || greeting.Revision != 2 {
+			t.Fatalf("greeting = %q at revision %d, want hi at revision 2", greeting.Content, greeting.Revision)
+		}
+	})
+
+	t.Run("RevisionConflict", func(t *testing.T) {
+		results, err := s.logic.ApplyConfigBatch(user, []ConfigBatchOperation{
+			{Action: ConfigBatchCreate, Config: text("prod", "default", "", "farewell", "bye")},
+			{Action: ConfigBatchUpdate, Config: text("prod", "default", "greeting-key", "greeting", "hey"), ExpectedRevision: 1},
+		})
+		if !errors.Is(err, ErrConfigBatchFailed) {
+			t.Fatalf("ApplyConfigBatch: err = %v, want ErrConfigBatchFailed", err)
+		}
+		var conflict *ConfigRevisionConflictError
+		if !errors.As(results[1].Err, &conflict) || conflict.Current.Revision != 2 {
+			t.Fatalf("update error = %v, want a conflict with revision 2", results[1].Err)
+		}
+		configs := stored("prod", "default")
+		if _, ok := configs["farewell"]; ok || configs["greeting"].Content != "hi" {
+			t.Fatalf("stored %v, want the batch rolled back", configs)
+		}
+	})
+
+	t.Run("SeveralPipelines", func(t *testing.T) {
+		if _, err := s.logic.ApplyConfigBatch(user, []ConfigBatchOperation{
+			{Action: ConfigBatchCreate, Config: text("prod", "web", "", "title", "web")},
+			{Action: ConfigBatchCreate, Config: text("stage", "default", "", "title", "stage")},
+			{Action: ConfigBatchDelete, Config: text("prod", "default", "greeting-key", "greeting", "")},
+		}); err != nil {
+			t.Fatalf("ApplyConfigBatch failed: %v", err)
+		}
+		if got := stored("prod", "web")["title"].Content; got != "web" {
+			t.Fatalf("prod/web title = %q, want web", got)
+		}
+		if got := stored("stage", "default")["title"].Content; got != "stage" {
+			t.Fatalf("stage/default title = %q, want stage", got)
+		}
+		if _, ok := stored("prod", "default")["greeting"]; ok {
+			t.Fatal("expected the greeting to be deleted")
+		}
+	})
+
+	t.Run("OperationLimit", func(t *testing.T) {
+		operations := make([]ConfigBatchOperation, maxConfigBatchOperations+1)
+		for i := range operations {
+			alias := fmt.Sprintf("bulk_%d", i)
+			operations[i] = ConfigBatchOperation{Action: ConfigBatchCreate, Config: text("stage", "default", "", alias, alias)}
+		}
+		for _, ops := range [][]ConfigBatchOperation{nil, operations} {
+			if _, err := s.logic.ApplyConfigBatch(user, ops); !errors.Is(err, ErrConfigBatchInvalid) {
+				t.Fatalf("ApplyConfigBatch of %d operations: err = %v, want ErrConfigBatchInvalid", len(ops), err)
+			}
+		}
+		if _, err := s.logic.ApplyConfigBatch(user, operations[:maxConfigBatchOperations]); err != nil {
+			t.Fatalf("ApplyConfigBatch of %d operations failed: %v", maxConfigBatchOperations, err)
+		}
+		if got := len(stored("stage", "default")); got != maxConfigBatchOperations+1 {
+			t.Fatalf("stored %d configs, want %d", got, maxConfigBatchOperations+1)
+		}
+	})
+}
@@ -0,0 +1,311 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/db"
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"github.com/yi-nology/rainbow_bridge/pkg/common"
+
+	"gorm.io/gorm"
+)
+
+// maxChangeRequestComment is the number of characters of a comment that are kept.
+const maxChangeRequestComment = 2048
+
+// ChangeRequestPendingError is returned for a write that was queued as a change
+// request because an environment it targets requires approval.
+type ChangeRequestPendingError struct {
+	Request         model.ConfigChangeRequest
+	EnvironmentKeys []string
+}
+
+func (e *ChangeRequestPendingError) Error() string {
+	return fmt.Sprintf("环境 %s 需要审批：已提交变更申请 #%d，经其他用户批准后生效", strings.Join(e.EnvironmentKeys, ", "), e.Request.ID)
+}
+
+func (e *ChangeRequestPendingError) Unwrap() error {
+	return ErrChangeRequestPending
+}
+
+// --------------------- Change Request Operations ---------------------
+
+// approvalEnvironments returns the environments among environmentKeys that
+// require approval, in order and without duplicates.
+func (l *Logic) approvalEnvironments(ctx context.Context, environmentKeys ...string) ([]string, error) {
+	var required []string
+	seen := make(map[string]bool, len(environmentKeys))
+	for _, environmentKey := range environmentKeys {
+		if environmentKey == "" || seen[environmentKey] {
+			continue
+		}
+		seen[environmentKey] = true
+		env, err := l.environmentDAO.GetByKey(ctx, l.db, environmentKey)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		if env.RequireApproval {
+			required = append(required, environmentKey)
+		}
+	}
+	return required, nil
+}
+
+// dryRunConfigChanges runs fn against a copy of the logic bound to a
+// transaction that is rolled back afterwards, and returns the config changes
+// fn would have made. Freeze windows are not checked during the dry run.
+func (l *Logic) dryRunConfigChanges(fn func(dry *Logic) error) ([]model.ConfigChangeRequestItem, error) {
+	errDryRun := errors.New("dry run")
+	var revisions []model.ConfigRevision
+	err := l.db.Transaction(func(tx *gorm.DB) error {
+		dry := *l
+		dry.db = tx
+		dry.redisClient = nil
+		dry.dryRunRevisions = &revisions
+		if err := fn(&dry); err != nil {
+			return err
+		}
+		return errDryRun
+	})
+	if !errors.Is(err, errDryRun) {
+		if err == nil {
+			err = errors.New("dry run was committed")
+		}
+		return nil, err
+	}
+
+	items := make([]model.ConfigChangeRequestItem, 0, len(revisions))
+	for i := range revisions {
+		items = append(items, model.ConfigChangeRequestItem{
+			EnvironmentKey: revisions[i].EnvironmentKey,
+			PipelineKey:    revisions[i].PipelineKey,
+			ResourceKey:    revisions[i].ResourceKey,
+			Alias:          revisions[i].Alias,
+			Action:         revisions[i].Action,
+			Before:         revisions[i].Before,
+			After:          revisions[i].After,
+		})
+	}
+	return items, nil
+}
+
+// createChangeRequest stores a pending change request on behalf of the current
+// user and returns the error that reports it to the requester.
+func (l *Logic) createChangeRequest(ctx context.Context, operation, summary string, payload any, items []model.ConfigChangeRequestItem, environmentKeys []string) error {
+	userID, hasUserID := common.GetUserID(ctx)
+	username := common.GetUsername(ctx)
+	if !hasUserID && username == "" {
+		return ErrChangeRequestAnonymous
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	request := &model.ConfigChangeRequest{
+		Operation:     operation,
+		Status:        model.ConfigChangeRequestPending,
+		Summary:       truncateRunes(summary, 512),
+		Payload:       string(data),
+		RequesterID:   userID,
+		RequesterName: username,
+	}
+	if err := l.changeDAO.Create(ctx, l.db, request, items); err != nil {
+		return err
+	}
+	return &ChangeRequestPendingError{Request: *request, EnvironmentKeys: environmentKeys}
+}
+
+// ListChangeRequests returns change requests, newest first.
+func (l *Logic) ListChangeRequests(ctx context.Context, environmentKey, status string, page, pageSize int) ([]model.ConfigChangeRequest, int64, error) {
+	filter := db.ChangeRequestFilter{EnvironmentKey: environmentKey, Status: status}
+	return l.changeDAO.List(ctx, l.db, filter, page, pageSize)
+}
+
+// GetChangeRequest returns a change request by ID.
+func (l *Logic) GetChangeRequest(ctx context.Context, id uint) (*model.ConfigChangeRequest, error) {
+	request, err := l.changeDAO.GetByID(ctx, l.db, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrChangeRequestNotFound
+		}
+		return nil, err
+	}
+	return request, nil
+}
+
+// ListChangeRequestItems returns the configs written by a change request.
+func (l *Logic) ListChangeRequestItems(ctx context.Context, id uint) ([]model.ConfigChangeRequestItem, error) {
+	return l.changeDAO.ListItems(ctx, l.db, id)
+}
+
+// ListChangeRequestComments returns the comments of a change request, oldest first.
+func (l *Logic) ListChangeRequestComments(ctx context.Context, id uint) ([]model.ConfigChangeRequestComment, error) {
+	return l.changeDAO.ListComments(ctx, l.db, id)
+}
+
+// reviewableChangeRequest returns a pending change request the current user may
+// review. Only approvals are refused to the requester; rejecting one's own
+// request withdraws it.
+func (l *Logic) reviewableChangeRequest(ctx context.Context, id uint, approve bool) (*model.ConfigChangeRequest, error) {
+	request, err := l.GetChangeRequest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if request.Status != model.ConfigChangeRequestPending {
+		return nil, ErrChangeRequestNotPending
+	}
+	userID, hasUserID := common.GetUserID(ctx)
+	username := common.GetUsername(ctx)
+	if !hasUserID && username == "" {
+		return nil, ErrChangeRequestAnonymous
+	}
+	if approve && isChangeRequester(request, userID, hasUserID, username) {
+		return nil, ErrChangeRequestSelfApproval
+	}
+	return request, nil
+}
+
+// claimChangeRequest marks a pending change request as being applied, so that
+// neither a second approval nor a rejection can act on it meanwhile.
+func (l *Logic) claimChangeRequest(ctx context.Context, request *model.ConfigChangeRequest) error {
+	ok, err := l.changeDAO.Transition(ctx, l.db, request.ID, model.ConfigChangeRequestPending, model.ConfigChangeRequestApplying)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrChangeRequestNotPending
+	}
+	request.Status = model.ConfigChangeRequestApplying
+	return nil
+}
+
+// releaseChangeRequest returns a claimed change request whose apply failed to
+// pending.
+func (l *Logic) releaseChangeRequest(ctx context.Context, request *model.ConfigChangeRequest) {
+	if _, err := l.changeDAO.Transition(context.WithoutCancel(ctx), l.db, request.ID, model.ConfigChangeRequestApplying, model.ConfigChangeRequestPending); err != nil {
+		fmt.Printf("Failed to release change request %d: %v\n", request.ID, err)
+		return
+	}
+	request.Status = model.ConfigChangeRequestPending
+}
+
+// finishChangeRequest records the review decision of the current user.
+func (l *Logic) finishChangeRequest(ctx context.Context, request *model.ConfigChangeRequest, status, comment string) error {
+	now := time.Now()
+	from := request.Status
+	request.Status = status
+	request.ReviewerID, _ = common.GetUserID(ctx)
+	request.ReviewerName = common.GetUsername(ctx)
+	request.ReviewComment = truncateRunes(strings.TrimSpace(comment), 1024)
+	request.ReviewedAt = &now
+	ok, err := l.changeDAO.Review(ctx, l.db, request, from)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrChangeRequestNotPending
+	}
+	return nil
+}
+
+// RejectChangeRequest closes a pending change request without applying it.
+func (l *Logic) RejectChangeRequest(ctx context.Context, id uint, comment string) (*model.ConfigChangeRequest, error) {
+	request, err := l.reviewableChangeRequest(ctx, id, false)
+	if err != nil {
+		return nil, err
+	}
+	if err := l.finishChangeRequest(ctx, request, model.ConfigChangeRequestRejected, comment); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+// CommentChangeRequest adds a comment of the current user to a change request.
+func (l *Logic) CommentChangeRequest(ctx context.Context, id uint, content string) (*model.ConfigChangeRequestComment, error) {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return nil, fmt.Errorf("%w: content is required", ErrChangeRequestInvalid)
+	}
+	if _, err := l.GetChangeRequest(ctx, id); err != nil {
+		return nil, err
+	}
+	comment := &model.ConfigChangeRequestComment{
+		ChangeRequestID: id,
+		AuthorName:      common.GetUsername(ctx),
+		Content:         truncateRunes(content, maxChangeRequestComment),
+	}
+	comment.AuthorID, _ = common.GetUserID(ctx)
+	if err := l.changeDAO.CreateComment(ctx, l.db, comment); err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+func isChangeRequester(request *model.ConfigChangeRequest, userID int, hasUserID bool, username string) bool {
+	if hasUserID && request.RequesterID != 0 && request.RequesterID == userID {
+		return true
+	}
+	return username != "" && request.RequesterName == username
+}
+
+// sameChangeRequestItems reports whether two dry runs of a change request write
+// the same configs. Resource keys of created configs are not compared, and
+// secrets are compared decrypted as key rotation re-encrypts them.
+func (l *Logic) sameChangeRequestItems(a, b []model.ConfigChangeRequestItem) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Action != b[i].Action || a[i].EnvironmentKey != b[i].EnvironmentKey ||
+			a[i].PipelineKey != b[i].PipelineKey || a[i].Alias != b[i].Alias {
+			return false
+		}
+		if a[i].Before != "" && a[i].ResourceKey != b[i].ResourceKey {
+			return false
+		}
+		if !l.sameConfigSnapshot(a[i].Before, b[i].Before, true) || !l.sameConfigSnapshot(a[i].After, b[i].After, a[i].Before != "") {
+			return false
+		}
+	}
+	return true
+}
+
+func (l *Logic) sameConfigSnapshot(a, b string, compareResourceKey bool) bool {
+	left, err := unmarshalConfigSnapshot(a)
+	if err != nil {
+		return false
+	}
+	right, err := unmarshalConfigSnapshot(b)
+	if err != nil {
+		return false
+	}
+	if left == nil || right == nil {
+		return left == nil && right == nil
+	}
+	if !compareResourceKey {
+		left.ResourceKey, right.ResourceKey = "", ""
+	}
+	if left.Type == "secret" && right.Type == "secret" && left.Content != right.Content && l.secretKeyring != nil {
+		leftContent, leftErr := l.secretKeyring.Decrypt(left.Content)
+		rightContent, rightErr := l.secretKeyring.Decrypt(right.Content)
+		if leftErr == nil && rightErr == nil && leftContent == rightContent {
+			right.Content = left.Content
+		}
+	}
+	return sameConfigContent(left, right)
+}
+
+func truncateRunes(value string, limit int) string {
+	if runes := []rune(value); len(runes) > limit {
+		return string(runes[:limit])
+	}
+	return value
+}
@@ -21,128 +21,284 @@ var (
 	colorHexRegexp = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
 )
 
+// ListConfigsAsMap entries describing the other entries.
+const (
+	// ConfigMapOriginsKey maps each alias to its origin.
+	ConfigMapOriginsKey = "_origins"
+	// ConfigMapDeprecatedKey maps each deprecated alias of a renamed config to its current alias.
+	ConfigMapDeprecatedKey = "_deprecated"
+)
+
+// configMapCache is the cached form of ListConfigsAsMap. Secret configs are
+// kept encrypted and listed in Secrets, to be decrypted on every read.
+type configMapCache struct {
+	Configs    map[string]string `json:"configs"`
+	Origins    map[string]string `json:"origins"`
+	Deprecated map[string]string `json:"deprecated,omitempty"`
+	Secrets    []string          `json:"secrets,omitempty"`
+}
+
+// ConfigRevisionConflictError is returned for an update or delete based on a
+// revision of the config that is no longer current. Current is the stored config.
+type ConfigRevisionConflictError struct {
+	Expected int64
+	Current  *model.Config
+}
+
+func (e *ConfigRevisionConflictError) Error() string {
+	return fmt.Sprintf("配置 %s 已被修改（期望版本 %d，当前版本 %d），请刷新后重试", e.Current.Alias, e.Expected, e.Current.Revision)
+}
+
+func (e *ConfigRevisionConflictError) Unwrap() error {
+	return ErrConfigRevisionConflict
+}
+
 // --------------------- Config Operations ---------------------
 
 func (l *Logic) AddConfig(ctx context.Context, cfg *model.Config) error {
+	return l.addConfig(ctx, cfg, model.ConfigRevisionActionCreate)
+}
+
+func (l *Logic) addConfig(ctx context.Context, cfg *model.Config, action string) error {
 	if cfg == nil {
 		return nil
 	}
+	overrides, err := l.checkEnvironmentWritable(ctx, "config."+action, cfg.EnvironmentKey)
+	if err != nil {
+		return err
+	}
 	normalizeConfigPayload(cfg)
 	if err := l.validateConfigContent(ctx, cfg); err != nil {
 		return err
 	}
 
 	// Check if alias already exists in the same environment and pipeline
-	if cfg.Alias != "" {
-		existing, err := l.configDAO.GetByAlias(ctx, l.db, cfg.EnvironmentKey, cfg.PipelineKey, cfg.Alias)
-		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-			return err
-		}
-		if existing != nil {
-			return ErrConfigAliasExists
-		}
+	if err := l.checkVariantConflict(ctx, cfg.EnvironmentKey, cfg.PipelineKey, cfg.Alias, "", cfg.MinVersion, cfg.MaxVersion); err != nil {
+		return err
 	}
-
-	if err := l.configDAO.Create(ctx, l.db, cfg); err != nil {
+	if err := l.checkConfigReferences(ctx, cfg); err != nil {
 		return err
 	}
 
-	// 清除相关缓存
-	if l.redisClient != nil {
-		// 清除列表缓存
-		listPattern := fmt.Sprintf("rainbow_bridge:config:list:%s:%s:*", cfg.EnvironmentKey, cfg.PipelineKey)
-		if err := redis.DeleteByPattern(ctx, l.redisClient, listPattern); err != nil {
-			fmt.Printf("Failed to clear config list cache: %v\n", err)
-		}
-
-		// 清除映射缓存
-		mapKey := redis.GenerateConfigMapKey(cfg.EnvironmentKey, cfg.PipelineKey)
-		if err := redis.Delete(ctx, l.redisClient, mapKey); err != nil {
-			fmt.Printf("Failed to clear config map cache: %v\n", err)
+	err = l.transactWithOverrides(ctx, overrides, func(tx *gorm.DB) error {
+		if err := l.configDAO.Create(ctx, tx, cfg); err != nil {
+			return err
 		}
+		return l.recordConfigRevision(ctx, tx, action, nil, cfg)
+	})
+	if err != nil {
+		return err
 	}
 
+	l.invalidateConfigCache(ctx, cfg.EnvironmentKey, cfg.PipelineKey, "")
 	return nil
 }
 
-func (l *Logic) UpdateConfig(ctx context.Context, cfg *model.Config) error {
+// UpdateConfig updates a config. A non-zero expectedRevision makes the update
+// fail with a *ConfigRevisionConflictError unless the config is still at it.
+func (l *Logic) UpdateConfig(ctx context.Context, cfg *model.Config, expectedRevision int64) error {
+	return l.updateConfig(ctx, cfg, model.ConfigRevisionActionUpdate, expectedRevision)
+}
+
+func (l *Logic) updateConfig(ctx context.Context, cfg *model.Config, action string, expectedRevision int64) error {
 	if cfg == nil {
 		return nil
 	}
-	normalizeConfigPayload(cfg)
-	if err := l.validateConfigContent(ctx, cfg); err != nil {
+	overrides, err := l.checkEnvironmentWritable(ctx, "config."+action, cfg.EnvironmentKey)
+	if err != nil {
 		return err
 	}
-	if _, err := l.configDAO.GetByResourceKey(ctx, l.db, cfg.EnvironmentKey, cfg.PipelineKey, cfg.ResourceKey); err != nil {
+	normalizeConfigPayload(cfg)
+	before, err := l.configDAO.GetByResourceKey(ctx, l.db, cfg.EnvironmentKey, cfg.PipelineKey, cfg.ResourceKey)
+	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return ErrResourceNotFound
 		}
 		return err
 	}
-
-	if err := l.configDAO.UpdateByEnvironmentAndPipeline(ctx, l.db, cfg.EnvironmentKey, cfg.PipelineKey, cfg); err != nil {
+	if expectedRevision != 0 && before.Revision != expectedRevision {
+		return &ConfigRevisionConflictError{Expected: expectedRevision, Current: before}
+	}
+	if cfg.Type == "secret" && before.Type == "secret" && cfg.Content == SecretContentMask {
+		// 管理端回传掩码表示不修改密钥内容
+		cfg.Content = before.Content
+	}
+	if err := l.validateConfigContent(ctx, cfg); err != nil {
+		return err
+	}
+	// 回滚恢复快照中的翻译，其余修改未传翻译时保留原有翻译
+	restoreTranslations := action == model.ConfigRevisionActionRollback
+	if !restoreTranslations && cfg.Translations == "" && before.Translations != "" && !isLocalizableConfigType(cfg.Type) {
+		// 改为不支持多语言的类型前需先清除翻译
+		return fmt.Errorf("%w: 修改为 %s 类型前需先清除多语言翻译", ErrConfigTranslationsInvalid, cfg.Type)
+	}
+	if err := l.checkVariantConflict(ctx, cfg.EnvironmentKey, cfg.PipelineKey, before.Alias, before.ResourceKey, cfg.MinVersion, cfg.MaxVersion); err != nil {
+		return err
+	}
+	candidate := *cfg
+	candidate.Alias = before.Alias
+	if candidate.Translations == "" && !restoreTranslations {
+		candidate.Translations = before.Translations
+	}
+	if err := l.checkConfigReferences(ctx, &candidate); err != nil {
 		return err
 	}
 
-	// 清除相关缓存
-	if l.redisClient != nil {
-		// 清除单个配置缓存
-		configKey := redis.GenerateConfigKey(cfg.EnvironmentKey, cfg.PipelineKey, cfg.ResourceKey)
-		if err := redis.Delete(ctx, l.redisClient, configKey); err != nil {
-			fmt.Printf("Failed to clear config cache: %v\n", err)
+	err = l.transactWithOverrides(ctx, overrides, func(tx *gorm.DB) error {
+		if err := l.advanceConfigRevision(ctx, tx, cfg.EnvironmentKey, cfg.PipelineKey, cfg.ResourceKey, expectedRevision); err != nil {
+			return err
+		}
+		if err := l.configDAO.UpdateByEnvironmentAndPipeline(ctx, tx, cfg.EnvironmentKey, cfg.PipelineKey, cfg); err != nil {
+			return err
+		}
+		if restoreTranslations && cfg.Translations != before.Translations {
+			if err := l.configDAO.UpdateTranslations(ctx, tx, before.ID, cfg.Translations); err != nil {
+				return err
+			}
+		}
+		after, err := l.configDAO.GetByResourceKey(ctx, tx, cfg.EnvironmentKey, cfg.PipelineKey, cfg.ResourceKey)
+		if err != nil {
+			return err
 		}
+		return l.recordConfigRevision(ctx, tx, action, before, after)
+	})
+	if err != nil {
+		return err
+	}
 
-		// 清除列表缓存
-		listPattern := fmt.Sprintf("rainbow_bridge:config:list:%s:%s:*", cfg.EnvironmentKey, cfg.PipelineKey)
-		if err := redis.DeleteByPattern(ctx, l.redisClient, listPattern); err != nil {
-			fmt.Printf("Failed to clear config list cache: %v\n", err)
+	l.invalidateConfigCache(ctx, cfg.EnvironmentKey, cfg.PipelineKey, cfg.ResourceKey)
+	return nil
+}
+
+// DeleteConfig deletes a config. A non-zero expectedRevision makes the delete
+// fail with a *ConfigRevisionConflictError unless the config is still at it.
+func (l *Logic) DeleteConfig(ctx context.Context, environmentKey, pipelineKey, resourceKey string, expectedRevision int64) error {
+	overrides, err := l.checkEnvironmentWritable(ctx, "config."+model.ConfigRevisionActionDelete, environmentKey)
+	if err != nil {
+		return err
+	}
+	before, err := l.configDAO.GetByResourceKey(ctx, l.db, environmentKey, pipelineKey, resourceKey)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrResourceNotFound
 		}
+		return err
+	}
+	if expectedRevision != 0 && before.Revision != expectedRevision {
+		return &ConfigRevisionConflictError{Expected: expectedRevision, Current: before}
+	}
+	if err := l.checkConfigReferenced(ctx, before); err != nil {
+		return err
+	}
 
-		// 清除映射缓存
-		mapKey := redis.GenerateConfigMapKey(cfg.EnvironmentKey, cfg.PipelineKey)
-		if err := redis.Delete(ctx, l.redisClient, mapKey); err != nil {
-			fmt.Printf("Failed to clear config map cache: %v\n", err)
+	err = l.transactWithOverrides(ctx, overrides, func(tx *gorm.DB) error {
+		if expectedRevision != 0 {
+			// 锁定期望版本，防止与并发修改交错
+			if err := l.advanceConfigRevision(ctx, tx, environmentKey, pipelineKey, resourceKey, expectedRevision); err != nil {
+				return err
+			}
 		}
+		if err := l.configDAO.DeleteByEnvironmentPipelineAndResourceKey(ctx, tx, environmentKey, pipelineKey, resourceKey); err != nil {
+			return err
+		}
+		return l.recordConfigRevision(ctx, tx, model.ConfigRevisionActionDelete, before, nil)
+	})
+	if err != nil {
+		return err
 	}
 
+	l.invalidateConfigCache(ctx, environmentKey, pipelineKey, resourceKey)
 	return nil
 }
 
-func (l *Logic) DeleteConfig(ctx context.Context, environmentKey, pipelineKey, resourceKey string) error {
-	_, err := l.configDAO.GetByResourceKey(ctx, l.db, environmentKey, pipelineKey, resourceKey)
+// advanceConfigRevision moves a config to its next revision within tx. When
+// expectedRevision is non-zero and the config has moved on meanwhile, the
+// returned *ConfigRevisionConflictError carries its current state.
+func (l *Logic) advanceConfigRevision(ctx context.Context, tx *gorm.DB, environmentKey, pipelineKey, resourceKey string, expectedRevision int64) error {
+	ok, err := l.configDAO.AdvanceRevision(ctx, tx, environmentKey, pipelineKey, resourceKey, expectedRevision)
+	if err != nil || ok {
+		return err
+	}
+	current, err := l.configDAO.GetByResourceKey(ctx, tx, environmentKey, pipelineKey, resourceKey)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return ErrResourceNotFound
 		}
 		return err
 	}
+	return &ConfigRevisionConflictError{Expected: expectedRevision, Current: current}
+}
 
-	if err := l.configDAO.DeleteByEnvironmentPipelineAndResourceKey(ctx, l.db, environmentKey, pipelineKey, resourceKey); err != nil {
+// checkConfigRevision fails with a *ConfigRevisionConflictError when a config
+// is no longer at expectedRevision; zero skips the check.
+func (l *Logic) checkConfigRevision(ctx context.Context, environmentKey, pipelineKey, resourceKey string, expectedRevision int64) error {
+	if expectedRevision == 0 {
+		return nil
+	}
+	current, err := l.configDAO.GetByResourceKey(ctx, l.db, environmentKey, pipelineKey, resourceKey)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrResourceNotFound
+		}
 		return err
 	}
+	if current.Revision != expectedRevision {
+		return &ConfigRevisionConflictError{Expected: expectedRevision, Current: current}
+	}
+	return nil
+}
 
-	// 清除相关缓存
-	if l.redisClient != nil {
-		// 清除单个配置缓存
+// invalidateConfigCache clears the caches of an environment/pipeline, see
+// clearConfigCache, and announces the change to runtime clients.
+func (l *Logic) invalidateConfigCache(ctx context.Context, environmentKey, pipelineKey, resourceKey string) {
+	l.clearConfigCache(ctx, environmentKey, pipelineKey, resourceKey)
+	l.announceRuntimeChange(ctx, environmentKey, pipelineKey)
+}
+
+// clearConfigCache clears the cached single config (when resourceKey is set),
+// list and map entries of an environment/pipeline.
+func (l *Logic) clearConfigCache(ctx context.Context, environmentKey, pipelineKey, resourceKey string) {
+	if l.redisClient == nil {
+		return
+	}
+
+	// 清除单个配置缓存
+	if resourceKey != "" {
 		configKey := redis.GenerateConfigKey(environmentKey, pipelineKey, resourceKey)
 		if err := redis.Delete(ctx, l.redisClient, configKey); err != nil {
 			fmt.Printf("Failed to clear config cache: %v\n", err)
 		}
+	}
 
-		// 清除列表缓存
-		listPattern := fmt.Sprintf("rainbow_bridge:config:list:%s:%s:*", environmentKey, pipelineKey)
-		if err := redis.DeleteByPattern(ctx, l.redisClient, listPattern); err != nil {
-			fmt.Printf("Failed to clear config list cache: %v\n", err)
-		}
+	// 环境基础配置被该环境下所有渠道继承，需清除全部渠道的缓存
+	scope := pipelineKey
+	if pipelineKey == model.BasePipelineKey {
+		scope = "*"
+	}
 
-		// 清除映射缓存
-		mapKey := redis.GenerateConfigMapKey(environmentKey, pipelineKey)
-		if err := redis.Delete(ctx, l.redisClient, mapKey); err != nil {
+	// 清除列表缓存
+	listPattern := fmt.Sprintf("rainbow_bridge:config:list:%s:%s:*", environmentKey, scope)
+	if err := redis.DeleteByPattern(ctx, l.redisClient, listPattern); err != nil {
+		fmt.Printf("Failed to clear config list cache: %v\n", err)
+	}
+
+	// 清除映射缓存（含各可见范围、各语言的缓存）
+	for _, mapKey := range []string{
+		redis.GenerateConfigMapKey(environmentKey, scope, "*"),
+		redis.GenerateLocalizedConfigMapKey(environmentKey, scope, "*", "*"),
+	} {
+		if err := l.deleteCacheKey(ctx, mapKey); err != nil {
 			fmt.Printf("Failed to clear config map cache: %v\n", err)
 		}
 	}
+}
 
-	return nil
+// deleteCacheKey deletes a cache key, treating keys containing "*" as patterns.
+func (l *Logic) deleteCacheKey(ctx context.Context, key string) error {
+	if strings.Contains(key, "*") {
+		return redis.DeleteByPattern(ctx, l.redisClient, key)
+	}
+	return redis.Delete(ctx, l.redisClient, key)
 }
 
 func (l *Logic) GetConfig(ctx context.Context, environmentKey, pipelineKey, resourceKey string) (*model.Config, error) {
@@ -203,28 +359,42 @@ func (l *Logic) GetConfigByKey(ctx context.Context, resourceKey string) (*model.
 	return cfg, nil
 }
 
-func (l *Logic) ListConfigs(ctx context.Context, environmentKey, pipelineKey, minVersion, maxVersion, resourceType string, latestOnly bool) ([]model.Config, error) {
+// ListConfigs returns the configs of a pipeline for the admin console. Configs
+// overriding an environment base config are flagged; with includeInherited the
+// base configs the pipeline inherits are listed as well. Configs hidden from
+// the caller are left out.
+func (l *Logic) ListConfigs(ctx context.Context, environmentKey, pipelineKey, minVersion, maxVersion, resourceType string, latestOnly, includeInherited bool) ([]model.Config, error) {
 	// 生成缓存键
-	cacheKey := fmt.Sprintf("rainbow_bridge:config:list:%s:%s:%s:%s:%s:%t", environmentKey, pipelineKey, minVersion, maxVersion, resourceType, latestOnly)
+	cacheKey := fmt.Sprintf("rainbow_bridge:config:list:%s:%s:%s:%s:%s:%t:%t", environmentKey, pipelineKey, minVersion, maxVersion, resourceType, latestOnly, includeInherited)
 
 	// 尝试从缓存中获取
 	var cachedConfigs []model.Config
 	found, err := redis.Get(ctx, l.redisClient, cacheKey, &cachedConfigs)
 	if err == nil && found {
-		return cachedConfigs, nil
+		return configVisibilityFor(ctx).filter(cachedConfigs), nil
 	}
 
 	// 缓存未命中，从数据库获取
-	var configs []model.Config
-	if latestOnly {
-		version := common.GetClientVersion(ctx)
-		configs, err = l.configDAO.ListByEnvironmentAndPipeline(ctx, l.db, environmentKey, pipelineKey, version, 0, 0)
-	} else {
-		configs, err = l.configDAO.ListByEnvironmentAndPipelineWithFilter(ctx, l.db, environmentKey, pipelineKey, minVersion, maxVersion, resourceType, 0, 0)
+	list := func(pipelineKey string) ([]model.Config, error) {
+		if latestOnly {
+			return l.configDAO.ListByEnvironmentAndPipeline(ctx, l.db, environmentKey, pipelineKey, common.GetClientVersion(ctx), 0, 0)
+		}
+		return l.configDAO.ListByEnvironmentAndPipelineWithFilter(ctx, l.db, environmentKey, pipelineKey, minVersion, maxVersion, resourceType, 0, 0)
 	}
+	configs, err := list(pipelineKey)
 	if err != nil {
 		return nil, err
 	}
+	if pipelineKey != model.BasePipelineKey {
+		base, err := list(model.BasePipelineKey)
+		if err != nil {
+			return nil, err
+		}
+		configs = model.MergeBaseConfigs(base, configs)
+		if !includeInherited {
+			configs = configs[:countPipelineConfigs(configs)]
+		}
+	}
 
 	// 存入缓存，设置过期时间为30分钟
 	if err := redis.Set(ctx, l.redisClient, cacheKey, configs, 30*time.Minute); err != nil {
@@ -232,57 +402,160 @@ func (l *Logic) ListConfigs(ctx context.Context, environmentKey, pipelineKey, mi
 		fmt.Printf("Failed to cache config list: %v\n", err)
 	}
 
-	return configs, nil
+	return configVisibilityFor(ctx).filter(configs), nil
 }
 
+// ListConfigsAsMap returns alias -> content of the merged pipeline and
+// environment base configs. The origin of every alias is reported under
+// ConfigMapOriginsKey, and renamed configs still served under their old alias
+// under ConfigMapDeprecatedKey. Secrets are only decrypted after caching.
 func (l *Logic) ListConfigsAsMap(ctx context.Context, environmentKey, pipelineKey string) (map[string]any, error) {
-	// 生成缓存键
-	cacheKey := redis.GenerateConfigMapKey(environmentKey, pipelineKey)
+	// 生成缓存键，按调用方可见范围与客户端偏好语言分别缓存
+	visibility := configVisibilityFor(ctx)
+	cacheKey := redis.GenerateConfigMapKey(environmentKey, pipelineKey, visibility.cacheScope())
+	if locales := common.GetLocales(ctx); len(locales) > 0 {
+		cacheKey = redis.GenerateLocalizedConfigMapKey(environmentKey, pipelineKey, visibility.cacheScope(), strings.Join(locales, ","))
+	}
 
 	// 尝试从缓存中获取
-	var cachedMap map[string]any
-	found, err := redis.Get(ctx, l.redisClient, cacheKey, &cachedMap)
-	if err == nil && found {
-		return cachedMap, nil
+	var cached configMapCache
+	found, err := redis.Get(ctx, l.redisClient, cacheKey, &cached)
+	if err == nil && found && cached.Configs != nil {
+		return l.revealConfigMap(&cached), nil
 	}
 
-	// 缓存未命中，从数据库获取
-	data, err := l.configDAO.ListByEnvironmentAndPipelineWithFilter(ctx, l.db, environmentKey, pipelineKey, "", "", "", 0, 0)
+	// 缓存未命中，从数据库获取（合并环境基础配置）
+	data, err := l.listEffectiveConfigs(ctx, l.db, environmentKey, pipelineKey)
 	if err != nil {
 		return nil, err
 	}
+	now := time.Now()
+	// 缓存在下一个定时生效/失效时间点过期
+	ttl := redis.ExpirationUntil(time.Hour, now, model.NextScheduleBoundary(data, now))
+	// 先过滤不可见的配置，避免其内容经引用插值泄露
+	data = visibility.filter(model.FilterScheduledConfigs(data, now))
 
-	userID, ok := common.GetUserID(ctx)
-	if !ok || userID == 0 {
-		filtered := make([]model.Config, 0, len(data))
-		for _, res := range data {
-			if res.IsPerm {
-				continue
+	data, err = l.applyAliasRedirects(ctx, environmentKey, pipelineKey, model.SelectConfigVariants(data, common.GetClientVersion(ctx)))
+	if err != nil {
+		return nil, err
+	}
+	data, err = l.localizeConfigs(ctx, environmentKey, pipelineKey, data)
+	if err != nil {
+		return nil, err
+	}
+	data = resolveConfigReferences(data)
+
+	// 密钥保持加密写入缓存，返回前再解密
+	cached = configMapCache{
+		Configs: make(map[string]string, len(data)),
+		Origins: make(map[string]string, len(data)),
+	}
+	secrets := make(map[string]bool)
+	for _, res := range data {
+		cached.Configs[res.Alias] = res.Content
+		cached.Origins[res.Alias] = res.Origin
+		secrets[res.Alias] = res.Type == "secret"
+		if res.RenamedTo != "" {
+			if cached.Deprecated == nil {
+				cached.Deprecated = make(map[string]string)
 			}
-			filtered = append(filtered, res)
+			cached.Deprecated[res.Alias] = res.RenamedTo
+			// 重定向到期后不再返回旧别名
+			ttl = redis.ExpirationUntil(ttl, now, *res.DeprecatedUntil)
 		}
-		data = filtered
 	}
 
-	result := make(map[string]any, len(data))
-	for _, res := range data {
-		result[res.Alias] = res.Content
+	for alias, secret := range secrets {
+		if secret {
+			cached.Secrets = append(cached.Secrets, alias)
+		}
 	}
 
-	// 存入缓存，设置过期时间为1小时
-	if err := redis.Set(ctx, l.redisClient, cacheKey, result, time.Hour); err != nil {
+	// 存入缓存，最长1小时
+	if err := redis.Set(ctx, l.redisClient, cacheKey, &cached, ttl); err != nil {
 		// 缓存错误不影响主流程，只记录错误
 		fmt.Printf("Failed to cache config map: %v\n", err)
 	}
 
-	return result, nil
+	return l.revealConfigMap(&cached), nil
+}
+
+// revealConfigMap builds the ListConfigsAsMap result of a cached map, with its
+// secrets decrypted. Secrets that cannot be decrypted are left out, as in
+// revealSecrets.
+func (l *Logic) revealConfigMap(cached *configMapCache) map[string]any {
+	hidden := make(map[string]bool)
+	revealed := make(map[string]string, len(cached.Secrets))
+	for _, alias := range cached.Secrets {
+		if l.secretKeyring == nil {
+			fmt.Printf("Skipping secret config %s: secret key not configured\n", alias)
+			hidden[alias] = true
+			continue
+		}
+		plaintext, err := l.secretKeyring.Decrypt(cached.Configs[alias])
+		if err != nil {
+			fmt.Printf("Skipping secret config %s: %v\n", alias, err)
+			hidden[alias] = true
+			continue
+		}
+		revealed[alias] = plaintext
+	}
+
+	result := make(map[string]any, len(cached.Configs)+2)
+	origins := make(map[string]string, len(cached.Origins))
+	deprecated := make(map[string]string)
+	for alias, content := range cached.Configs {
+		if hidden[alias] {
+			continue
+		}
+		if plaintext, ok := revealed[alias]; ok {
+			content = plaintext
+		}
+		result[alias] = content
+		origins[alias] = cached.Origins[alias]
+		if renamedTo, ok := cached.Deprecated[alias]; ok {
+			deprecated[alias] = renamedTo
+		}
+	}
+	result[ConfigMapOriginsKey] = origins
+	if len(deprecated) > 0 {
+		result[ConfigMapDeprecatedKey] = deprecated
+	}
+	return result
+}
+
+// listEffectiveConfigs returns every variant of the configs a pipeline serves:
+// its own configs merged over the base configs of its environment.
+func (l *Logic) listEffectiveConfigs(ctx context.Context, db *gorm.DB, environmentKey, pipelineKey string) ([]model.Config, error) {
+	configs, err := l.configDAO.ListByEnvironmentAndPipelineWithFilter(ctx, db, environmentKey, pipelineKey, "", "", "", 0, 0)
+	if err != nil || pipelineKey == model.BasePipelineKey {
+		return configs, err
+	}
+	base, err := l.configDAO.ListByEnvironmentAndPipelineWithFilter(ctx, db, environmentKey, model.BasePipelineKey, "", "", "", 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	return model.MergeBaseConfigs(base, configs), nil
+}
+
+// countPipelineConfigs returns how many leading configs of a merged view
+// belong to the pipeline itself.
+func countPipelineConfigs(configs []model.Config) int {
+	for i := range configs {
+		if configs[i].Origin == model.ConfigOriginEnvironment {
+			return i
+		}
+	}
+	return len(configs)
 }
 
 func (l *Logic) ExportConfigs(ctx context.Context, environmentKey, pipelineKey string) ([]model.Config, error) {
-	data, err := l.configDAO.ListByEnvironmentAndPipeline(ctx, l.db, environmentKey, pipelineKey, common.GetClientVersion(ctx), 0, 0)
+	// 导出全部版本变体，不按客户端版本筛选
+	data, err := l.configDAO.ListByEnvironmentAndPipeline(ctx, l.db, environmentKey, pipelineKey, "", 0, 0)
 	if err != nil {
 		return nil, err
 	}
+	data = configVisibilityFor(ctx).filter(data)
 	for i := range data {
 		data[i].ResourceKey = ""
 	}
@@ -290,96 +563,182 @@ func (l *Logic) ExportConfigs(ctx context.Context, environmentKey, pipelineKey s
 }
 
 func (l *Logic) ImportConfigs(ctx context.Context, configs []model.Config, overwrite bool) error {
-	if overwrite {
-		if err := l.configDAO.ClearAll(ctx, l.db); err != nil {
-			return err
-		}
+	overrides, err := l.checkImportWritable(ctx, configs, overwrite)
+	if err != nil {
+		return err
+	}
+	// 写入前完成全部校验与加密，任一配置无效时既不清空也不写入
+	configs, err = l.prepareImportedConfigs(ctx, configs)
+	if err != nil {
+		return err
 	}
 
-	// 用于跟踪已导入的 alias，避免重复
-	importedAliases := make(map[string]bool)
 	// 用于跟踪需要清除缓存的环境和渠道
 	envPipelineMap := make(map[string]bool)
-
-	for idx := range configs {
-		cfg := configs[idx]
-		normalizeConfigPayload(&cfg)
-		if err := l.validateConfigContent(ctx, &cfg); err != nil {
-			return err
-		}
-
-		// 检查是否已经导入过相同的 alias
-		aliasKey := fmt.Sprintf("%s/%s/%s", cfg.EnvironmentKey, cfg.PipelineKey, cfg.Alias)
-		if cfg.Alias != "" && importedAliases[aliasKey] {
-			// 跳过重复的 alias
-			continue
-		}
-
-		existing, err := l.configDAO.GetByResourceKey(ctx, l.db, cfg.EnvironmentKey, cfg.PipelineKey, cfg.ResourceKey)
-		if err != nil && err != gorm.ErrRecordNotFound {
-			return err
-		}
-		if existing == nil && cfg.ResourceKey == "" && cfg.Alias != "" {
-			existing, err = l.configDAO.GetByAlias(ctx, l.db, cfg.EnvironmentKey, cfg.PipelineKey, cfg.Alias)
-			if err != nil && err != gorm.ErrRecordNotFound {
+	err = l.transactWithOverrides(ctx, overrides, func(tx *gorm.DB) error {
+		if overwrite {
+			cleared, err := l.configDAO.ListAll(ctx, tx)
+			if err != nil {
 				return err
 			}
-		}
-		if existing == nil {
-			if err := l.configDAO.Create(ctx, l.db, &cfg); err != nil {
+			if err := l.configDAO.ClearAll(ctx, tx); err != nil {
 				return err
 			}
-			// 记录已导入的 alias
-			if cfg.Alias != "" {
-				importedAliases[aliasKey] = true
+			for i := range cleared {
+				if err := l.recordConfigRevision(ctx, tx, model.ConfigRevisionActionDelete, &cleared[i], nil); err != nil {
+					return err
+				}
 			}
-		} else {
-			cfg.ResourceKey = existing.ResourceKey
-			if err := l.configDAO.UpdateByEnvironmentAndPipeline(ctx, l.db, cfg.EnvironmentKey, cfg.PipelineKey, &cfg); err != nil {
+			if err := l.recordAllRuntimeChanges(ctx, tx); err != nil {
 				return err
 			}
+		}
+
+		// 用于跟踪已导入的 alias，避免重复
+		importedAliases := make(map[string]bool)
+		for idx := range configs {
+			cfg := configs[idx]
+
+			// 检查是否已经导入过相同的 alias
+			aliasKey := fmt.Sprintf("%s/%s/%s/%s/%s", cfg.EnvironmentKey, cfg.PipelineKey, cfg.Alias, cfg.MinVersion, cfg.MaxVersion)
+			if cfg.Alias != "" && importedAliases[aliasKey] {
+				// 跳过重复的 alias
+				continue
+			}
+
+			existing, err := l.findImportedConfig(ctx, tx, &cfg)
+			if err != nil {
+				return err
+			}
+			if existing == nil {
+				if err := l.configDAO.Create(ctx, tx, &cfg); err != nil {
+					return err
+				}
+				if err := l.recordConfigRevision(ctx, tx, model.ConfigRevisionActionImport, nil, &cfg); err != nil {
+					return err
+				}
+			} else {
+				cfg.ResourceKey = existing.ResourceKey
+				if err := l.advanceConfigRevision(ctx, tx, cfg.EnvironmentKey, cfg.PipelineKey, cfg.ResourceKey, 0); err != nil {
+					return err
+				}
+				if err := l.configDAO.UpdateByEnvironmentAndPipeline(ctx, tx, cfg.EnvironmentKey, cfg.PipelineKey, &cfg); err != nil {
+					return err
+				}
+				after, err := l.configDAO.GetByResourceKey(ctx, tx, cfg.EnvironmentKey, cfg.PipelineKey, cfg.ResourceKey)
+				if err != nil {
+					return err
+				}
+				if err := l.recordConfigRevision(ctx, tx, model.ConfigRevisionActionImport, existing, after); err != nil {
+					return err
+				}
+			}
 			// 记录已导入的 alias
 			if cfg.Alias != "" {
 				importedAliases[aliasKey] = true
 			}
-		}
 
-		// 记录需要清除缓存的环境和渠道
-		envPipelineKey := fmt.Sprintf("%s:%s", cfg.EnvironmentKey, cfg.PipelineKey)
-		envPipelineMap[envPipelineKey] = true
+			// 记录需要清除缓存的环境和渠道
+			envPipelineKey := fmt.Sprintf("%s:%s", cfg.EnvironmentKey, cfg.PipelineKey)
+			envPipelineMap[envPipelineKey] = true
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	// 清除相关缓存
-	if l.redisClient != nil {
-		for envPipelineKey := range envPipelineMap {
-			parts := strings.Split(envPipelineKey, ":")
-			if len(parts) == 2 {
-				environmentKey := parts[0]
-				pipelineKey := parts[1]
-
-				// 清除列表缓存
-				listPattern := fmt.Sprintf("rainbow_bridge:config:list:%s:%s:*", environmentKey, pipelineKey)
-				if err := redis.DeleteByPattern(ctx, l.redisClient, listPattern); err != nil {
-					fmt.Printf("Failed to clear config list cache: %v\n", err)
-				}
+	for envPipelineKey := range envPipelineMap {
+		parts := strings.Split(envPipelineKey, ":")
+		if len(parts) == 2 {
+			l.invalidateConfigCache(ctx, parts[0], parts[1], "")
+		}
+	}
 
-				// 清除映射缓存
-				mapKey := redis.GenerateConfigMapKey(environmentKey, pipelineKey)
-				if err := redis.Delete(ctx, l.redisClient, mapKey); err != nil {
-					fmt.Printf("Failed to clear config map cache: %v\n", err)
-				}
-			}
+	// 如果是覆盖模式，清除所有缓存
+	if overwrite {
+		defer l.announceAllRuntimeChanges(ctx)
+	}
+	if overwrite && l.redisClient != nil {
+		if err := redis.DeleteByPattern(ctx, l.redisClient, "rainbow_bridge:config:*"); err != nil {
+			fmt.Printf("Failed to clear all config caches: %v\n", err)
 		}
+	}
 
-		// 如果是覆盖模式，清除所有缓存
-		if overwrite {
-			if err := redis.DeleteByPattern(ctx, l.redisClient, "rainbow_bridge:config:*"); err != nil {
-				fmt.Printf("Failed to clear all config caches: %v\n", err)
+	return nil
+}
+
+// prepareImportedConfigs normalizes, validates and encrypts the configs of an
+// import. Exported archives mask secret contents: a masked secret keeps the
+// ciphertext of the config it replaces, and is skipped when there is none.
+func (l *Logic) prepareImportedConfigs(ctx context.Context, configs []model.Config) ([]model.Config, error) {
+	prepared := make([]model.Config, 0, len(configs))
+	for i := range configs {
+		cfg := configs[i]
+		normalizeConfigPayload(&cfg)
+		if cfg.Type == "secret" && cfg.Content == SecretContentMask {
+			existing, err := l.findImportedConfig(ctx, l.db, &cfg)
+			if err != nil {
+				return nil, err
+			}
+			if existing == nil || existing.Type != "secret" {
+				fmt.Printf("Warning: skip masked secret %s/%s/%s without stored content\n", cfg.EnvironmentKey, cfg.PipelineKey, cfg.Alias)
+				continue
 			}
+			cfg.Content = existing.Content
 		}
+		if err := l.validateConfigContent(ctx, &cfg); err != nil {
+			return nil, err
+		}
+		prepared = append(prepared, cfg)
 	}
+	return prepared, nil
+}
 
-	return nil
+// findImportedConfig returns the stored config an imported config replaces,
+// matched by resource key or else by alias and version range.
+func (l *Logic) findImportedConfig(ctx context.Context, db *gorm.DB, cfg *model.Config) (*model.Config, error) {
+	existing, err := l.configDAO.GetByResourceKey(ctx, db, cfg.EnvironmentKey, cfg.PipelineKey, cfg.ResourceKey)
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+	if existing == nil && cfg.ResourceKey == "" && cfg.Alias != "" {
+		existing, err = l.configDAO.GetVariant(ctx, db, cfg.EnvironmentKey, cfg.PipelineKey, cfg.Alias, cfg.MinVersion, cfg.MaxVersion)
+		if err != nil && err != gorm.ErrRecordNotFound {
+			return nil, err
+		}
+	}
+	return existing, nil
+}
+
+// checkImportWritable checks the freeze windows of every environment an import
+// writes to.
+func (l *Logic) checkImportWritable(ctx context.Context, configs []model.Config, overwrite bool) ([]model.EnvironmentFreezeOverride, error) {
+	environmentKeys, err := l.importEnvironmentKeys(ctx, configs, overwrite)
+	if err != nil {
+		return nil, err
+	}
+	return l.checkEnvironmentWritable(ctx, "config."+model.ConfigRevisionActionImport, environmentKeys...)
+}
+
+// importEnvironmentKeys returns the environments an import writes to; an
+// overwrite clears the configs of all environments.
+func (l *Logic) importEnvironmentKeys(ctx context.Context, configs []model.Config, overwrite bool) ([]string, error) {
+	environmentKeys := make([]string, 0, len(configs))
+	for i := range configs {
+		environmentKeys = append(environmentKeys, configs[i].EnvironmentKey)
+	}
+	if overwrite {
+		envs, err := l.environmentDAO.List(ctx, l.db, nil, 0, 0)
+		if err != nil {
+			return nil, err
+		}
+		for i := range envs {
+			environmentKeys = append(environmentKeys, envs[i].EnvironmentKey)
+		}
+	}
+	return environmentKeys, nil
 }
 
 func (l *Logic) ListBusinessKeys(ctx context.Context) ([]string, error) {
@@ -401,6 +760,10 @@ func normalizeConfigPayload(cfg *model.Config) {
 	cfg.Content = strings.TrimSpace(cfg.Content)
 	cfg.Remark = strings.TrimSpace(cfg.Remark)
 	cfg.Description = strings.TrimSpace(cfg.Description)
+	cfg.MinVersion = strings.TrimSpace(cfg.MinVersion)
+	cfg.MaxVersion = strings.TrimSpace(cfg.MaxVersion)
+	cfg.Options = strings.TrimSpace(cfg.Options)
+	cfg.ScheduleTimezone = strings.TrimSpace(cfg.ScheduleTimezone)
 }
 
 func normalizeConfigType(t string) string {
@@ -411,9 +774,27 @@ func (l *Logic) validateConfigContent(ctx context.Context, cfg *model.Config) er
 	if cfg == nil {
 		return errors.New("config payload required")
 	}
+	if err := validateVersionRange(cfg.MinVersion, cfg.MaxVersion); err != nil {
+		return err
+	}
+	labels, err := util.ParseLabels(cfg.Labels)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrConfigLabelsInvalid, err)
+	}
+	cfg.Labels = util.FormatLabels(labels)
+	if err := normalizeConfigTranslations(cfg); err != nil {
+		return err
+	}
+	if err := validateConfigSchedule(cfg); err != nil {
+		return err
+	}
 
 	// 前端传什么类型就存什么类型，不做自动类型转换
 
+	if cfg.Options != "" && !isNumericConfigType(cfg.Type) {
+		return errors.New("仅数值类型配置支持 options")
+	}
+
 	switch cfg.Type {
 	case "image":
 		if cfg.Content == "" {
@@ -485,6 +866,30 @@ func (l *Logic) validateConfigContent(ctx context.Context, cfg *model.Config) er
 		}
 		cfg.Content = colorValue
 		return nil
+	case "number", "decimal":
+		if cfg.Content == "" {
+			return errors.New("数值内容不能为空")
+		}
+		opts, err := util.ParseNumberOptions(cfg.Options)
+		if err != nil {
+			return fmt.Errorf("数值配置 options 无效: %w", err)
+		}
+		// decimal 按声明的精度补齐小数位，number 保留最短形式
+		canonical, err := util.CanonicalNumber(cfg.Content, opts, cfg.Type == "decimal")
+		if err != nil {
+			return fmt.Errorf("数值内容无效: %w", err)
+		}
+		cfg.Content = canonical
+		return nil
+	case "secret":
+		return l.encryptSecretContent(cfg)
+	case "boolean":
+		value, err := util.CanonicalBoolean(cfg.Content)
+		if err != nil {
+			return fmt.Errorf("布尔内容需为 true 或 false: %w", err)
+		}
+		cfg.Content = value
+		return nil
 	default:
 		if cfg.Content == "" {
 			return errors.New("配置内容不能为空")
@@ -501,8 +906,73 @@ func (l *Logic) validateConfigContent(ctx context.Context, cfg *model.Config) er
 			return err
 		}
 		cfg.Content = string(canonical)
+		return l.checkConfigSchema(ctx, cfg)
+	}
+}
+
+// isJSONObjectConfigType reports whether content of the type is stored as a JSON object.
+func isJSONObjectConfigType(t string) bool {
+	switch t {
+	case "image", "file", "text", "textarea", "richtext", "color", "number", "decimal", "boolean", "secret":
+		return false
+	default:
+		return true
+	}
+}
+
+// isNumericConfigType reports whether the type accepts number options.
+func isNumericConfigType(t string) bool {
+	return t == "number" || t == "decimal"
+}
+
+// validateVersionRange checks that both bounds are valid semantic versions and min < max.
+func validateVersionRange(minVersion, maxVersion string) error {
+	if minVersion != "" {
+		if _, err := util.ParseVersion(minVersion); err != nil {
+			return fmt.Errorf("最低客户端版本格式无效: %s", minVersion)
+		}
+	}
+	if maxVersion != "" {
+		if _, err := util.ParseVersion(maxVersion); err != nil {
+			return fmt.Errorf("最高客户端版本格式无效: %s", maxVersion)
+		}
+	}
+	if minVersion != "" && maxVersion != "" {
+		if cmp, _ := util.CompareVersions(minVersion, maxVersion); cmp >= 0 {
+			return errors.New("最低客户端版本必须小于最高客户端版本")
+		}
+	}
+	return nil
+}
+
+// checkVariantConflict makes sure a variant of alias with the given version range can
+// coexist with the other variants: there is at most one default (unranged) variant,
+// and ranged variants must not overlap. excludeResourceKey skips the config being updated.
+func (l *Logic) checkVariantConflict(ctx context.Context, environmentKey, pipelineKey, alias, excludeResourceKey, minVersion, maxVersion string) error {
+	if alias == "" {
 		return nil
 	}
+	variants, err := l.configDAO.ListByAlias(ctx, l.db, environmentKey, pipelineKey, alias)
+	if err != nil {
+		return err
+	}
+	isDefault := minVersion == "" && maxVersion == ""
+	for i := range variants {
+		other := &variants[i]
+		if other.ResourceKey == excludeResourceKey {
+			continue
+		}
+		if other.IsDefaultVariant() || isDefault {
+			if other.IsDefaultVariant() && isDefault {
+				return ErrConfigAliasExists
+			}
+			continue
+		}
+		if util.VersionRangesOverlap(minVersion, maxVersion, other.MinVersion, other.MaxVersion) {
+			return ErrConfigVersionRangeOverlap
+		}
+	}
+	return nil
 }
 
 func normalizeColorContent(value string) (string, error) {
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/db"
+	"github.com/yi-nology/rainbow_bridge/biz/model/common"
+	envpb "github.com/yi-nology/rainbow_bridge/biz/model/environment"
+	pkgcommon "github.com/yi-nology/rainbow_bridge/pkg/common"
+	"github.com/yi-nology/rainbow_bridge/pkg/config"
+)
+
+// TestListConfigsAsMapSecrets checks that the config map is cached with its
+// secrets encrypted and served with them decrypted.
+func TestListConfigsAsMapSecrets(t *testing.T) {
+	gdb := db.SetupTestDB(t)
+	defer db.CleanupTestDB(t, gdb)
+	s := NewService(gdb, nil, "", &config.Config{})
+	keyring, err := pkgcommon.NewSecretKeyring(bytes.Repeat([]byte{3}, 32))
+	if err != nil {
+		t.Fatalf("NewSecretKeyring failed: %v", err)
+	}
+	s.SetSecretKeyring(keyring)
+
+	user := pkgcommon.ContextWithUserID(context.Background(), 1)
+	if err := s.AddEnvironment(user, &envpb.Environment{EnvironmentKey: "prod", EnvironmentName: "Prod", IsActive: true}); err != nil {
+		t.Fatalf("AddEnvironment failed: %v", err)
+	}
+	for _, cfg := range []*common.ResourceConfig{
+		{Name: "Password", Alias: "password", Type: "secret", Content: "s3cret"},
+		{Name: "Greeting", Alias: "greeting", Type: "text", Content: "hello"},
+	} {
+		cfg.EnvironmentKey, cfg.PipelineKey = "prod", "default"
+		if _, err := s.AddConfig(user, cfg); err != nil {
+			t.Fatalf("AddConfig %s failed: %v", cfg.Alias, err)
+		}
+	}
+
+	result, err := s.logic.ListConfigsAsMap(context.Background(), "prod", "default")
+	if err != nil {
+		t.Fatalf("ListConfigsAsMap failed: %v", err)
+	}
+	if result["password"] != "s3cret" || result["greeting"] != "hello" {
+		t.Fatalf("ListConfigsAsMap = %v, want the secret decrypted", result)
+	}
+
+	stored, err := s.logic.configDAO.ListByType(context.Background(), gdb, "secret")
+	if err != nil || len(stored) != 1 {
+		t.Fatalf("ListByType = %v, %v; want the secret", stored, err)
+	}
+	data, err := json.Marshal(&configMapCache{
+		Configs: map[string]string{"password": stored[0].Content, "greeting": "hello"},
+		Origins: map[string]string{"password": "pipeline", "greeting": "pipeline"},
+		Secrets: []string{"password"},
+	})
+	if err != nil {
+		t.Fatalf("encode cache: %v", err)
+	}
+	if strings.Contains(string(data), "s3cret") {
+		t.Fatalf("cached config map holds the plaintext secret: %s", data)
+	}
+	var cached configMapCache
+	if err := json.Unmarshal(data, &cached); err != nil {
+		t.Fatalf("decode cache: %v", err)
+	}
+	if revealed := s.logic.revealConfigMap(&cached); revealed["password"] != "s3cret" {
+		t.Fatalf("revealConfigMap = %v, want the secret decrypted", revealed)
+	}
+
+	// 密钥不可用时不返回密文
+	s.logic.secretKeyring = nil
+	revealed := s.logic.revealConfigMap(&cached)
+	if _, ok := revealed["password"]; ok || revealed["greeting"] != "hello" {
+		t.Fatalf("revealConfigMap without a key = %v, want the secret left out", revealed)
+	}
+	if _, ok := revealed[ConfigMapOriginsKey].(map[string]string)["password"]; ok {
+		t.Fatal("expected the origin of a left out secret to be left out")
+	}
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"github.com/yi-nology/rainbow_bridge/pkg/common"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// changeEventLogSize is the number of change events kept for resuming streams.
+	changeEventLogSize = 10000
+	// changeStreamBatch bounds the events read at once while streaming.
+	changeStreamBatch = 100
+	// changeStreamKeepAlive is the interval of keep-alives on idle streams.
+	changeStreamKeepAlive = 15 * time.Second
+)
+
+// Change stream message types besides the "<kind>.<action>" of change events.
+const (
+	ChangeStreamReady = "ready"
+	ChangeStreamReset = "reset"
+)
+
+// ChangeStreamMessage is a message of a change stream. Type is
+// "<kind>.<action>" for change events, ChangeStreamReady when a stream starts
+// without replay and ChangeStreamReset when missed events can no longer be
+// replayed; it is empty for keep-alives. ID is the event ID to resume after.
+type ChangeStreamMessage struct {
+	Type  string
+	ID    int64
+	Event *model.ChangeEvent
+}
+
+// --------------------- Change Event Operations ---------------------
+
+// recordConfigEvent stores the change event of a config change within tx;
+// before is nil for created configs, after for deleted ones.
+func (l *Logic) recordConfigEvent(ctx context.Context, tx *gorm.DB, before, after *model.Config) error {
+	action := model.ChangeEventUpdated
+	ref := after
+	switch {
+	case before == nil:
+		action = model.ChangeEventCreated
+	case after == nil:
+		action = model.ChangeEventDeleted
+		ref = before
+	}
+	return l.eventDAO.Create(ctx, tx, &model.ChangeEvent{
+		EnvironmentKey: ref.EnvironmentKey,
+		PipelineKey:    ref.PipelineKey,
+		Kind:           model.ChangeEventKindConfig,
+		Action:         action,
+		ResourceKey:    ref.ResourceKey,
+		Alias:          ref.Alias,
+		Name:           ref.Name,
+		IsPerm:         ref.IsPerm || (before != nil && before.IsPerm),
+		OperatorName:   common.GetUsername(ctx),
+	})
+}
+
+// recordAssetEvent stores and announces the change event of an asset.
+// Failures are only logged, like cache errors.
+func (l *Logic) recordAssetEvent(ctx context.Context, action string, asset *model.Asset) {
+	event := &model.ChangeEvent{
+		EnvironmentKey: asset.EnvironmentKey,
+		PipelineKey:    asset.PipelineKey,
+		Kind:           model.ChangeEventKindAsset,
+		Action:         action,
+		FileID:         asset.FileID,
+		Name:           asset.FileName,
+		OperatorName:   common.GetUsername(ctx),
+	}
+	if err := l.eventDAO.Create(ctx, l.db, event); err != nil {
+		fmt.Printf("Failed to record asset change event: %v\n", err)
+		return
+	}
+	l.pruneChangeEvents(ctx)
+	if err := l.notifier.Publish(ctx, runtimeChangeTopic(asset.EnvironmentKey, asset.PipelineKey)); err != nil {
+		fmt.Printf("Failed to publish asset change: %v\n", err)
+	}
+}
+
+func (l *Logic) pruneChangeEvents(ctx context.Context) {
+	if err := l.eventDAO.Prune(ctx, l.db, changeEventLogSize); err != nil {
+		fmt.Printf("Failed to prune change events: %v\n", err)
+	}
+}
+
+// StreamChangeEvents sends the change events of the configs and assets of an
+// environment/pipeline, including the environment base, until ctx is done or
+// send fails. With resume, the events after lastEventID are replayed first, or
+// a reset is sent when they are no longer kept; otherwise the stream starts
+// with a ready message. Keep-alives are sent while the stream is idle. Events
+// of configs hidden from the caller are skipped.
+func (l *Logic) StreamChangeEvents(ctx context.Context, environmentKey, pipelineKey string, lastEventID int64, resume bool, send func(*ChangeStreamMessage) error) error {
+	scopes := runtimeScopes(pipelineKey)
+	// 先订阅再查询，避免错过两者之间的事件
+	wake, cancel := l.notifier.Subscribe(runtimeChangeTopics(environmentKey, scopes)...)
+	defer cancel()
+
+	cursor, err := l.startChangeStream(ctx, environmentKey, scopes, lastEventID, resume, send)
+	if err != nil {
+		return err
+	}
+	visibility := configVisibilityFor(ctx)
+	keepAlive := time.NewTicker(changeStreamKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		events, err := l.eventDAO.ListAfter(ctx, l.db, environmentKey, scopes, cursor, changeStreamBatch)
+		if err != nil {
+			return err
+		}
+		for i := range events {
+			cursor = int64(events[i].ID)
+			if !visibility.visibleEvent(&events[i]) {
+				continue
+			}
+			if err := send(&ChangeStreamMessage{Type: events[i].Kind + "." + events[i].Action, ID: cursor, Event: &events[i]}); err != nil {
+				return err
+			}
+		}
+		if len(events) == changeStreamBatch {
+			continue
+		}
+		select {
+		case <-wake:
+		case <-keepAlive.C:
+			if err := send(&ChangeStreamMessage{}); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// startChangeStream sends the opening message of a change stream, if any, and
+// returns the event ID to stream after.
+func (l *Logic) startChangeStream(ctx context.Context, environmentKey string, scopes []string, lastEventID int64, resume bool, send func(*ChangeStreamMessage) error) (int64, error) {
+	latest, err := l.eventDAO.LatestID(ctx, l.db, environmentKey, scopes)
+	if err != nil {
+		return 0, err
+	}
+	if !resume {
+		return latest, send(&ChangeStreamMessage{Type: ChangeStreamReady, ID: latest})
+	}
+	oldest, _, err := l.eventDAO.Bounds(ctx, l.db)
+	if err != nil {
+		return 0, err
+	}
+	if lastEventID > latest || lastEventID < oldest-1 {
+		// 未知的事件 ID 或已清除的事件无法重放，客户端需重新加载
+		return latest, send(&ChangeStreamMessage{Type: ChangeStreamReset, ID: latest})
+	}
+	return lastEventID, nil
+}
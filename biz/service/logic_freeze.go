@@ -0,0 +1,349 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"github.com/yi-nology/rainbow_bridge/pkg/common"
+	"github.com/yi-nology/rainbow_bridge/pkg/util"
+
+	"gorm.io/gorm"
+)
+
+// FreezeOverrideReasonHeader carries the reason of a write that overrides an
+// active freeze window. Non-ASCII reasons may be percent-encoded.
+const FreezeOverrideReasonHeader = "X-Freeze-Override-Reason"
+
+// maxFreezeOverrideReason is the number of characters of a reason that are recorded.
+const maxFreezeOverrideReason = 512
+
+// EnvironmentFrozenError explains why a write to an environment was rejected
+// by one of its freeze windows.
+type EnvironmentFrozenError struct {
+	EnvironmentKey string
+	Freeze         model.EnvironmentFreeze
+	EndsAt         time.Time
+	// ReasonMissing is set when the user may override the freeze but did not
+	// give a reason.
+	ReasonMissing bool
+}
+
+func (e *EnvironmentFrozenError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s：环境 %s 处于冻结窗口「%s」", ErrEnvironmentFrozen.Error(), e.EnvironmentKey, e.Freeze.Name)
+	if e.Freeze.Reason != "" {
+		fmt.Fprintf(&b, "（%s）", e.Freeze.Reason)
+	}
+	fmt.Fprintf(&b, "，持续至 %s", util.FormatScheduleTime(&e.EndsAt, e.Freeze.Timezone))
+	users := e.Freeze.AllowedUserList()
+	switch {
+	case e.ReasonMissing:
+		fmt.Fprintf(&b, "；强制变更需在请求头 %s 中填写原因", FreezeOverrideReasonHeader)
+	case len(users) > 0:
+		fmt.Fprintf(&b, "；仅 %s 可通过请求头 %s 填写原因后强制变更", strings.Join(users, ", "), FreezeOverrideReasonHeader)
+	default:
+		b.WriteString("；该窗口不允许强制变更")
+	}
+	return b.String()
+}
+
+func (e *EnvironmentFrozenError) Unwrap() error {
+	return ErrEnvironmentFrozen
+}
+
+// freezeScope remembers the environments a request has already been cleared
+// for, so that nested writes neither check again nor record the override twice.
+// pending holds the overrides of precheckEnvironmentWritable until a nested
+// write to the environment records them.
+type freezeScope struct {
+	mu      sync.Mutex
+	cleared map[string]bool
+	pending map[string][]model.EnvironmentFreezeOverride
+}
+
+type freezeScopeKey struct{}
+
+// withFreezeScope starts a freeze scope for operations made of several writes.
+func withFreezeScope(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(freezeScopeKey{}).(*freezeScope); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, freezeScopeKey{}, &freezeScope{
+		cleared: make(map[string]bool),
+		pending: make(map[string][]model.EnvironmentFreezeOverride),
+	})
+}
+
+// --------------------- Environment Freeze Operations ---------------------
+
+// checkEnvironmentWritable rejects a write to environments inside an active
+// freeze window. Users allowed by every active window may override them by
+// sending a reason in FreezeOverrideReasonHeader. The overrides used, tagged
+// with the operation (e.g. "config.update"), are returned for the write to
+// record with transactWithOverrides.
+func (l *Logic) checkEnvironmentWritable(ctx context.Context, operation string, environmentKeys ...string) ([]model.EnvironmentFreezeOverride, error) {
+	if l.dryRunRevisions != nil {
+		// 预演不写入环境
+		return nil, nil
+	}
+	scope, _ := ctx.Value(freezeScopeKey{}).(*freezeScope)
+	if scope != nil {
+		scope.mu.Lock()
+		defer scope.mu.Unlock()
+	}
+
+	now := time.Now()
+	userID, hasUserID := common.GetUserID(ctx)
+	username := common.GetUsername(ctx)
+	reason := freezeOverrideReason(ctx)
+
+	var overrides []model.EnvironmentFreezeOverride
+	checked := make(map[string]bool, len(environmentKeys))
+	for _, environmentKey := range environmentKeys {
+		if environmentKey == "" || checked[environmentKey] {
+			continue
+		}
+		checked[environmentKey] = true
+		if scope != nil && scope.cleared[environmentKey] {
+			overrides = append(overrides, scope.pending[environmentKey]...)
+			delete(scope.pending, environmentKey)
+			continue
+		}
+
+		freezes, err := l.freezeDAO.ListByEnvironment(ctx, l.db, environmentKey)
+		if err != nil {
+			return nil, err
+		}
+		for i := range freezes {
+			_, end, ok := freezes[i].ActiveWindow(now)
+			if !ok {
+				continue
+			}
+			if !freezes[i].AllowsUser(userID, hasUserID, username) || reason == "" {
+				return nil, &EnvironmentFrozenError{
+					EnvironmentKey: environmentKey,
+					Freeze:         freezes[i],
+					EndsAt:         end,
+					ReasonMissing:  freezes[i].AllowsUser(userID, hasUserID, username),
+				}
+			}
+			overrides = append(overrides, model.EnvironmentFreezeOverride{
+				FreezeID:       freezes[i].ID,
+				EnvironmentKey: environmentKey,
+				Operation:      operation,
+				Reason:         reason,
+				OperatorID:     userID,
+				OperatorName:   username,
+			})
+		}
+	}
+
+	// 同一操作中的后续写入不再检查，覆盖记录随最外层写入保存
+	if scope != nil {
+		for environmentKey := range checked {
+			scope.cleared[environmentKey] = true
+		}
+	}
+	return overrides, nil
+}
+
+// precheckEnvironmentWritable checks the freeze windows of environments
+// ahead of an operation whose writes happen further down, e.g. before a file
+// is uploaded. ctx must carry a freeze scope: the overrides are handed to the
+// first nested write to each environment, and dropped when none follows.
+func (l *Logic) precheckEnvironmentWritable(ctx context.Context, operation string, environmentKeys ...string) error {
+	scope, ok := ctx.Value(freezeScopeKey{}).(*freezeScope)
+	if !ok {
+		return errors.New("freeze precheck requires a freeze scope")
+	}
+	overrides, err := l.checkEnvironmentWritable(ctx, operation, environmentKeys...)
+	if err != nil {
+		return err
+	}
+	scope.mu.Lock()
+	defer scope.mu.Unlock()
+	for i := range overrides {
+		key := overrides[i].EnvironmentKey
+		scope.pending[key] = append(scope.pending[key], overrides[i])
+	}
+	return nil
+}
+
+// transactWithOverrides runs a write in a transaction that also records the
+// freeze overrides returned for it by checkEnvironmentWritable, so rejected
+// or failed writes leave none behind. When the write fails the overrides go
+// back to the freeze scope, for the next write of the operation to record.
+func (l *Logic) transactWithOverrides(ctx context.Context, overrides []model.EnvironmentFreezeOverride, write func(tx *gorm.DB) error) error {
+	err := l.db.Transaction(func(tx *gorm.DB) error {
+		if err := write(tx); err != nil {
+			return err
+		}
+		for i := range overrides {
+			if err := l.freezeDAO.CreateOverride(ctx, tx, &overrides[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err == nil || len(overrides) == 0 {
+		return err
+	}
+
+	scope, ok := ctx.Value(freezeScopeKey{}).(*freezeScope)
+	if !ok {
+		return err
+	}
+	scope.mu.Lock()
+	defer scope.mu.Unlock()
+	for i := range overrides {
+		overrides[i].ID = 0
+		key := overrides[i].EnvironmentKey
+		scope.pending[key] = append(scope.pending[key], overrides[i])
+	}
+	return err
+}
+
+// ListEnvironmentFreezes returns the freeze windows of an environment.
+func (l *Logic) ListEnvironmentFreezes(ctx context.Context, environmentKey string) ([]model.EnvironmentFreeze, error) {
+	return l.freezeDAO.ListByEnvironment(ctx, l.db, environmentKey)
+}
+
+// CreateEnvironmentFreeze adds a freeze window to an environment.
+func (l *Logic) CreateEnvironmentFreeze(ctx context.Context, freeze *model.EnvironmentFreeze) error {
+	if _, err := l.environmentDAO.GetByKey(ctx, l.db, freeze.EnvironmentKey); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrEnvironmentNotFound
+		}
+		return err
+	}
+	if err := normalizeEnvironmentFreeze(freeze); err != nil {
+		return err
+	}
+	freeze.ID = 0
+	freeze.CreatedBy = common.GetUsername(ctx)
+	return l.freezeDAO.Create(ctx, l.db, freeze)
+}
+
+// UpdateEnvironmentFreeze replaces a freeze window. Changing a window that is
+// active requires the same override as any other write to the environment.
+func (l *Logic) UpdateEnvironmentFreeze(ctx context.Context, freeze *model.EnvironmentFreeze) error {
+	existing, overrides, err := l.getFreezeForChange(ctx, freeze.ID, "freeze.update")
+	if err != nil {
+		return err
+	}
+	freeze.EnvironmentKey = existing.EnvironmentKey
+	if err := normalizeEnvironmentFreeze(freeze); err != nil {
+		return err
+	}
+	freeze.CreatedAt = existing.CreatedAt
+	freeze.CreatedBy = existing.CreatedBy
+	return l.transactWithOverrides(ctx, overrides, func(tx *gorm.DB) error {
+		return l.freezeDAO.Save(ctx, tx, freeze)
+	})
+}
+
+// DeleteEnvironmentFreeze removes a freeze window. Lifting a window that is
+// active requires the same override as any other write to the environment.
+func (l *Logic) DeleteEnvironmentFreeze(ctx context.Context, id uint) error {
+	_, overrides, err := l.getFreezeForChange(ctx, id, "freeze.delete")
+	if err != nil {
+		return err
+	}
+	return l.transactWithOverrides(ctx, overrides, func(tx *gorm.DB) error {
+		return l.freezeDAO.Delete(ctx, tx, id)
+	})
+}
+
+// ListFreezeOverrides returns the overrides recorded for an environment, newest first.
+func (l *Logic) ListFreezeOverrides(ctx context.Context, environmentKey string, page, pageSize int) ([]model.EnvironmentFreezeOverride, int64, error) {
+	return l.freezeDAO.ListOverrides(ctx, l.db, environmentKey, page, pageSize)
+}
+
+// getFreezeForChange loads a freeze window to change, along with the
+// overrides the change must record when the window is active.
+func (l *Logic) getFreezeForChange(ctx context.Context, id uint, operation string) (*model.EnvironmentFreeze, []model.EnvironmentFreezeOverride, error) {
+	freeze, err := l.freezeDAO.GetByID(ctx, l.db, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, ErrEnvironmentFreezeNotFound
+		}
+		return nil, nil, err
+	}
+	if _, _, ok := freeze.ActiveWindow(time.Now()); !ok {
+		return freeze, nil, nil
+	}
+	overrides, err := l.checkEnvironmentWritable(ctx, operation, freeze.EnvironmentKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return freeze, overrides, nil
+}
+
+// normalizeEnvironmentFreeze validates a freeze window and clears the fields
+// that do not apply to its recurrence.
+func normalizeEnvironmentFreeze(freeze *model.EnvironmentFreeze) error {
+	freeze.Name = strings.TrimSpace(freeze.Name)
+	if freeze.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrEnvironmentFreezeInvalid)
+	}
+	freeze.Timezone = strings.TrimSpace(freeze.Timezone)
+	if _, err := util.LoadScheduleLocation(freeze.Timezone); err != nil {
+		return fmt.Errorf("%w: %v", ErrEnvironmentFreezeInvalid, err)
+	}
+
+	switch freeze.Recurrence {
+	case "", model.EnvironmentFreezeOnce:
+		freeze.Recurrence = model.EnvironmentFreezeOnce
+		if freeze.StartsAt == nil || freeze.EndsAt == nil {
+			return fmt.Errorf("%w: starts_at and ends_at are required", ErrEnvironmentFreezeInvalid)
+		}
+		if !freeze.EndsAt.After(*freeze.StartsAt) {
+			return fmt.Errorf("%w: ends_at must be after starts_at", ErrEnvironmentFreezeInvalid)
+		}
+		freeze.WeeklyStart, freeze.WeeklyEnd = "", ""
+	case model.EnvironmentFreezeWeekly:
+		start, err := util.ParseWeeklyTime(freeze.WeeklyStart)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrEnvironmentFreezeInvalid, err)
+		}
+		end, err := util.ParseWeeklyTime(freeze.WeeklyEnd)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrEnvironmentFreezeInvalid, err)
+		}
+		if start == end {
+			return fmt.Errorf("%w: weekly_start and weekly_end must differ", ErrEnvironmentFreezeInvalid)
+		}
+		freeze.WeeklyStart, freeze.WeeklyEnd = util.FormatWeeklyTime(start), util.FormatWeeklyTime(end)
+		freeze.StartsAt, freeze.EndsAt = nil, nil
+	default:
+		return fmt.Errorf("%w: unknown recurrence %q", ErrEnvironmentFreezeInvalid, freeze.Recurrence)
+	}
+
+	seen := make(map[string]bool)
+	users := make([]string, 0)
+	for _, user := range freeze.AllowedUserList() {
+		if !seen[user] {
+			seen[user] = true
+			users = append(users, user)
+		}
+	}
+	freeze.AllowedUsers = strings.Join(users, ",")
+	return nil
+}
+
+func freezeOverrideReason(ctx context.Context) string {
+	reason := strings.TrimSpace(common.GetRequestHeader(ctx, FreezeOverrideReasonHeader))
+	if decoded, err := url.PathUnescape(reason); err == nil {
+		reason = strings.TrimSpace(decoded)
+	}
+	if runes := []rune(reason); len(runes) > maxFreezeOverrideReason {
+		reason = string(runes[:maxFreezeOverrideReason])
+	}
+	return reason
+}
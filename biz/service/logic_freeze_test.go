@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/db"
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"github.com/yi-nology/rainbow_bridge/biz/model/common"
+	envpb "github.com/yi-nology/rainbow_bridge/biz/model/environment"
+	pkgcommon "github.com/yi-nology/rainbow_bridge/pkg/common"
+	"github.com/yi-nology/rainbow_bridge/pkg/config"
+)
+
+// TestFreezeOverrideRecordedWithWrite checks that an override of a freeze
+// window is only recorded when the write that used it is committed.
+func TestFreezeOverrideRecordedWithWrite(t *testing.T) {
+	gdb := db.SetupTestDB(t)
+	defer db.CleanupTestDB(t, gdb)
+	s := NewService(gdb, nil, "", &config.Config{})
+
+	user := pkgcommon.ContextWithUsername(pkgcommon.ContextWithUserID(context.Background(), 1), "alice")
+	if err := s.AddEnvironment(user, &envpb.Environment{EnvironmentKey: "prod", EnvironmentName: "Prod", IsActive: true}); err != nil {
+		t.Fatalf("AddEnvironment failed: %v", err)
+	}
+	greeting, err := s.AddConfig(user, &common.ResourceConfig{
+		EnvironmentKey: "prod", PipelineKey: "default", Name: "Greeting", Alias: "greeting", Type: "text", Content: "hello",
+	})
+	if err != nil {
+		t.Fatalf("AddConfig failed: %v", err)
+	}
+	starts, ends := time.Now().Add(-time.Hour), time.Now().Add(time.Hour)
+	freeze := &model.EnvironmentFreeze{
+		EnvironmentKey: "prod", Name: "Release", Recurrence: model.EnvironmentFreezeOnce,
+		StartsAt: &starts, EndsAt: &ends, AllowedUsers: "alice",
+	}
+	if err := s.logic.CreateEnvironmentFreeze(user, freeze); err != nil {
+		t.Fatalf("CreateEnvironmentFreeze failed: %v", err)
+	}
+	overrides := func() []model.EnvironmentFreezeOverride {
+		t.Helper()
+		list, _, err := s.logic.freezeDAO.ListOverrides(user, gdb, "prod", 1, 10)
+		if err != nil {
+			t.Fatalf("ListOverrides failed: %v", err)
+		}
+		return list
+	}
+
+	forced := pkgcommon.ContextWithRequestHeaders(user, map[string]string{FreezeOverrideReasonHeader: "hotfix"})
+	greeting.Content = "hi"
+	var conflict *ConfigRevisionConflictError
+	if _, err := s.UpdateConfig(forced, greeting, 5); !errors.As(err, &conflict) {
+		t.Fatalf("UpdateConfig at a stale revision: err = %v, want a revision conflict", err)
+	}
+	if list := overrides(); len(list) != 0 {
+		t.Fatalf("recorded %+v for a failed write, want nothing", list)
+	}
+
+	if _, err := s.UpdateConfig(forced, greeting, 1); err != nil {
+		t.Fatalf("UpdateConfig failed: %v", err)
+	}
+	list := overrides()
+	if len(list) != 1 || list[0].Operation != "config.update" || list[0].Reason != "hotfix" || list[0].FreezeID != freeze.ID {
+		t.Fatalf("overrides = %+v, want one config.update override of the freeze", list)
+	}
+}
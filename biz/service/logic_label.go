@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/db"
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"github.com/yi-nology/rainbow_bridge/pkg/util"
+
+	"gorm.io/gorm"
+)
+
+// --------------------- Config Labels & Search ---------------------
+
+// SearchConfigs returns the configs matching filter across environments and
+// pipelines together with the total count.
+func (l *Logic) SearchConfigs(ctx context.Context, filter db.ConfigSearchFilter, page, pageSize int) ([]model.Config, int64, error) {
+	if filter.Type != "" {
+		filter.Type = normalizeConfigType(filter.Type)
+	}
+	filter.ExcludePerm = !configVisibilityFor(ctx).perm
+	return l.configDAO.Search(ctx, l.db, filter, page, pageSize)
+}
+
+// UpdateConfigLabels replaces the labels of a config; empty labels clear them.
+// The change is recorded in the config history like any other update.
+func (l *Logic) UpdateConfigLabels(ctx context.Context, environmentKey, pipelineKey, resourceKey string, labels map[string]string) (*model.Config, error) {
+	if err := util.ValidateLabels(labels); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrConfigLabelsInvalid, err)
+	}
+	overrides, err := l.checkEnvironmentWritable(ctx, "config.labels", environmentKey)
+	if err != nil {
+		return nil, err
+	}
+	before, err := l.configDAO.GetByResourceKey(ctx, l.db, environmentKey, pipelineKey, resourceKey)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrResourceNotFound
+		}
+		return nil, err
+	}
+	if err := configVisibilityFor(ctx).check(before); err != nil {
+		return nil, err
+	}
+
+	var after *model.Config
+	err = l.transactWithOverrides(ctx, overrides, func(tx *gorm.DB) error {
+		if err := l.configDAO.SetLabels(ctx, tx, before.ID, util.FormatLabels(labels)); err != nil {
+			return err
+		}
+		after, err = l.configDAO.GetByResourceKey(ctx, tx, environmentKey, pipelineKey, resourceKey)
+		if err != nil {
+			return err
+		}
+		return l.recordConfigRevision(ctx, tx, model.ConfigRevisionActionUpdate, before, after)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	l.invalidateConfigCache(ctx, environmentKey, pipelineKey, resourceKey)
+	return after, nil
+}
@@ -0,0 +1,266 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"github.com/yi-nology/rainbow_bridge/pkg/common"
+	"github.com/yi-nology/rainbow_bridge/pkg/util"
+
+	"gorm.io/gorm"
+)
+
+// MissingTranslations lists the text-like configs of a pipeline without a
+// value in Locale.
+type MissingTranslations struct {
+	Locale  string
+	Configs []model.Config
+}
+
+// --------------------- Config Translations ---------------------
+
+// isLocalizableConfigType reports whether configs of the type may carry translations.
+func isLocalizableConfigType(t string) bool {
+	switch t {
+	case "text", "textarea", "richtext":
+		return true
+	default:
+		return false
+	}
+}
+
+// normalizeConfigTranslations validates the translations of cfg and stores
+// them with normalised locales.
+func normalizeConfigTranslations(cfg *model.Config) error {
+	translations, err := util.ParseTranslations(cfg.Translations)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrConfigTranslationsInvalid, err)
+	}
+	if len(translations) > 0 && !isLocalizableConfigType(cfg.Type) {
+		return fmt.Errorf("%w: 仅文案、文本和富文本配置支持多语言", ErrConfigTranslationsInvalid)
+	}
+	cfg.Translations = util.FormatTranslations(translations)
+	return nil
+}
+
+// UpdateConfigTranslations replaces the translations of a config; empty
+// translations clear them. The change is recorded in the config history like
+// any other update.
+func (l *Logic) UpdateConfigTranslations(ctx context.Context, environmentKey, pipelineKey, resourceKey string, translations map[string]string) (*model.Config, error) {
+	normalized, err := util.NormalizeTranslations(translations)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrConfigTranslationsInvalid, err)
+	}
+	overrides, err := l.checkEnvironmentWritable(ctx, "config.translations", environmentKey)
+	if err != nil {
+		return nil, err
+	}
+	before, err := l.configDAO.GetByResourceKey(ctx, l.db, environmentKey, pipelineKey, resourceKey)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrResourceNotFound
+		}
+		return nil, err
+	}
+	if err := configVisibilityFor(ctx).check(before); err != nil {
+		return nil, err
+	}
+	candidate := *before
+	candidate.Translations = util.FormatTranslations(normalized)
+	if err := normalizeConfigTranslations(&candidate); err != nil {
+		return nil, err
+	}
+	if err := l.checkConfigReferences(ctx, &candidate); err != nil {
+		return nil, err
+	}
+
+	var after *model.Config
+	err = l.transactWithOverrides(ctx, overrides, func(tx *gorm.DB) error {
+		if err := l.advanceConfigRevision(ctx, tx, environmentKey, pipelineKey, resourceKey, 0); err != nil {
+			return err
+		}
+		if err := l.configDAO.UpdateTranslations(ctx, tx, before.ID, candidate.Translations); err != nil {
+			return err
+		}
+		after, err = l.configDAO.GetByResourceKey(ctx, tx, environmentKey, pipelineKey, resourceKey)
+		if err != nil {
+			return err
+		}
+		return l.recordConfigRevision(ctx, tx, model.ConfigRevisionActionUpdate, before, after)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	l.invalidateConfigCache(ctx, environmentKey, pipelineKey, resourceKey)
+	return after, nil
+}
+
+// ListMissingTranslations returns the default locale of a pipeline and, for
+// each target locale, the text-like configs served to the pipeline (inherited
+// base configs included) that have no translation into it. The target locales
+// are the further locales of the pipeline, or only locale when given.
+func (l *Logic) ListMissingTranslations(ctx context.Context, environmentKey, pipelineKey, locale string) (string, []MissingTranslations, error) {
+	pipeline, err := l.pipelineDAO.GetByKey(ctx, l.db, environmentKey, pipelineKey)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", nil, ErrPipelineNotFound
+		}
+		return "", nil, err
+	}
+	targets, err := util.ParseLocaleList(pipeline.Locales)
+	if err != nil {
+		return "", nil, err
+	}
+	if strings.TrimSpace(locale) != "" {
+		normalized, err := util.NormalizeLocale(locale)
+		if err != nil {
+			return "", nil, fmt.Errorf("%w: %v", ErrConfigTranslationsInvalid, err)
+		}
+		targets = []string{normalized}
+	}
+
+	configs, err := l.listEffectiveConfigs(ctx, l.db, environmentKey, pipelineKey)
+	if err != nil {
+		return "", nil, err
+	}
+	configs = configVisibilityFor(ctx).filter(configs)
+	result := make([]MissingTranslations, 0, len(targets))
+	for _, target := range targets {
+		missing := MissingTranslations{Locale: target, Configs: []model.Config{}}
+		if target == pipeline.DefaultLocale {
+			// 默认语言的值即配置内容
+			result = append(result, missing)
+			continue
+		}
+		for i := range configs {
+			if !isLocalizableConfigType(configs[i].Type) {
+				continue
+			}
+			translations, err := util.ParseTranslations(configs[i].Translations)
+			if err != nil {
+				return "", nil, err
+			}
+			if _, ok := translations[target]; !ok {
+				missing.Configs = append(missing.Configs, configs[i])
+			}
+		}
+		result = append(result, missing)
+	}
+	return pipeline.DefaultLocale, result, nil
+}
+
+// localizeConfigs serves translated configs in the locale that best matches
+// the locales preferred in ctx, see util.MatchLocale. The default locale of
+// the pipeline stands for the content itself; it is also served when no
+// translation matches. Served locales are reported in Locale.
+func (l *Logic) localizeConfigs(ctx context.Context, environmentKey, pipelineKey string, configs []model.Config) ([]model.Config, error) {
+	if !hasTranslatedConfigs(configs) {
+		return configs, nil
+	}
+	defaultLocale, err := l.pipelineDefaultLocale(ctx, environmentKey, pipelineKey)
+	if err != nil {
+		return nil, err
+	}
+	return localizeConfigList(ctx, defaultLocale, configs), nil
+}
+
+func hasTranslatedConfigs(configs []model.Config) bool {
+	for i := range configs {
+		if configs[i].Translations != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// localizeConfigList localizes configs for a pipeline with the given default
+// locale, see localizeConfigs.
+func localizeConfigList(ctx context.Context, defaultLocale string, configs []model.Config) []model.Config {
+	if !hasTranslatedConfigs(configs) {
+		return configs
+	}
+	preferred := common.GetLocales(ctx)
+	localized := make([]model.Config, len(configs))
+	for i := range configs {
+		localized[i] = configs[i]
+		translations, err := util.ParseTranslations(configs[i].Translations)
+		if err != nil || len(translations) == 0 {
+			continue
+		}
+		// 默认语言在前，其余按标签排序，使同语言回退的结果稳定
+		others := make([]string, 0, len(translations))
+		for locale := range translations {
+			if locale != defaultLocale {
+				others = append(others, locale)
+			}
+		}
+		sort.Strings(others)
+		available := others
+		if defaultLocale != "" {
+			available = append([]string{defaultLocale}, others...)
+		}
+		locale := util.MatchLocale(preferred, available)
+		if locale == "" {
+			locale = defaultLocale
+		}
+		if value, ok := translations[locale]; ok {
+			localized[i].Content = value
+		}
+		localized[i].Locale = locale
+	}
+	return localized
+}
+
+// pipelineDefaultLocale returns the default locale of a pipeline; it is empty
+// for environment base configs and unknown pipelines.
+func (l *Logic) pipelineDefaultLocale(ctx context.Context, environmentKey, pipelineKey string) (string, error) {
+	if pipelineKey == model.BasePipelineKey {
+		return "", nil
+	}
+	pipeline, err := l.pipelineDAO.GetByKey(ctx, l.db, environmentKey, pipelineKey)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	return pipeline.DefaultLocale, nil
+}
+
+// pipelineLocales returns the default locale of a pipeline followed by its
+// further locales. Without configured locales, the locales the configs are
+// translated into are used.
+func pipelineLocales(pipeline *model.Pipeline, configs []model.Config) []string {
+	locales, _ := util.ParseLocaleList(pipeline.Locales)
+	if len(locales) == 0 {
+		seen := make(map[string]bool)
+		for i := range configs {
+			translations, err := util.ParseTranslations(configs[i].Translations)
+			if err != nil {
+				continue
+			}
+			for locale := range translations {
+				if !seen[locale] {
+					seen[locale] = true
+					locales = append(locales, locale)
+				}
+			}
+		}
+		sort.Strings(locales)
+	}
+	result := make([]string, 0, len(locales)+1)
+	if pipeline.DefaultLocale != "" {
+		result = append(result, pipeline.DefaultLocale)
+	}
+	for _, locale := range locales {
+		if locale != pipeline.DefaultLocale {
+			result = append(result, locale)
+		}
+	}
+	return result
+}
@@ -0,0 +1,397 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"github.com/yi-nology/rainbow_bridge/pkg/util"
+
+	"gorm.io/gorm"
+)
+
+// --------------------- Config References ---------------------
+
+// ConfigPreview is a config with its references resolved.
+type ConfigPreview struct {
+	Config     model.Config
+	RawContent string
+	References []util.ConfigReference
+}
+
+// PreviewConfig resolves the references of a config the way runtime clients
+// would see them. Base configs inherited by the pipeline can be previewed
+// through the pipeline. References to configs hidden from the caller are left
+// as written.
+func (l *Logic) PreviewConfig(ctx context.Context, environmentKey, pipelineKey, resourceKey string) (*ConfigPreview, error) {
+	target, err := l.configDAO.GetByResourceKey(ctx, l.db, environmentKey, pipelineKey, resourceKey)
+	if errors.Is(err, gorm.ErrRecordNotFound) && pipelineKey != model.BasePipelineKey {
+		target, err = l.configDAO.GetByResourceKey(ctx, l.db, environmentKey, model.BasePipelineKey, resourceKey)
+	}
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrResourceNotFound
+		}
+		return nil, err
+	}
+	visibility := configVisibilityFor(ctx)
+	if err := visibility.check(target); err != nil {
+		return nil, err
+	}
+
+	configs, err := l.listEffectiveConfigs(ctx, l.db, environmentKey, pipelineKey)
+	if err != nil {
+		return nil, err
+	}
+	selected := model.SelectConfigVariants(visibility.filter(configs), "")
+	// 预览指定的变体，而不是该别名的默认变体
+	replaced := false
+	for i := range selected {
+		if selected[i].Alias == target.Alias {
+			selected[i] = *target
+			replaced = true
+		}
+	}
+	if !replaced {
+		selected = append(selected, *target)
+	}
+
+	preview := &ConfigPreview{
+		Config:     *target,
+		RawContent: target.Content,
+		References: configReferences(target),
+	}
+	preview.Config.Content = newConfigReferenceResolver(selected).resolve(target)
+	return preview, nil
+}
+
+// resolveConfigReferences interpolates the references of configs that have
+// already been narrowed to one variant per alias. Dangling and cyclic
+// references are left as written.
+func resolveConfigReferences(configs []model.Config) []model.Config {
+	resolver := newConfigReferenceResolver(configs)
+	if !resolver.hasReferences {
+		return configs
+	}
+	resolved := make([]model.Config, len(configs))
+	for i := range configs {
+		resolved[i] = configs[i]
+		if resolver.byAlias[configs[i].Alias] == &configs[i] {
+			resolved[i].Content, _ = resolver.content(configs[i].Alias)
+		} else {
+			resolved[i].Content = resolver.resolve(&configs[i])
+		}
+	}
+	return resolved
+}
+
+// checkConfigReferences makes sure every reference of cfg points to an existing
+// alias (and field) of its environment/pipeline, and that saving cfg does not
+// close a reference cycle. Pipeline configs may reference inherited base configs.
+func (l *Logic) checkConfigReferences(ctx context.Context, cfg *model.Config) error {
+	refs := configReferences(cfg)
+	if len(refs) == 0 {
+		return nil
+	}
+	configs, err := l.listEffectiveConfigs(ctx, l.db, cfg.EnvironmentKey, cfg.PipelineKey)
+	if err != nil {
+		return err
+	}
+
+	variants := make(map[string][]*model.Config, len(configs)+1)
+	for i := range configs {
+		if configs[i].ResourceKey == cfg.ResourceKey && configs[i].PipelineKey == cfg.PipelineKey {
+			continue
+		}
+		if configs[i].Alias == cfg.Alias && configs[i].PipelineKey != cfg.PipelineKey {
+			// 保存后渠道配置会覆盖同名的环境基础配置
+			continue
+		}
+		variants[configs[i].Alias] = append(variants[configs[i].Alias], &configs[i])
+	}
+	variants[cfg.Alias] = append(variants[cfg.Alias], cfg)
+
+	for _, ref := range refs {
+		targets := variants[ref.Alias]
+		if len(targets) == 0 {
+			return fmt.Errorf("%w: %s", ErrConfigReferenceDangling, ref.Token)
+		}
+		for _, target := range targets {
+			if target.Type == "secret" {
+				return fmt.Errorf("%w: %s", ErrConfigReferenceSecret, ref.Token)
+			}
+		}
+		if len(ref.Path) == 0 {
+			continue
+		}
+		// 每个版本变体都必须包含被引用的字段
+		for _, target := range targets {
+			if _, ok := lookupReferencePath(TypedConfigContent(target.Type, target.Content), ref.Path); !ok {
+				return fmt.Errorf("%w: %s", ErrConfigReferenceDangling, ref.Token)
+			}
+		}
+	}
+
+	if cycle := findReferenceCycle(cfg.Alias, variants); cycle != nil {
+		return fmt.Errorf("%w: %s", ErrConfigReferenceCycle, strings.Join(cycle, " -> "))
+	}
+	return nil
+}
+
+// checkConfigReferenced refuses to delete the last variant of an alias that
+// other configs still reference.
+func (l *Logic) checkConfigReferenced(ctx context.Context, cfg *model.Config) error {
+	var configs []model.Config
+	var err error
+	if cfg.PipelineKey == model.BasePipelineKey {
+		// 环境基础配置可能被任意渠道引用
+		configs, err = l.configDAO.ListByEnvironment(ctx, l.db, cfg.EnvironmentKey)
+	} else {
+		configs, err = l.listEffectiveConfigs(ctx, l.db, cfg.EnvironmentKey, cfg.PipelineKey)
+	}
+	if err != nil {
+		return err
+	}
+	if cfg.PipelineKey != model.BasePipelineKey {
+		// 删除后渠道会重新继承同名的环境基础配置
+		base, err := l.configDAO.ListByAlias(ctx, l.db, cfg.EnvironmentKey, model.BasePipelineKey, cfg.Alias)
+		if err != nil {
+			return err
+		}
+		if len(base) > 0 {
+			return nil
+		}
+	}
+
+	// 仍有其他变体（或渠道自身覆盖了该别名）时，引用不会悬空
+	definedBy := make(map[string]bool)
+	for i := range configs {
+		other := &configs[i]
+		if other.Alias != cfg.Alias || (other.PipelineKey == cfg.PipelineKey && other.ResourceKey == cfg.ResourceKey) {
+			continue
+		}
+		if other.PipelineKey == cfg.PipelineKey {
+			return nil
+		}
+		definedBy[other.PipelineKey] = true
+	}
+	for i := range configs {
+		other := &configs[i]
+		if other.PipelineKey == cfg.PipelineKey && other.ResourceKey == cfg.ResourceKey {
+			continue
+		}
+		if definedBy[other.PipelineKey] {
+			continue
+		}
+		for _, ref := range configReferences(other) {
+			if ref.Alias == cfg.Alias {
+				return fmt.Errorf("%w: %s", ErrConfigReferenced, other.Alias)
+			}
+		}
+	}
+	return nil
+}
+
+// supportsConfigReferences reports whether references in content of the type
+// are interpolated. Typed scalars and asset references are served verbatim.
+func supportsConfigReferences(t string) bool {
+	switch t {
+	case "text", "textarea", "richtext":
+		return true
+	default:
+		return isJSONObjectConfigType(t)
+	}
+}
+
+// configReferences returns the references in the content of cfg and in its translations.
+func configReferences(cfg *model.Config) []util.ConfigReference {
+	if !supportsConfigReferences(cfg.Type) {
+		return nil
+	}
+	refs := util.FindConfigReferences(cfg.Content)
+	if cfg.Translations != "" {
+		translations, _ := util.ParseTranslations(cfg.Translations)
+		locales := make([]string, 0, len(translations))
+		for locale := range translations {
+			locales = append(locales, locale)
+		}
+		sort.Strings(locales)
+		for _, locale := range locales {
+			refs = append(refs, util.FindConfigReferences(translations[locale])...)
+		}
+	}
+	return refs
+}
+
+// findReferenceCycle returns the aliases of a reference cycle through start, if any.
+func findReferenceCycle(start string, variants map[string][]*model.Config) []string {
+	visited := make(map[string]bool)
+	var path []string
+	var visit func(alias string) bool
+	visit = func(alias string) bool {
+		path = append(path, alias)
+		for _, cfg := range variants[alias] {
+			for _, ref := range configReferences(cfg) {
+				if ref.Alias == start {
+					path = append(path, start)
+					return true
+				}
+				if visited[ref.Alias] {
+					continue
+				}
+				visited[ref.Alias] = true
+				if visit(ref.Alias) {
+					return true
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		return false
+	}
+	if visit(start) {
+		return path
+	}
+	return nil
+}
+
+// configReferenceResolver resolves references against one variant per alias,
+// memoising resolved contents and guarding against cycles.
+type configReferenceResolver struct {
+	byAlias       map[string]*model.Config
+	resolved      map[string]string
+	resolving     map[string]bool
+	hasReferences bool
+}
+
+func newConfigReferenceResolver(configs []model.Config) *configReferenceResolver {
+	r := &configReferenceResolver{
+		byAlias:   make(map[string]*model.Config, len(configs)),
+		resolved:  make(map[string]string),
+		resolving: make(map[string]bool),
+	}
+	for i := range configs {
+		r.byAlias[configs[i].Alias] = &configs[i]
+		if !r.hasReferences && len(configReferences(&configs[i])) > 0 {
+			r.hasReferences = true
+		}
+	}
+	return r
+}
+
+// resolve returns the content of cfg with its references interpolated. Inside
+// JSON object configs a string that is exactly one reference takes the typed
+// value of the target (object, number or boolean); otherwise the value is
+// interpolated as text.
+func (r *configReferenceResolver) resolve(cfg *model.Config) string {
+	if !supportsConfigReferences(cfg.Type) || len(util.FindConfigReferences(cfg.Content)) == 0 {
+		return cfg.Content
+	}
+	if isJSONObjectConfigType(cfg.Type) {
+		var payload any
+		if err := json.Unmarshal([]byte(cfg.Content), &payload); err == nil {
+			if data, err := json.Marshal(r.resolveJSON(payload)); err == nil {
+				return string(data)
+			}
+		}
+	}
+	return util.InterpolateConfigReferences(cfg.Content, r.text)
+}
+
+func (r *configReferenceResolver) resolveJSON(node any) any {
+	switch value := node.(type) {
+	case map[string]any:
+		for key, child := range value {
+			value[key] = r.resolveJSON(child)
+		}
+		return value
+	case []any:
+		for i, child := range value {
+			value[i] = r.resolveJSON(child)
+		}
+		return value
+	case string:
+		if ref, ok := util.ParseSingleConfigReference(value); ok {
+			if resolved, ok := r.value(ref); ok {
+				return resolved
+			}
+			return value
+		}
+		return util.InterpolateConfigReferences(value, r.text)
+	default:
+		return node
+	}
+}
+
+// content returns the resolved content of alias; false while the alias is
+// itself being resolved, which means the reference is part of a cycle.
+func (r *configReferenceResolver) content(alias string) (string, bool) {
+	if content, ok := r.resolved[alias]; ok {
+		return content, true
+	}
+	target, ok := r.byAlias[alias]
+	if !ok || r.resolving[alias] || target.Type == "secret" {
+		return "", false
+	}
+	r.resolving[alias] = true
+	content := r.resolve(target)
+	delete(r.resolving, alias)
+	r.resolved[alias] = content
+	return content, true
+}
+
+// value returns the typed value a reference points to.
+func (r *configReferenceResolver) value(ref util.ConfigReference) (any, bool) {
+	content, ok := r.content(ref.Alias)
+	if !ok {
+		return nil, false
+	}
+	return lookupReferencePath(TypedConfigContent(r.byAlias[ref.Alias].Type, content), ref.Path)
+}
+
+// text returns the value a reference points to as it is interpolated into text.
+func (r *configReferenceResolver) text(ref util.ConfigReference) (string, bool) {
+	value, ok := r.value(ref)
+	if !ok {
+		return "", false
+	}
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case json.Number:
+		return v.String(), true
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return "", false
+		}
+		return string(data), true
+	}
+}
+
+// lookupReferencePath walks path through objects (by key) and arrays (by index).
+func lookupReferencePath(value any, path []string) (any, bool) {
+	for _, key := range path {
+		switch node := value.(type) {
+		case map[string]any:
+			child, ok := node[key]
+			if !ok {
+				return nil, false
+			}
+			value = child
+		case []any:
+			idx, err := strconv.Atoi(key)
+			if err != nil || idx < 0 || idx >= len(node) {
+				return nil, false
+			}
+			value = node[idx]
+		default:
+			return nil, false
+		}
+	}
+	return value, true
+}
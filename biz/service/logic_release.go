@@ -0,0 +1,406 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"github.com/yi-nology/rainbow_bridge/pkg/common"
+	"github.com/yi-nology/rainbow_bridge/pkg/redis"
+
+	"gorm.io/gorm"
+)
+
+// Release diff change kinds.
+const (
+	ReleaseChangeAdded    = "added"
+	ReleaseChangeRemoved  = "removed"
+	ReleaseChangeModified = "modified"
+)
+
+// ReleaseConfigDiff describes how a single config differs between two releases.
+type ReleaseConfigDiff struct {
+	ResourceKey string
+	Alias       string
+	Change      string
+	Before      *model.Config
+	After       *model.Config
+}
+
+// --------------------- Config Release Operations ---------------------
+
+// PublishRelease freezes the current configs of an environment/pipeline,
+// including the inherited environment base configs, into a new numbered
+// release and makes it the active one.
+func (l *Logic) PublishRelease(ctx context.Context, environmentKey, pipelineKey, description string) (*model.ConfigRelease, error) {
+	if err := l.ensurePipelineExists(ctx, environmentKey, pipelineKey); err != nil {
+		return nil, err
+	}
+	overrides, err := l.checkEnvironmentWritable(ctx, "release.publish", environmentKey)
+	if err != nil {
+		return nil, err
+	}
+
+	release := &model.ConfigRelease{
+		EnvironmentKey: environmentKey,
+		PipelineKey:    pipelineKey,
+		Description:    description,
+		OperatorName:   common.GetUsername(ctx),
+	}
+	if userID, ok := common.GetUserID(ctx); ok {
+		release.OperatorID = userID
+	}
+
+	err = l.transactWithOverrides(ctx, overrides, func(tx *gorm.DB) error {
+		configs, err := l.listEffectiveConfigs(ctx, tx, environmentKey, pipelineKey)
+		if err != nil {
+			return err
+		}
+		snapshot, err := json.Marshal(configs)
+		if err != nil {
+			return err
+		}
+		latest, err := l.releaseDAO.MaxVersion(ctx, tx, environmentKey, pipelineKey)
+		if err != nil {
+			return err
+		}
+
+		if err := l.recordReleasePlan(ctx, tx, environmentKey, pipelineKey, configs); err != nil {
+			return err
+		}
+
+		release.Version = latest + 1
+		release.Snapshot = string(snapshot)
+		release.ConfigCount = len(configs)
+		if err := l.releaseDAO.Create(ctx, tx, release); err != nil {
+			return err
+		}
+		if err := l.releaseDAO.Activate(ctx, tx, environmentKey, pipelineKey, release.Version); err != nil {
+			return err
+		}
+		// 已晋升的灰度值已包含在新版本中
+		if err := l.rolloutDAO.CompletePromoted(ctx, tx, environmentKey, pipelineKey); err != nil {
+			return err
+		}
+		return l.recordRuntimeChange(ctx, tx, environmentKey, pipelineKey)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	l.announceRuntimeChange(ctx, environmentKey, pipelineKey)
+	return l.GetRelease(ctx, environmentKey, pipelineKey, release.Version)
+}
+
+// ListReleases returns the releases of an environment/pipeline, newest first.
+func (l *Logic) ListReleases(ctx context.Context, environmentKey, pipelineKey string, page, pageSize int) ([]model.ConfigRelease, int64, error) {
+	return l.releaseDAO.List(ctx, l.db, environmentKey, pipelineKey, page, pageSize)
+}
+
+// GetRelease returns a single release including its snapshot.
+func (l *Logic) GetRelease(ctx context.Context, environmentKey, pipelineKey string, version int) (*model.ConfigRelease, error) {
+	release, err := l.releaseDAO.GetByVersion(ctx, l.db, environmentKey, pipelineKey, version)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrReleaseNotFound
+		}
+		return nil, err
+	}
+	return release, nil
+}
+
+// ActivateRelease makes an earlier (or the latest) release the one served to runtime clients.
+func (l *Logic) ActivateRelease(ctx context.Context, environmentKey, pipelineKey string, version int) (*model.ConfigRelease, error) {
+	overrides, err := l.checkEnvironmentWritable(ctx, "release.activate", environmentKey)
+	if err != nil {
+		return nil, err
+	}
+	err = l.transactWithOverrides(ctx, overrides, func(tx *gorm.DB) error {
+		if l.dryRunRevisions != nil {
+			target, err := l.releaseDAO.GetByVersion(ctx, tx, environmentKey, pipelineKey, version)
+			if err != nil {
+				return err
+			}
+			configs, err := decodeReleaseSnapshot(target)
+			if err != nil {
+				return err
+			}
+			if err := l.recordReleasePlan(ctx, tx, environmentKey, pipelineKey, configs); err != nil {
+				return err
+			}
+		}
+		if err := l.releaseDAO.Activate(ctx, tx, environmentKey, pipelineKey, version); err != nil {
+			return err
+		}
+		return l.recordRuntimeChange(ctx, tx, environmentKey, pipelineKey)
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrReleaseNotFound
+		}
+		return nil, err
+	}
+
+	l.announceRuntimeChange(ctx, environmentKey, pipelineKey)
+	return l.GetRelease(ctx, environmentKey, pipelineKey, version)
+}
+
+// CompareReleases diffs the configs of two releases keyed by resource_key.
+// A version of 0 stands for the current, unpublished working configs. Configs
+// hidden from the caller are left out.
+func (l *Logic) CompareReleases(ctx context.Context, environmentKey, pipelineKey string, fromVersion, toVersion int) ([]ReleaseConfigDiff, error) {
+	from, err := l.releaseConfigs(ctx, environmentKey, pipelineKey, fromVersion)
+	if err != nil {
+		return nil, err
+	}
+	to, err := l.releaseConfigs(ctx, environmentKey, pipelineKey, toVersion)
+	if err != nil {
+		return nil, err
+	}
+	visibility := configVisibilityFor(ctx)
+	return diffConfigSets(visibility.filter(from), visibility.filter(to)), nil
+}
+
+// ListRuntimeConfigs returns the configs served to runtime clients: the
+// snapshot of the active release, or the working configs when nothing has
+// been published for the environment/pipeline yet, merged over the base
+// configs of the environment. Version variants are
+// reduced to the one matching the client version in ctx and open rollouts
+// are applied.
+func (l *Logic) ListRuntimeConfigs(ctx context.Context, environmentKey, pipelineKey string) ([]model.Config, error) {
+	source, err := l.loadRuntimeSource(ctx, environmentKey, pipelineKey)
+	if err != nil {
+		return nil, err
+	}
+	return l.renderRuntimeConfigs(ctx, source), nil
+}
+
+// runtimeSource is what the runtime configs of a pipeline are rendered from
+// besides the request: the stored configs, the open rollouts and alias
+// redirects of the pipeline and its environment base, and the default locale
+// of the pipeline when configs are translated.
+type runtimeSource struct {
+	Configs       []model.Config              `json:"configs"`
+	Rollouts      []model.ConfigRollout       `json:"rollouts"`
+	Redirects     []model.ConfigAliasRedirect `json:"redirects"`
+	DefaultLocale string                      `json:"default_locale,omitempty"`
+}
+
+// loadRuntimeSource loads the runtime source of a pipeline.
+func (l *Logic) loadRuntimeSource(ctx context.Context, environmentKey, pipelineKey string) (*runtimeSource, error) {
+	now := time.Now()
+	own, err := l.runtimeDAO.Get(ctx, l.db, environmentKey, pipelineKey, now)
+	if err != nil {
+		return nil, err
+	}
+	base, err := l.runtimeDAO.Get(ctx, l.db, environmentKey, model.BasePipelineKey, now)
+	if err != nil {
+		return nil, err
+	}
+	return l.loadRuntimeSourceAt(ctx, environmentKey, pipelineKey, own.Counter, base.Counter)
+}
+
+// loadRuntimeSourceAt loads the runtime source of a pipeline, cached per state
+// of its change counters like the runtime schedule. Writes bump the counters
+// in their transaction, so a source cached before a write is not served once
+// the counters include it, even before the write has cleared the caches.
+func (l *Logic) loadRuntimeSourceAt(ctx context.Context, environmentKey, pipelineKey string, counter, baseCounter int64) (*runtimeSource, error) {
+	cacheKey := redis.GenerateRuntimeSourceKey(environmentKey, pipelineKey, counter, baseCounter)
+	var cached runtimeSource
+	found, err := redis.Get(ctx, l.redisClient, cacheKey, &cached)
+	if err == nil && found {
+		return &cached, nil
+	}
+
+	now := time.Now()
+	configs, err := l.loadRuntimeConfigs(ctx, environmentKey, pipelineKey)
+	if err != nil {
+		return nil, err
+	}
+	source := &runtimeSource{Configs: configs}
+	// 渠道自身的灰度与重定向优先于继承的环境基础配置
+	for _, scope := range runtimeScopes(pipelineKey) {
+		rollouts, err := l.rolloutDAO.ListOpen(ctx, l.db, environmentKey, scope)
+		if err != nil {
+			return nil, err
+		}
+		source.Rollouts = append(source.Rollouts, rollouts...)
+		redirects, err := l.redirectDAO.ListActive(ctx, l.db, environmentKey, scope, now)
+		if err != nil {
+			return nil, err
+		}
+		source.Redirects = append(source.Redirects, redirects...)
+	}
+	if hasTranslatedConfigs(configs) {
+		source.DefaultLocale, err = l.pipelineDefaultLocale(ctx, environmentKey, pipelineKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 存入缓存，设置过期时间为30分钟，且不晚于下一个定时生效/失效时间点与最早到期的重定向
+	boundary := model.NextScheduleBoundary(configs, now)
+	for i := range source.Redirects {
+		if boundary.IsZero() || source.Redirects[i].ExpiresAt.Before(boundary) {
+			boundary = source.Redirects[i].ExpiresAt
+		}
+	}
+	if err := redis.Set(ctx, l.redisClient, cacheKey, source, redis.ExpirationUntil(30*time.Minute, now, boundary)); err != nil {
+		// 缓存错误不影响主流程，只记录错误
+		fmt.Printf("Failed to cache runtime source: %v\n", err)
+	}
+	return source, nil
+}
+
+// loadRuntimeConfigs returns the stored configs runtime clients are served
+// from, before renderRuntimeConfigs.
+func (l *Logic) loadRuntimeConfigs(ctx context.Context, environmentKey, pipelineKey string) ([]model.Config, error) {
+	release, err := l.releaseDAO.GetActive(ctx, l.db, environmentKey, pipelineKey)
+	switch {
+	case err == nil:
+		return decodeReleaseSnapshot(release)
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return l.listEffectiveConfigs(ctx, l.db, environmentKey, pipelineKey)
+	default:
+		return nil, err
+	}
+}
+
+// renderRuntimeConfigs turns a runtime source into the per-client view:
+// configs hidden from the caller or outside their schedule window are dropped
+// first, so references to hidden configs stay unresolved; version variants are
+// resolved, then rollouts are applied, renamed configs are added under their
+// deprecated aliases, translated configs are served in the preferred locale,
+// references between configs are interpolated and finally secrets are decrypted.
+// The source is left untouched, so it may be rendered again.
+func (l *Logic) renderRuntimeConfigs(ctx context.Context, source *runtimeSource) []model.Config {
+	configs := make([]model.Config, len(source.Configs))
+	copy(configs, source.Configs)
+	scheduled := model.FilterScheduledConfigs(configVisibilityFor(ctx).filter(configs), time.Now())
+	selected := model.SelectConfigVariants(scheduled, common.GetClientVersion(ctx))
+	rendered := applyRollouts(ctx, source.Rollouts, selected)
+	rendered = applyAliasRedirectList(source.Redirects, rendered)
+	rendered = localizeConfigList(ctx, source.DefaultLocale, rendered)
+	return l.revealSecrets(resolveConfigReferences(rendered))
+}
+
+// recordReleasePlan records how the configs served to runtime clients change
+// when configs become active, during dry runs, so that change requests show
+// it for review.
+func (l *Logic) recordReleasePlan(ctx context.Context, tx *gorm.DB, environmentKey, pipelineKey string, configs []model.Config) error {
+	if l.dryRunRevisions == nil {
+		return nil
+	}
+	var served []model.Config
+	active, err := l.releaseDAO.GetActive(ctx, tx, environmentKey, pipelineKey)
+	switch {
+	case err == nil:
+		served, err = decodeReleaseSnapshot(active)
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		served, err = l.listEffectiveConfigs(ctx, tx, environmentKey, pipelineKey)
+	}
+	if err != nil {
+		return err
+	}
+	for _, diff := range diffConfigSets(served, configs) {
+		if err := l.recordConfigRevision(ctx, tx, model.ConfigRevisionActionRelease, diff.Before, diff.After); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *Logic) ensurePipelineExists(ctx context.Context, environmentKey, pipelineKey string) error {
+	if _, err := l.environmentDAO.GetByKey(ctx, l.db, environmentKey); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrEnvironmentNotFound
+		}
+		return err
+	}
+	if _, err := l.pipelineDAO.GetByKey(ctx, l.db, environmentKey, pipelineKey); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrPipelineNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// releaseConfigs loads the configs of a release, or the merged working configs for version 0.
+func (l *Logic) releaseConfigs(ctx context.Context, environmentKey, pipelineKey string, version int) ([]model.Config, error) {
+	if version == 0 {
+		return l.listEffectiveConfigs(ctx, l.db, environmentKey, pipelineKey)
+	}
+	release, err := l.GetRelease(ctx, environmentKey, pipelineKey, version)
+	if err != nil {
+		return nil, err
+	}
+	return decodeReleaseSnapshot(release)
+}
+
+func decodeReleaseSnapshot(release *model.ConfigRelease) ([]model.Config, error) {
+	if release == nil || release.Snapshot == "" {
+		return []model.Config{}, nil
+	}
+	var configs []model.Config
+	if err := json.Unmarshal([]byte(release.Snapshot), &configs); err != nil {
+		return nil, fmt.Errorf("decode release %d snapshot: %w", release.Version, err)
+	}
+	return configs, nil
+}
+
+// diffConfigSets compares two config sets by resource_key; the result is ordered by resource_key.
+func diffConfigSets(from, to []model.Config) []ReleaseConfigDiff {
+	fromMap := make(map[string]*model.Config, len(from))
+	for i := range from {
+		fromMap[from[i].ResourceKey] = &from[i]
+	}
+	toMap := make(map[string]*model.Config, len(to))
+	for i := range to {
+		toMap[to[i].ResourceKey] = &to[i]
+	}
+
+	var diffs []ReleaseConfigDiff
+	for key, before := range fromMap {
+		after, ok := toMap[key]
+		if !ok {
+			diffs = append(diffs, ReleaseConfigDiff{ResourceKey: key, Alias: before.Alias, Change: ReleaseChangeRemoved, Before: before})
+			continue
+		}
+		if !sameConfigContent(before, after) {
+			diffs = append(diffs, ReleaseConfigDiff{ResourceKey: key, Alias: after.Alias, Change: ReleaseChangeModified, Before: before, After: after})
+		}
+	}
+	for key, after := range toMap {
+		if _, ok := fromMap[key]; !ok {
+			diffs = append(diffs, ReleaseConfigDiff{ResourceKey: key, Alias: after.Alias, Change: ReleaseChangeAdded, After: after})
+		}
+	}
+
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].ResourceKey < diffs[j].ResourceKey })
+	return diffs
+}
+
+// sameConfigContent reports whether two configs are equal ignoring bookkeeping fields.
+func sameConfigContent(a, b *model.Config) bool {
+	left, right := *a, *b
+	left.ID, right.ID = 0, 0
+	left.CreatedAt, right.CreatedAt = time.Time{}, time.Time{}
+	left.UpdatedAt, right.UpdatedAt = time.Time{}, time.Time{}
+	left.Revision, right.Revision = 0, 0
+	left.Origin, right.Origin = "", ""
+	left.OverridesBase, right.OverridesBase = false, false
+	leftData, err := json.Marshal(left)
+	if err != nil {
+		return false
+	}
+	rightData, err := json.Marshal(right)
+	if err != nil {
+		return false
+	}
+	return string(leftData) == string(rightData)
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"github.com/yi-nology/rainbow_bridge/pkg/common"
+
+	"gorm.io/gorm"
+)
+
+// --------------------- Config Revision Operations ---------------------
+
+// recordConfigRevision stores the before/after snapshot of a config change,
+// its change event and the runtime change, using the given transaction.
+func (l *Logic) recordConfigRevision(ctx context.Context, tx *gorm.DB, action string, before, after *model.Config) error {
+	ref := after
+	if ref == nil {
+		ref = before
+	}
+	if ref == nil {
+		return nil
+	}
+
+	revision := &model.ConfigRevision{
+		EnvironmentKey: ref.EnvironmentKey,
+		PipelineKey:    ref.PipelineKey,
+		ResourceKey:    ref.ResourceKey,
+		Alias:          ref.Alias,
+		Action:         action,
+		OperatorName:   common.GetUsername(ctx),
+	}
+	if userID, ok := common.GetUserID(ctx); ok {
+		revision.OperatorID = userID
+	}
+
+	var err error
+	if revision.Before, err = marshalConfigSnapshot(before); err != nil {
+		return err
+	}
+	if revision.After, err = marshalConfigSnapshot(after); err != nil {
+		return err
+	}
+	if l.dryRunRevisions != nil {
+		*l.dryRunRevisions = append(*l.dryRunRevisions, *revision)
+		return nil
+	}
+	if err := l.revisionDAO.Create(ctx, tx, revision); err != nil {
+		return err
+	}
+	if err := l.recordConfigEvent(ctx, tx, before, after); err != nil {
+		return err
+	}
+	var aliases []string
+	for _, cfg := range []*model.Config{before, after} {
+		if cfg != nil {
+			aliases = append(aliases, cfg.Alias)
+		}
+	}
+	return l.recordRuntimeChange(ctx, tx, ref.EnvironmentKey, ref.PipelineKey, aliases...)
+}
+
+// ListConfigRevisions returns the change history of a config, newest first.
+func (l *Logic) ListConfigRevisions(ctx context.Context, environmentKey, pipelineKey, resourceKey string, page, pageSize int) ([]model.ConfigRevision, int64, error) {
+	return l.revisionDAO.ListByResource(ctx, l.db, environmentKey, pipelineKey, resourceKey, page, pageSize)
+}
+
+// RollbackConfig restores a config to the state recorded by the given revision.
+// The state after the revision is restored; for delete revisions the deleted
+// content is re-created. The restore goes through the regular create/update
+// validation and is itself recorded as a rollback revision.
+func (l *Logic) RollbackConfig(ctx context.Context, revisionID uint) (*model.Config, error) {
+	target, current, err := l.rollbackTarget(ctx, revisionID)
+	if err != nil {
+		return nil, err
+	}
+	if current != nil {
+		err = l.updateConfig(ctx, target, model.ConfigRevisionActionRollback, 0)
+	} else {
+		err = l.addConfig(ctx, target, model.ConfigRevisionActionRollback)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return l.configDAO.GetByResourceKey(ctx, l.db, target.EnvironmentKey, target.PipelineKey, target.ResourceKey)
+}
+
+// rollbackTarget returns the config a revision restores and the current
+// config it replaces, nil when the config no longer exists. It fails with
+// ErrRevisionNotFound when the restored state is hidden from the caller and
+// with ErrResourceNotFound when the current config is.
+func (l *Logic) rollbackTarget(ctx context.Context, revisionID uint) (*model.Config, *model.Config, error) {
+	revision, err := l.revisionDAO.GetByID(ctx, l.db, revisionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, ErrRevisionNotFound
+		}
+		return nil, nil, err
+	}
+
+	snapshot := revision.After
+	if snapshot == "" {
+		snapshot = revision.Before
+	}
+	target, err := unmarshalConfigSnapshot(snapshot)
+	if err != nil {
+		return nil, nil, err
+	}
+	visibility := configVisibilityFor(ctx)
+	if target == nil || !visibility.visible(target) {
+		return nil, nil, ErrRevisionNotFound
+	}
+	target.ID = 0
+	target.CreatedAt = time.Time{}
+	target.UpdatedAt = time.Time{}
+	target.EnvironmentKey = revision.EnvironmentKey
+	target.PipelineKey = revision.PipelineKey
+	target.ResourceKey = revision.ResourceKey
+
+	current, err := l.configDAO.GetByResourceKey(ctx, l.db, target.EnvironmentKey, target.PipelineKey, target.ResourceKey)
+	switch {
+	case err == nil:
+		if err := visibility.check(current); err != nil {
+			return nil, nil, err
+		}
+		return target, current, nil
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return target, nil, nil
+	default:
+		return nil, nil, err
+	}
+}
+
+func marshalConfigSnapshot(cfg *model.Config) (string, error) {
+	if cfg == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func unmarshalConfigSnapshot(snapshot string) (*model.Config, error) {
+	if snapshot == "" {
+		return nil, nil
+	}
+	var cfg model.Config
+	if err := json.Unmarshal([]byte(snapshot), &cfg); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
@@ -0,0 +1,350 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"strings"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"github.com/yi-nology/rainbow_bridge/pkg/common"
+
+	"gorm.io/gorm"
+)
+
+// RolloutInput describes a new rollout. The target config is identified by
+// ResourceKey, or by Alias when the alias has a single (or a default) variant.
+type RolloutInput struct {
+	EnvironmentKey   string `json:"environment_key"`
+	PipelineKey      string `json:"pipeline_key"`
+	Alias            string `json:"alias,omitempty"`
+	ResourceKey      string `json:"resource_key,omitempty"`
+	CandidateContent string `json:"candidate_content"`
+	Percentage       int    `json:"percentage"`
+	BucketHeader     string `json:"bucket_header,omitempty"`
+}
+
+// --------------------- Config Rollout Operations ---------------------
+
+// CreateRollout starts exposing a candidate content of a config to a percentage of clients.
+func (l *Logic) CreateRollout(ctx context.Context, input *RolloutInput) (*model.ConfigRollout, error) {
+	if input == nil {
+		return nil, errors.New("rollout payload required")
+	}
+	if err := validateRolloutPercentage(input.Percentage); err != nil {
+		return nil, err
+	}
+	target, err := l.resolveRolloutTarget(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	overrides, err := l.checkEnvironmentWritable(ctx, "rollout.create", target.EnvironmentKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := l.rolloutDAO.GetOpenByResourceKey(ctx, l.db, target.EnvironmentKey, target.PipelineKey, target.ResourceKey); err == nil {
+		return nil, ErrRolloutExists
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	// 候选值与原配置使用相同的类型校验与规范化
+	candidate := *target
+	candidate.Content = input.CandidateContent
+	normalizeConfigPayload(&candidate)
+	if err := l.validateConfigContent(ctx, &candidate); err != nil {
+		return nil, err
+	}
+	if err := l.checkConfigReferences(ctx, &candidate); err != nil {
+		return nil, err
+	}
+
+	bucketHeader := strings.TrimSpace(input.BucketHeader)
+	if bucketHeader == "" {
+		bucketHeader = model.DefaultRolloutBucketHeader
+	}
+	rollout := &model.ConfigRollout{
+		EnvironmentKey:   target.EnvironmentKey,
+		PipelineKey:      target.PipelineKey,
+		ResourceKey:      target.ResourceKey,
+		Alias:            target.Alias,
+		CandidateContent: candidate.Content,
+		Percentage:       input.Percentage,
+		BucketHeader:     bucketHeader,
+		BucketSalt:       target.ResourceKey,
+		Status:           model.ConfigRolloutStatusRunning,
+		OperatorName:     common.GetUsername(ctx),
+	}
+	err = l.transactWithOverrides(ctx, overrides, func(tx *gorm.DB) error {
+		if err := l.rolloutDAO.Create(ctx, tx, rollout); err != nil {
+			return err
+		}
+		return l.recordRuntimeChange(ctx, tx, rollout.EnvironmentKey, rollout.PipelineKey, rollout.Alias)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := l.recordRolloutPlan(ctx, target, rollout); err != nil {
+		return nil, err
+	}
+
+	l.announceRuntimeChange(ctx, rollout.EnvironmentKey, rollout.PipelineKey)
+	return rollout, nil
+}
+
+// RampRollout changes the percentage of a rollout; a paused rollout resumes running.
+func (l *Logic) RampRollout(ctx context.Context, id uint, percentage int) (*model.ConfigRollout, error) {
+	if err := validateRolloutPercentage(percentage); err != nil {
+		return nil, err
+	}
+	return l.transitionRollout(ctx, id, "rollout.ramp", func(rollout *model.ConfigRollout) error {
+		if rollout.Status != model.ConfigRolloutStatusRunning && rollout.Status != model.ConfigRolloutStatusPaused {
+			return ErrRolloutNotOpen
+		}
+		rollout.Percentage = percentage
+		rollout.Status = model.ConfigRolloutStatusRunning
+		return l.recordRolloutPlan(ctx, nil, rollout)
+	})
+}
+
+// PauseRollout serves the baseline to everyone while keeping the configured percentage.
+func (l *Logic) PauseRollout(ctx context.Context, id uint) (*model.ConfigRollout, error) {
+	return l.transitionRollout(ctx, id, "rollout.pause", func(rollout *model.ConfigRollout) error {
+		if rollout.Status != model.ConfigRolloutStatusRunning {
+			return ErrRolloutNotOpen
+		}
+		rollout.Status = model.ConfigRolloutStatusPaused
+		return nil
+	})
+}
+
+// AbortRollout discards the candidate; everyone gets the baseline again.
+func (l *Logic) AbortRollout(ctx context.Context, id uint) (*model.ConfigRollout, error) {
+	return l.transitionRollout(ctx, id, "rollout.abort", func(rollout *model.ConfigRollout) error {
+		if rollout.Status != model.ConfigRolloutStatusRunning && rollout.Status != model.ConfigRolloutStatusPaused {
+			return ErrRolloutNotOpen
+		}
+		rollout.Status = model.ConfigRolloutStatusAborted
+		return nil
+	})
+}
+
+// PromoteRollout writes the candidate into the config itself. When the
+// environment/pipeline serves a published release the candidate keeps being
+// served to everyone until the next release is published.
+func (l *Logic) PromoteRollout(ctx context.Context, id uint) (*model.ConfigRollout, error) {
+	rollout, err := l.getRollout(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if rollout.Status != model.ConfigRolloutStatusRunning && rollout.Status != model.ConfigRolloutStatusPaused {
+		return nil, ErrRolloutNotOpen
+	}
+	// 晋升会写入配置，同一请求内只检查并记录一次冻结窗口的强制变更
+	ctx = withFreezeScope(ctx)
+	if err := l.precheckEnvironmentWritable(ctx, "rollout.promote", rollout.EnvironmentKey); err != nil {
+		return nil, err
+	}
+
+	target, err := l.configDAO.GetByResourceKey(ctx, l.db, rollout.EnvironmentKey, rollout.PipelineKey, rollout.ResourceKey)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrResourceNotFound
+		}
+		return nil, err
+	}
+	target.Content = rollout.CandidateContent
+	if err := l.updateConfig(ctx, target, model.ConfigRevisionActionPromote, 0); err != nil {
+		return nil, err
+	}
+
+	rollout.Percentage = 100
+	rollout.Status = model.ConfigRolloutStatusCompleted
+	if _, err := l.releaseDAO.GetActive(ctx, l.db, rollout.EnvironmentKey, rollout.PipelineKey); err == nil {
+		rollout.Status = model.ConfigRolloutStatusPromoted
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	err = l.db.Transaction(func(tx *gorm.DB) error {
+		if err := l.rolloutDAO.Save(ctx, tx, rollout); err != nil {
+			return err
+		}
+		return l.recordRuntimeChange(ctx, tx, rollout.EnvironmentKey, rollout.PipelineKey, rollout.Alias)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	l.announceRuntimeChange(ctx, rollout.EnvironmentKey, rollout.PipelineKey)
+	return rollout, nil
+}
+
+// ListRollouts returns the rollouts of an environment/pipeline, newest first.
+// Rollouts of configs hidden from the caller are left out.
+func (l *Logic) ListRollouts(ctx context.Context, environmentKey, pipelineKey, status string) ([]model.ConfigRollout, error) {
+	rollouts, err := l.rolloutDAO.List(ctx, l.db, environmentKey, pipelineKey, status)
+	if err != nil || len(rollouts) == 0 || configVisibilityFor(ctx).perm {
+		return rollouts, err
+	}
+	// 候选内容属于被灰度的配置，对调用方隐藏的配置的灰度一并隐藏
+	configs, err := l.configDAO.ListByEnvironmentAndPipeline(ctx, l.db, environmentKey, pipelineKey, "", 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	hidden := make(map[string]bool)
+	for i := range configs {
+		if configs[i].IsPerm {
+			hidden[configs[i].ResourceKey] = true
+		}
+	}
+	visible := make([]model.ConfigRollout, 0, len(rollouts))
+	for i := range rollouts {
+		if !hidden[rollouts[i].ResourceKey] {
+			visible = append(visible, rollouts[i])
+		}
+	}
+	return visible, nil
+}
+
+// applyRollouts replaces the content of configs under an open rollout with the
+// candidate for clients whose bucket falls within the rollout percentage.
+// Clients without a bucketing value always get the baseline.
+func applyRollouts(ctx context.Context, rollouts []model.ConfigRollout, configs []model.Config) []model.Config {
+	if len(rollouts) == 0 {
+		return configs
+	}
+
+	byResource := make(map[string]*model.ConfigRollout, len(rollouts))
+	for i := range rollouts {
+		byResource[rollouts[i].ResourceKey] = &rollouts[i]
+	}
+	for i := range configs {
+		rollout, ok := byResource[configs[i].ResourceKey]
+		if !ok {
+			continue
+		}
+		if rolloutServesCandidate(rollout, common.GetRequestHeader(ctx, rollout.BucketHeader)) {
+			configs[i].Content = rollout.CandidateContent
+		}
+	}
+	return configs
+}
+
+func (l *Logic) getRollout(ctx context.Context, id uint) (*model.ConfigRollout, error) {
+	rollout, err := l.rolloutDAO.GetByID(ctx, l.db, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrRolloutNotFound
+		}
+		return nil, err
+	}
+	return rollout, nil
+}
+
+func (l *Logic) transitionRollout(ctx context.Context, id uint, operation string, apply func(*model.ConfigRollout) error) (*model.ConfigRollout, error) {
+	rollout, err := l.getRollout(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	overrides, err := l.checkEnvironmentWritable(ctx, operation, rollout.EnvironmentKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := apply(rollout); err != nil {
+		return nil, err
+	}
+	err = l.transactWithOverrides(ctx, overrides, func(tx *gorm.DB) error {
+		if err := l.rolloutDAO.Save(ctx, tx, rollout); err != nil {
+			return err
+		}
+		return l.recordRuntimeChange(ctx, tx, rollout.EnvironmentKey, rollout.PipelineKey, rollout.Alias)
+	})
+	if err != nil {
+		return nil, err
+	}
+	l.announceRuntimeChange(ctx, rollout.EnvironmentKey, rollout.PipelineKey)
+	return rollout, nil
+}
+
+// recordRolloutPlan records the candidate a rollout exposes as a change of its
+// config during dry runs, so that change requests show it for review. target
+// is the config of the rollout, loaded when nil.
+func (l *Logic) recordRolloutPlan(ctx context.Context, target *model.Config, rollout *model.ConfigRollout) error {
+	if l.dryRunRevisions == nil {
+		return nil
+	}
+	if target == nil {
+		var err error
+		target, err = l.configDAO.GetByResourceKey(ctx, l.db, rollout.EnvironmentKey, rollout.PipelineKey, rollout.ResourceKey)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrResourceNotFound
+			}
+			return err
+		}
+	}
+	candidate := *target
+	candidate.Content = rollout.CandidateContent
+	return l.recordConfigRevision(ctx, l.db, model.ConfigRevisionActionRollout, target, &candidate)
+}
+
+func (l *Logic) resolveRolloutTarget(ctx context.Context, input *RolloutInput) (*model.Config, error) {
+	if input.ResourceKey != "" {
+		cfg, err := l.configDAO.GetByResourceKey(ctx, l.db, input.EnvironmentKey, input.PipelineKey, input.ResourceKey)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, ErrResourceNotFound
+			}
+			return nil, err
+		}
+		return cfg, nil
+	}
+
+	variants, err := l.configDAO.ListByAlias(ctx, l.db, input.EnvironmentKey, input.PipelineKey, strings.TrimSpace(input.Alias))
+	if err != nil {
+		return nil, err
+	}
+	switch len(variants) {
+	case 0:
+		return nil, ErrResourceNotFound
+	case 1:
+		return &variants[0], nil
+	}
+	for i := range variants {
+		if variants[i].IsDefaultVariant() {
+			return &variants[i], nil
+		}
+	}
+	return nil, ErrRolloutAmbiguousAlias
+}
+
+func validateRolloutPercentage(percentage int) error {
+	if percentage < 0 || percentage > 100 {
+		return ErrRolloutInvalidPercentage
+	}
+	return nil
+}
+
+// rolloutServesCandidate decides deterministically whether a client gets the candidate.
+func rolloutServesCandidate(rollout *model.ConfigRollout, bucketValue string) bool {
+	switch rollout.Status {
+	case model.ConfigRolloutStatusPromoted:
+		return true
+	case model.ConfigRolloutStatusRunning:
+		if bucketValue == "" {
+			return false
+		}
+		return rolloutBucket(rollout.BucketSalt, bucketValue) < rollout.Percentage
+	default:
+		return false
+	}
+}
+
+// rolloutBucket maps a bucketing value to a stable bucket in [0, 100). Buckets
+// below the percentage get the candidate, so ramping up only ever adds clients.
+func rolloutBucket(salt, value string) int {
+	sum := sha256.Sum256([]byte(salt + ":" + value))
+	return int(binary.BigEndian.Uint32(sum[:4]) % 100)
+}
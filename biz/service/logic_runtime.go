@@ -0,0 +1,430 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"github.com/yi-nology/rainbow_bridge/pkg/common"
+	"github.com/yi-nology/rainbow_bridge/pkg/redis"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// runtimeChangeLogSize is the number of changes kept for watching clients.
+	runtimeChangeLogSize = 10000
+	// maxRuntimeWatchChanges caps the changes reported individually by a
+	// watch; more are reported as a full change.
+	maxRuntimeWatchChanges = 1000
+)
+
+// RuntimeValidator identifies the runtime configs served to a client for
+// conditional requests: ETag changes whenever they may differ, LastModified is
+// the last time they may have changed. Vary lists the request headers of
+// running rollouts the configs further depend on.
+type RuntimeValidator struct {
+	ETag         string
+	LastModified time.Time
+	Vary         []string
+}
+
+// runtimeSchedule lists what changes the runtime configs of a pipeline
+// without a recorded change. It only depends on the change counters and is
+// cached per state of them.
+type runtimeSchedule struct {
+	// Boundaries are the activation and expiry times of scheduled configs and
+	// the expiry times of alias redirects.
+	Boundaries []time.Time `json:"boundaries"`
+	// BucketHeaders are the request headers running rollouts bucket clients by.
+	BucketHeaders []string `json:"bucket_headers"`
+}
+
+// runtimeScheduleCache keeps the latest runtime schedule of each pipeline in
+// process, so conditional requests do not reload the runtime configs when
+// Redis is not configured. An entry is only used at the change counters it
+// was loaded at.
+type runtimeScheduleCache struct {
+	mu        sync.Mutex
+	schedules map[[2]string]cachedRuntimeSchedule
+}
+
+type cachedRuntimeSchedule struct {
+	counter     int64
+	baseCounter int64
+	schedule    *runtimeSchedule
+}
+
+func newRuntimeScheduleCache() *runtimeScheduleCache {
+	return &runtimeScheduleCache{schedules: make(map[[2]string]cachedRuntimeSchedule)}
+}
+
+// get returns the schedule of a pipeline at the given counters, or nil.
+func (c *runtimeScheduleCache) get(environmentKey, pipelineKey string, counter, baseCounter int64) *runtimeSchedule {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cached, ok := c.schedules[[2]string{environmentKey, pipelineKey}]
+	if !ok || cached.counter != counter || cached.baseCounter != baseCounter {
+		return nil
+	}
+	return cached.schedule
+}
+
+// put replaces the schedule kept for a pipeline.
+func (c *runtimeScheduleCache) put(environmentKey, pipelineKey string, counter, baseCounter int64, schedule *runtimeSchedule) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.schedules[[2]string{environmentKey, pipelineKey}] = cachedRuntimeSchedule{counter: counter, baseCounter: baseCounter, schedule: schedule}
+}
+
+// RuntimeChanges describes how the runtime configs of an environment/pipeline
+// changed after a revision. Full means every config may have changed, in which
+// case Aliases is empty.
+type RuntimeChanges struct {
+	Revision int64
+	Changed  bool
+	Aliases  []string
+	Full     bool
+}
+
+// --------------------- Runtime Change Operations ---------------------
+
+// recordRuntimeChange records a change that may alter the runtime configs of
+// an environment/pipeline within the transaction of the write; for
+// BasePipelineKey of every pipeline of the environment. The change covers the
+// given aliases, or every config when none is given. Its change counter is
+// bumped and the change logged along with the write, so the ETag of the
+// runtime configs and the revision watched by clients change exactly when the
+// write is committed. Dry runs record nothing. Once committed the change is
+// announced with announceRuntimeChange.
+func (l *Logic) recordRuntimeChange(ctx context.Context, tx *gorm.DB, environmentKey, pipelineKey string, aliases ...string) error {
+	if l.dryRunRevisions != nil {
+		return nil
+	}
+	if err := l.runtimeDAO.Bump(ctx, tx, environmentKey, pipelineKey, time.Now()); err != nil {
+		return err
+	}
+	return l.runtimeDAO.AppendLog(ctx, tx, runtimeChangeEntries(environmentKey, pipelineKey, aliases))
+}
+
+// recordAllRuntimeChanges records a change of every config of every
+// environment/pipeline within the transaction of the write, e.g. when all
+// configs are replaced; see recordRuntimeChange.
+func (l *Logic) recordAllRuntimeChanges(ctx context.Context, tx *gorm.DB) error {
+	if l.dryRunRevisions != nil {
+		return nil
+	}
+	if err := l.runtimeDAO.BumpAll(ctx, tx, time.Now()); err != nil {
+		return err
+	}
+	scopes, err := l.runtimeDAO.ListScopes(ctx, tx)
+	if err != nil {
+		return err
+	}
+	// 环境基础配置的变更覆盖该环境下所有渠道
+	var entries []model.RuntimeChangeLog
+	seen := make(map[string]bool)
+	for i := range scopes {
+		if !seen[scopes[i].EnvironmentKey] {
+			seen[scopes[i].EnvironmentKey] = true
+			entries = append(entries, runtimeChangeEntries(scopes[i].EnvironmentKey, model.BasePipelineKey, nil)...)
+		}
+	}
+	return l.runtimeDAO.AppendLog(ctx, tx, entries)
+}
+
+// announceRuntimeChange wakes up the clients watching a committed change
+// recorded with recordRuntimeChange and queues a refresh of the runtime
+// snapshots it covers. Within a batch the change is announced once the batch
+// is committed; dry runs announce nothing.
+func (l *Logic) announceRuntimeChange(ctx context.Context, environmentKey, pipelineKey string) {
+	if l.dryRunRevisions != nil {
+		return
+	}
+	entries := []model.RuntimeChangeLog{{EnvironmentKey: environmentKey, PipelineKey: pipelineKey}}
+	if l.runtimeChanges != nil {
+		*l.runtimeChanges = append(*l.runtimeChanges, entries...)
+		return
+	}
+	l.announceRuntimeChanges(ctx, entries)
+}
+
+// announceAllRuntimeChanges announces a change recorded with
+// recordAllRuntimeChanges.
+func (l *Logic) announceAllRuntimeChanges(ctx context.Context) {
+	scopes, err := l.runtimeDAO.ListScopes(ctx, l.db)
+	if err != nil {
+		fmt.Printf("Failed to publish runtime changes: %v\n", err)
+	}
+	// 环境基础配置的通知唤醒该环境下所有渠道
+	var entries []model.RuntimeChangeLog
+	for i := range scopes {
+		entries = append(entries, model.RuntimeChangeLog{EnvironmentKey: scopes[i].EnvironmentKey, PipelineKey: model.BasePipelineKey})
+	}
+	l.publishRuntimeChanges(ctx, entries)
+	l.pruneChangeLogs(ctx)
+	l.refreshAllRuntimeSnapshots(ctx)
+}
+
+// announceRuntimeChanges announces committed changes and queues a refresh of
+// the runtime snapshots they cover. Failures are only logged, like cache
+// errors.
+func (l *Logic) announceRuntimeChanges(ctx context.Context, entries []model.RuntimeChangeLog) {
+	if len(entries) == 0 {
+		return
+	}
+	l.publishRuntimeChanges(ctx, entries)
+	l.pruneChangeLogs(ctx)
+	l.refreshRuntimeSnapshots(ctx, entries)
+}
+
+func (l *Logic) publishRuntimeChanges(ctx context.Context, entries []model.RuntimeChangeLog) {
+	published := make(map[string]bool)
+	for i := range entries {
+		topic := runtimeChangeTopic(entries[i].EnvironmentKey, entries[i].PipelineKey)
+		if published[topic] {
+			continue
+		}
+		published[topic] = true
+		if err := l.notifier.Publish(ctx, topic); err != nil {
+			fmt.Printf("Failed to publish runtime change: %v\n", err)
+		}
+	}
+}
+
+// pruneChangeLogs keeps the runtime change log and the change events within
+// their size once changes are committed.
+func (l *Logic) pruneChangeLogs(ctx context.Context) {
+	if err := l.runtimeDAO.PruneLog(ctx, l.db, runtimeChangeLogSize); err != nil {
+		fmt.Printf("Failed to prune runtime change log: %v\n", err)
+	}
+	l.pruneChangeEvents(ctx)
+}
+
+// runtimeChangeEntries returns the log entries of a change: one per alias, or
+// a single entry covering every config when no alias is given.
+func runtimeChangeEntries(environmentKey, pipelineKey string, aliases []string) []model.RuntimeChangeLog {
+	now := time.Now()
+	var entries []model.RuntimeChangeLog
+	seen := make(map[string]bool)
+	for _, alias := range aliases {
+		if alias == "" || seen[alias] {
+			continue
+		}
+		seen[alias] = true
+		entries = append(entries, model.RuntimeChangeLog{EnvironmentKey: environmentKey, PipelineKey: pipelineKey, Alias: alias, CreatedAt: now})
+	}
+	if len(entries) == 0 {
+		entries = append(entries, model.RuntimeChangeLog{EnvironmentKey: environmentKey, PipelineKey: pipelineKey, CreatedAt: now})
+	}
+	return entries
+}
+
+// runtimeScopes returns the pipelines whose changes alter the runtime configs
+// of pipelineKey: the pipeline itself and the environment base.
+func runtimeScopes(pipelineKey string) []string {
+	if pipelineKey == model.BasePipelineKey {
+		return []string{model.BasePipelineKey}
+	}
+	return []string{pipelineKey, model.BasePipelineKey}
+}
+
+// runtimeChangeTopic returns the notifier topic announcing the changes of an
+// environment/pipeline.
+func runtimeChangeTopic(environmentKey, pipelineKey string) string {
+	return environmentKey + ":" + pipelineKey
+}
+
+func runtimeChangeTopics(environmentKey string, pipelineKeys []string) []string {
+	topics := make([]string, len(pipelineKeys))
+	for i, pipelineKey := range pipelineKeys {
+		topics[i] = runtimeChangeTopic(environmentKey, pipelineKey)
+	}
+	return topics
+}
+
+// RuntimeRevision returns the revision of the last change of the runtime
+// configs of an environment/pipeline, or 0 when none was recorded.
+func (l *Logic) RuntimeRevision(ctx context.Context, environmentKey, pipelineKey string) (int64, error) {
+	return l.runtimeDAO.LatestRevision(ctx, l.db, environmentKey, runtimeScopes(pipelineKey))
+}
+
+// WatchRuntimeChanges waits until the runtime configs of an environment/pipeline
+// change after revision or timeout elapses, and returns the changes after
+// revision. A revision ahead of the latest one is answered at once with a full
+// change, as are changes older than the kept change log.
+func (l *Logic) WatchRuntimeChanges(ctx context.Context, environmentKey, pipelineKey string, revision int64, timeout time.Duration) (*RuntimeChanges, error) {
+	scopes := runtimeScopes(pipelineKey)
+	// 先订阅再查询，避免错过两者之间的变更
+	wake, cancel := l.notifier.Subscribe(runtimeChangeTopics(environmentKey, scopes)...)
+	defer cancel()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		changes, err := l.runtimeChangesSince(ctx, environmentKey, scopes, revision)
+		if err != nil || changes.Changed {
+			return changes, err
+		}
+		select {
+		case <-wake:
+		case <-timer.C:
+			return changes, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func (l *Logic) runtimeChangesSince(ctx context.Context, environmentKey string, scopes []string, revision int64) (*RuntimeChanges, error) {
+	latest, err := l.runtimeDAO.LatestRevision(ctx, l.db, environmentKey, scopes)
+	if err != nil {
+		return nil, err
+	}
+	changes := &RuntimeChanges{Revision: latest, Aliases: []string{}}
+	if revision == latest {
+		return changes, nil
+	}
+	changes.Changed = true
+	if revision > latest {
+		changes.Full = true
+		return changes, nil
+	}
+	oldest, err := l.runtimeDAO.OldestRevision(ctx, l.db)
+	if err != nil {
+		return nil, err
+	}
+	if revision < oldest-1 {
+		// 更早的变更已从日志中清除
+		changes.Full = true
+		return changes, nil
+	}
+
+	entries, err := l.runtimeDAO.ListLogAfter(ctx, l.db, environmentKey, scopes, revision, maxRuntimeWatchChanges)
+	if err != nil {
+		return nil, err
+	}
+	changes.Full = len(entries) == maxRuntimeWatchChanges
+	seen := make(map[string]bool)
+	for i := range entries {
+		if entries[i].Alias == "" {
+			changes.Full = true
+		}
+		if entries[i].Alias != "" && !seen[entries[i].Alias] {
+			seen[entries[i].Alias] = true
+			changes.Aliases = append(changes.Aliases, entries[i].Alias)
+		}
+	}
+	if changes.Full {
+		changes.Aliases = []string{}
+	}
+	sort.Strings(changes.Aliases)
+	return changes, nil
+}
+
+// RuntimeConfigValidator returns the validator of the runtime configs
+// ListRuntimeConfigs serves for ctx without loading them. The ETag covers the
+// change counters of the pipeline and of the environment base, the last
+// schedule boundary passed, and the request properties configs are selected
+// by: config visibility, client version, preferred locales and rollout bucket
+// headers.
+func (l *Logic) RuntimeConfigValidator(ctx context.Context, environmentKey, pipelineKey string) (*RuntimeValidator, error) {
+	now := time.Now()
+	own, err := l.runtimeDAO.Get(ctx, l.db, environmentKey, pipelineKey, now)
+	if err != nil {
+		return nil, err
+	}
+	base, err := l.runtimeDAO.Get(ctx, l.db, environmentKey, model.BasePipelineKey, now)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := l.loadRuntimeSchedule(ctx, environmentKey, pipelineKey, own.Counter, base.Counter)
+	if err != nil {
+		return nil, err
+	}
+
+	lastModified := own.ChangedAt
+	if base.ChangedAt.After(lastModified) {
+		lastModified = base.ChangedAt
+	}
+	for _, boundary := range schedule.Boundaries {
+		if !boundary.After(now) && boundary.After(lastModified) {
+			lastModified = boundary
+		}
+	}
+
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s\x00%s\x00%d\x00%d\x00%d\x00%s\x00%s\x00%s",
+		environmentKey, pipelineKey, own.Counter, base.Counter, lastModified.UnixNano(),
+		configVisibilityFor(ctx).cacheScope(), common.GetClientVersion(ctx), strings.Join(common.GetLocales(ctx), ","))
+	for _, header := range schedule.BucketHeaders {
+		fmt.Fprintf(hash, "\x00%s=%s", header, common.GetRequestHeader(ctx, header))
+	}
+	return &RuntimeValidator{
+		ETag:         `"` + hex.EncodeToString(hash.Sum(nil)[:16]) + `"`,
+		LastModified: lastModified.UTC().Truncate(time.Second),
+		Vary:         schedule.BucketHeaders,
+	}, nil
+}
+
+// loadRuntimeSchedule returns the runtime schedule of a pipeline at the given
+// state of its change counters.
+func (l *Logic) loadRuntimeSchedule(ctx context.Context, environmentKey, pipelineKey string, counter, baseCounter int64) (*runtimeSchedule, error) {
+	// 按计数器状态缓存，计数器变化后自然失效
+	if schedule := l.schedules.get(environmentKey, pipelineKey, counter, baseCounter); schedule != nil {
+		return schedule, nil
+	}
+	cacheKey := redis.GenerateRuntimeScheduleKey(environmentKey, pipelineKey, counter, baseCounter)
+	var cached runtimeSchedule
+	found, err := redis.Get(ctx, l.redisClient, cacheKey, &cached)
+	if err == nil && found {
+		l.schedules.put(environmentKey, pipelineKey, counter, baseCounter, &cached)
+		return &cached, nil
+	}
+
+	source, err := l.loadRuntimeSourceAt(ctx, environmentKey, pipelineKey, counter, baseCounter)
+	if err != nil {
+		return nil, err
+	}
+	schedule := &runtimeSchedule{Boundaries: []time.Time{}, BucketHeaders: []string{}}
+	for i := range source.Configs {
+		for _, bound := range []*time.Time{source.Configs[i].EffectiveAt, source.Configs[i].ExpiresAt} {
+			if bound != nil {
+				schedule.Boundaries = append(schedule.Boundaries, *bound)
+			}
+		}
+	}
+	headers := make(map[string]bool)
+	for i := range source.Rollouts {
+		rollout := &source.Rollouts[i]
+		if rollout.Status == model.ConfigRolloutStatusRunning && !headers[rollout.BucketHeader] {
+			headers[rollout.BucketHeader] = true
+			schedule.BucketHeaders = append(schedule.BucketHeaders, rollout.BucketHeader)
+		}
+	}
+	sort.Strings(schedule.BucketHeaders)
+
+	for _, scope := range runtimeScopes(pipelineKey) {
+		// 已到期的重定向同样计入，其到期时间仍是运行时配置变化的时间点
+		redirects, err := l.redirectDAO.ListByPipeline(ctx, l.db, environmentKey, scope)
+		if err != nil {
+			return nil, err
+		}
+		for i := range redirects {
+			schedule.Boundaries = append(schedule.Boundaries, redirects[i].ExpiresAt)
+		}
+	}
+
+	if err := redis.Set(ctx, l.redisClient, cacheKey, schedule, 30*time.Minute); err != nil {
+		// 缓存错误不影响主流程，只记录错误
+		fmt.Printf("Failed to cache runtime schedule: %v\n", err)
+	}
+	l.schedules.put(environmentKey, pipelineKey, counter, baseCounter, schedule)
+	return schedule, nil
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/db"
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"github.com/yi-nology/rainbow_bridge/biz/model/common"
+	envpb "github.com/yi-nology/rainbow_bridge/biz/model/environment"
+	pkgcommon "github.com/yi-nology/rainbow_bridge/pkg/common"
+	"github.com/yi-nology/rainbow_bridge/pkg/config"
+)
+
+// TestRuntimeScheduleCachedInProcess checks that without Redis the runtime
+// schedule is loaded once per state of the change counters.
+func TestRuntimeScheduleCachedInProcess(t *testing.T) {
+	gdb := db.SetupTestDB(t)
+	defer db.CleanupTestDB(t, gdb)
+	s := NewService(gdb, nil, "", &config.Config{})
+
+	user := pkgcommon.ContextWithUserID(context.Background(), 1)
+	if err := s.AddEnvironment(user, &envpb.Environment{EnvironmentKey: "prod", EnvironmentName: "Prod", IsActive: true}); err != nil {
+		t.Fatalf("AddEnvironment failed: %v", err)
+	}
+	if _, err := s.AddConfig(user, &common.ResourceConfig{
+		EnvironmentKey: "prod", PipelineKey: "default", Name: "Greeting", Alias: "greeting", Type: "text", Content: "hello",
+	}); err != nil {
+		t.Fatalf("AddConfig failed: %v", err)
+	}
+	counters := func() (int64, int64) {
+		t.Helper()
+		now := time.Now()
+		own, err := s.logic.runtimeDAO.Get(user, gdb, "prod", "default", now)
+		if err != nil {
+			t.Fatalf("get counter: %v", err)
+		}
+		base, err := s.logic.runtimeDAO.Get(user, gdb, "prod", model.BasePipelineKey, now)
+		if err != nil {
+			t.Fatalf("get base counter: %v", err)
+		}
+		return own.Counter, base.Counter
+	}
+
+	if _, err := s.logic.RuntimeConfigValidator(user, "prod", "default"); err != nil {
+		t.Fatalf("RuntimeConfigValidator failed: %v", err)
+	}
+	counter, baseCounter := counters()
+	cached := s.logic.schedules.get("prod", "default", counter, baseCounter)
+	if cached == nil {
+		t.Fatal("expected the schedule to be kept in process")
+	}
+	schedule, err := s.logic.loadRuntimeSchedule(user, "prod", "default", counter, baseCounter)
+	if err != nil || schedule != cached {
+		t.Fatalf("loadRuntimeSchedule = %p, %v; want the cached schedule %p", schedule, err, cached)
+	}
+
+	// 写入推进计数器，旧状态的日程不再使用
+	starts := time.Now().Add(time.Hour)
+	if _, err := s.AddConfig(user, &common.ResourceConfig{
+		EnvironmentKey: "prod", PipelineKey: "default", Name: "Banner", Alias: "banner", Type: "text", Content: "sale",
+		EffectiveAt: starts.Format(time.RFC3339),
+	}); err != nil {
+		t.Fatalf("AddConfig failed: %v", err)
+	}
+	if _, err := s.logic.RuntimeConfigValidator(user, "prod", "default"); err != nil {
+		t.Fatalf("RuntimeConfigValidator failed: %v", err)
+	}
+	counter, baseCounter = counters()
+	schedule = s.logic.schedules.get("prod", "default", counter, baseCounter)
+	if schedule == nil || schedule == cached || len(schedule.Boundaries) != 1 {
+		t.Fatalf("schedule after the write = %+v, want it reloaded with the new boundary", schedule)
+	}
+}
+
+// TestRuntimeChangeCountedWithWrite checks that a config write and the bump of
+// the change counters behind the runtime ETag commit or fail together.
+func TestRuntimeChangeCountedWithWrite(t *testing.T) {
+	gdb := db.SetupTestDB(t)
+	defer db.CleanupTestDB(t, gdb)
+	s := NewService(gdb, nil, "", &config.Config{})
+
+	user := pkgcommon.ContextWithUserID(context.Background(), 1)
+	if err := s.AddEnvironment(user, &envpb.Environment{EnvironmentKey: "prod", EnvironmentName: "Prod", IsActive: true}); err != nil {
+		t.Fatalf("AddEnvironment failed: %v", err)
+	}
+	greeting := &common.ResourceConfig{
+		EnvironmentKey: "prod", PipelineKey: "default", Name: "Greeting", Alias: "greeting", Type: "text", Content: "hello",
+	}
+
+	// 计数器无法递增时写入一并回滚，不会留下 ETag 不变的新配置
+	if err := gdb.Migrator().DropTable(&model.RuntimeChange{}); err != nil {
+		t.Fatalf("drop runtime_change: %v", err)
+	}
+	if _, err := s.AddConfig(user, greeting); err == nil {
+		t.Fatal("expected the write to fail when its change cannot be counted")
+	}
+	if err := gdb.AutoMigrate(&model.RuntimeChange{}); err != nil {
+		t.Fatalf("migrate runtime_change: %v", err)
+	}
+	configs, err := s.logic.ListConfigs(user, "prod", "default", "", "", "", false, false)
+	if err != nil || len(configs) != 0 {
+		t.Fatalf("ListConfigs = %v, %v; want the failed write rolled back", configs, err)
+	}
+
+	validator, err := s.logic.RuntimeConfigValidator(user, "prod", "default")
+	if err != nil {
+		t.Fatalf("RuntimeConfigValidator failed: %v", err)
+	}
+	if _, err := s.AddConfig(user, greeting); err != nil {
+		t.Fatalf("AddConfig failed: %v", err)
+	}
+	after, err := s.logic.RuntimeConfigValidator(user, "prod", "default")
+	if err != nil || after.ETag == validator.ETag {
+		t.Fatalf("ETag after the write = %v, %v; want it changed from %s", after, err, validator.ETag)
+	}
+}
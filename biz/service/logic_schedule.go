@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"github.com/yi-nology/rainbow_bridge/pkg/util"
+)
+
+// Scheduled change actions.
+const (
+	ScheduleActionActivate = "activate"
+	ScheduleActionExpire   = "expire"
+)
+
+// ScheduledChange is an upcoming activation or expiry of a config.
+type ScheduledChange struct {
+	Config model.Config
+	Action string
+	At     time.Time
+}
+
+// --------------------- Config Schedules ---------------------
+
+// ListScheduledChanges returns the activations and expiries of the working
+// configs of an environment (optionally a single pipeline) after now, up to
+// until when set, in chronological order.
+func (l *Logic) ListScheduledChanges(ctx context.Context, environmentKey, pipelineKey string, until time.Time) ([]ScheduledChange, error) {
+	now := time.Now()
+	configs, err := l.configDAO.ListScheduled(ctx, l.db, environmentKey, pipelineKey, now, until)
+	if err != nil {
+		return nil, err
+	}
+	configs = configVisibilityFor(ctx).filter(configs)
+	changes := make([]ScheduledChange, 0, len(configs))
+	inRange := func(at *time.Time) bool {
+		return at != nil && at.After(now) && (until.IsZero() || !at.After(until))
+	}
+	for i := range configs {
+		if inRange(configs[i].EffectiveAt) {
+			changes = append(changes, ScheduledChange{Config: configs[i], Action: ScheduleActionActivate, At: *configs[i].EffectiveAt})
+		}
+		if inRange(configs[i].ExpiresAt) {
+			changes = append(changes, ScheduledChange{Config: configs[i], Action: ScheduleActionExpire, At: *configs[i].ExpiresAt})
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		if !changes[i].At.Equal(changes[j].At) {
+			return changes[i].At.Before(changes[j].At)
+		}
+		return changes[i].Config.Alias < changes[j].Config.Alias
+	})
+	return changes, nil
+}
+
+// validateConfigSchedule checks the timezone and that the window is not empty.
+func validateConfigSchedule(cfg *model.Config) error {
+	if _, err := util.LoadScheduleLocation(cfg.ScheduleTimezone); err != nil {
+		return fmt.Errorf("%w: %v", ErrConfigScheduleInvalid, err)
+	}
+	if cfg.EffectiveAt != nil && cfg.ExpiresAt != nil && !cfg.ExpiresAt.After(*cfg.EffectiveAt) {
+		return fmt.Errorf("%w: expires_at must be after effective_at", ErrConfigScheduleInvalid)
+	}
+	return nil
+}
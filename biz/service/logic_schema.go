@@ -0,0 +1,279 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"github.com/yi-nology/rainbow_bridge/pkg/common"
+	"github.com/yi-nology/rainbow_bridge/pkg/util"
+
+	"gorm.io/gorm"
+)
+
+// ConfigSchemaInput describes a schema to create or update.
+type ConfigSchemaInput struct {
+	ID             uint
+	EnvironmentKey string
+	PipelineKey    string
+	AliasPattern   string
+	Schema         string
+	Description    string
+}
+
+// SchemaValidationError is returned when config content does not satisfy the
+// JSON Schema attached to its alias.
+type SchemaValidationError struct {
+	Alias        string
+	SchemaID     uint
+	AliasPattern string
+	Violations   []util.SchemaViolation
+}
+
+func (e *SchemaValidationError) Error() string {
+	parts := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		parts = append(parts, fmt.Sprintf("%s: %s", v.Path, v.Message))
+	}
+	return fmt.Sprintf("配置内容不符合别名 %s 的 JSON Schema: %s", e.AliasPattern, strings.Join(parts, "; "))
+}
+
+// ConfigSchemaFailure reports a stored config that does not satisfy its schema.
+type ConfigSchemaFailure struct {
+	Config *model.Config
+	Err    *SchemaValidationError
+}
+
+// --------------------- Config Schema Operations ---------------------
+
+// CreateConfigSchema attaches a JSON Schema to an alias or alias pattern.
+func (l *Logic) CreateConfigSchema(ctx context.Context, input *ConfigSchemaInput) (*model.ConfigSchema, error) {
+	if input == nil {
+		return nil, errors.New("schema payload required")
+	}
+	schema := &model.ConfigSchema{
+		EnvironmentKey: strings.TrimSpace(input.EnvironmentKey),
+		PipelineKey:    strings.TrimSpace(input.PipelineKey),
+		AliasPattern:   strings.TrimSpace(input.AliasPattern),
+		Schema:         strings.TrimSpace(input.Schema),
+		Description:    strings.TrimSpace(input.Description),
+		OperatorName:   common.GetUsername(ctx),
+	}
+	if err := l.validateConfigSchema(ctx, schema); err != nil {
+		return nil, err
+	}
+	if err := l.schemaDAO.Create(ctx, l.db, schema); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// UpdateConfigSchema replaces the pattern, schema and description of a schema.
+func (l *Logic) UpdateConfigSchema(ctx context.Context, input *ConfigSchemaInput) (*model.ConfigSchema, error) {
+	if input == nil {
+		return nil, errors.New("schema payload required")
+	}
+	schema, err := l.getConfigSchema(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+	schema.AliasPattern = strings.TrimSpace(input.AliasPattern)
+	schema.Schema = strings.TrimSpace(input.Schema)
+	schema.Description = strings.TrimSpace(input.Description)
+	schema.OperatorName = common.GetUsername(ctx)
+	if err := l.validateConfigSchema(ctx, schema); err != nil {
+		return nil, err
+	}
+	if err := l.schemaDAO.Save(ctx, l.db, schema); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// DeleteConfigSchema detaches a schema; configs are no longer validated against it.
+func (l *Logic) DeleteConfigSchema(ctx context.Context, id uint) error {
+	if _, err := l.getConfigSchema(ctx, id); err != nil {
+		return err
+	}
+	return l.schemaDAO.Delete(ctx, l.db, id)
+}
+
+// ListConfigSchemas returns the schemas of an environment, or of one of its
+// pipelines when pipelineKey is set.
+func (l *Logic) ListConfigSchemas(ctx context.Context, environmentKey, pipelineKey string) ([]model.ConfigSchema, error) {
+	if pipelineKey == "" {
+		return l.schemaDAO.List(ctx, l.db, environmentKey)
+	}
+	return l.schemaDAO.List(ctx, l.db, environmentKey, pipelineKey)
+}
+
+// RevalidateConfigs checks the stored configs of an environment (or of one of
+// its pipelines) against their current schemas. It returns the number of
+// configs checked and the ones failing.
+func (l *Logic) RevalidateConfigs(ctx context.Context, environmentKey, pipelineKey string) (int, []ConfigSchemaFailure, error) {
+	var (
+		configs []model.Config
+		err     error
+	)
+	if pipelineKey == "" {
+		configs, err = l.configDAO.ListByEnvironment(ctx, l.db, environmentKey)
+	} else {
+		configs, err = l.configDAO.ListByEnvironmentAndPipelineWithFilter(ctx, l.db, environmentKey, pipelineKey, "", "", "", 0, 0)
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+	schemas, err := l.schemaDAO.List(ctx, l.db, environmentKey)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	compiled := make(map[uint]*jsonschema.Schema)
+	checked := 0
+	var failures []ConfigSchemaFailure
+	for i := range configs {
+		cfg := &configs[i]
+		if !isJSONObjectConfigType(cfg.Type) {
+			continue
+		}
+		schema := matchConfigSchema(schemas, cfg.PipelineKey, cfg.Alias)
+		if schema == nil {
+			continue
+		}
+		checked++
+		if err := checkContentAgainstSchema(schema, cfg.Alias, cfg.Content, compiled); err != nil {
+			var schemaErr *SchemaValidationError
+			if !errors.As(err, &schemaErr) {
+				return 0, nil, err
+			}
+			failures = append(failures, ConfigSchemaFailure{Config: cfg, Err: schemaErr})
+		}
+	}
+	return checked, failures, nil
+}
+
+// checkConfigSchema validates JSON object content against the schema attached to the config's alias, if any.
+func (l *Logic) checkConfigSchema(ctx context.Context, cfg *model.Config) error {
+	if cfg.Alias == "" {
+		return nil
+	}
+	pipelines := []string{cfg.PipelineKey}
+	if cfg.PipelineKey != model.BasePipelineKey {
+		pipelines = append(pipelines, model.BasePipelineKey)
+	}
+	schemas, err := l.schemaDAO.List(ctx, l.db, cfg.EnvironmentKey, pipelines...)
+	if err != nil {
+		return err
+	}
+	schema := matchConfigSchema(schemas, cfg.PipelineKey, cfg.Alias)
+	if schema == nil {
+		return nil
+	}
+	return checkContentAgainstSchema(schema, cfg.Alias, cfg.Content, nil)
+}
+
+func (l *Logic) validateConfigSchema(ctx context.Context, schema *model.ConfigSchema) error {
+	if schema.EnvironmentKey == "" {
+		return ErrEnvironmentKeyRequired
+	}
+	if schema.PipelineKey == "" {
+		return ErrPipelineKeyRequired
+	}
+	if schema.AliasPattern == "" {
+		return ErrConfigSchemaPatternInvalid
+	}
+	if _, err := path.Match(schema.AliasPattern, ""); err != nil {
+		return ErrConfigSchemaPatternInvalid
+	}
+	if _, err := util.CompileJSONSchema(schema.Schema); err != nil {
+		return fmt.Errorf("%w: %v", ErrConfigSchemaInvalid, err)
+	}
+
+	existing, err := l.schemaDAO.GetByPattern(ctx, l.db, schema.EnvironmentKey, schema.PipelineKey, schema.AliasPattern)
+	if err == nil && existing.ID != schema.ID {
+		return ErrConfigSchemaExists
+	}
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	return nil
+}
+
+func (l *Logic) getConfigSchema(ctx context.Context, id uint) (*model.ConfigSchema, error) {
+	schema, err := l.schemaDAO.GetByID(ctx, l.db, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrConfigSchemaNotFound
+		}
+		return nil, err
+	}
+	return schema, nil
+}
+
+// matchConfigSchema picks the schema applying to an alias of a pipeline.
+// Schemas of the pipeline itself win over the environment base schemas; within
+// one pipeline an exact alias wins over patterns, and among patterns the
+// longest (most specific) one wins.
+func matchConfigSchema(schemas []model.ConfigSchema, pipelineKey, alias string) *model.ConfigSchema {
+	for _, scope := range []string{pipelineKey, model.BasePipelineKey} {
+		var best *model.ConfigSchema
+		for i := range schemas {
+			schema := &schemas[i]
+			if schema.PipelineKey != scope {
+				continue
+			}
+			if schema.IsExactAlias() {
+				if schema.AliasPattern == alias {
+					return schema
+				}
+				continue
+			}
+			if ok, _ := path.Match(schema.AliasPattern, alias); !ok {
+				continue
+			}
+			if best == nil || len(schema.AliasPattern) > len(best.AliasPattern) ||
+				(len(schema.AliasPattern) == len(best.AliasPattern) && schema.ID < best.ID) {
+				best = schema
+			}
+		}
+		if best != nil {
+			return best
+		}
+		if scope == model.BasePipelineKey {
+			break
+		}
+	}
+	return nil
+}
+
+// checkContentAgainstSchema validates content, reusing compiled schemas from cache when given.
+func checkContentAgainstSchema(schema *model.ConfigSchema, alias, content string, cache map[uint]*jsonschema.Schema) error {
+	compiled, ok := cache[schema.ID]
+	if !ok {
+		var err error
+		compiled, err = util.CompileJSONSchema(schema.Schema)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrConfigSchemaInvalid, err)
+		}
+		if cache != nil {
+			cache[schema.ID] = compiled
+		}
+	}
+	violations, err := util.ValidateJSONSchema(compiled, content)
+	if err != nil {
+		return err
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	return &SchemaValidationError{
+		Alias:        alias,
+		SchemaID:     schema.ID,
+		AliasPattern: schema.AliasPattern,
+		Violations:   violations,
+	}
+}
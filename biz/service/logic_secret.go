@@ -0,0 +1,384 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"github.com/yi-nology/rainbow_bridge/pkg/common"
+	"github.com/yi-nology/rainbow_bridge/pkg/redis"
+
+	"gorm.io/gorm"
+)
+
+// SecretContentMask replaces the content of secret configs in admin responses.
+// Sending it back unchanged on update keeps the stored secret.
+const SecretContentMask = "******"
+
+// SecretRotationResult counts the rows re-encrypted with the current key.
+type SecretRotationResult struct {
+	Configs        int
+	Rollouts       int
+	Releases       int
+	Revisions      int
+	ChangeRequests int
+	Snapshots      int
+}
+
+// --------------------- Secret Config Operations ---------------------
+
+// RevealSecret returns a secret config with its content decrypted.
+func (l *Logic) RevealSecret(ctx context.Context, environmentKey, pipelineKey, resourceKey string) (*model.Config, error) {
+	cfg, err := l.configDAO.GetByResourceKey(ctx, l.db, environmentKey, pipelineKey, resourceKey)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrResourceNotFound
+		}
+		return nil, err
+	}
+	if err := configVisibilityFor(ctx).check(cfg); err != nil {
+		return nil, err
+	}
+	if cfg.Type != "secret" {
+		return nil, ErrConfigNotSecret
+	}
+	if l.secretKeyring == nil {
+		return nil, ErrSecretKeyNotConfigured
+	}
+	plaintext, err := l.secretKeyring.Decrypt(cfg.Content)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Content = plaintext
+	return cfg, nil
+}
+
+// RotateSecrets re-encrypts every stored secret with the current key: configs,
+// rollout candidates, release snapshots, revision history, change requests and
+// the runtime snapshots kept for outages. The previous key must still be
+// configured so the old ciphertexts can be decrypted.
+func (l *Logic) RotateSecrets(ctx context.Context) (*SecretRotationResult, error) {
+	if l.secretKeyring == nil {
+		return nil, ErrSecretKeyNotConfigured
+	}
+	result := &SecretRotationResult{}
+	err := l.db.Transaction(func(tx *gorm.DB) error {
+		configs, err := l.configDAO.ListByType(ctx, tx, "secret")
+		if err != nil {
+			return err
+		}
+		for i := range configs {
+			rotated, changed, err := l.secretKeyring.Rotate(configs[i].Content)
+			if err != nil {
+				return fmt.Errorf("config %s: %w", configs[i].ResourceKey, err)
+			}
+			if !changed {
+				continue
+			}
+			if err := l.configDAO.UpdateContent(ctx, tx, configs[i].ID, rotated); err != nil {
+				return err
+			}
+			result.Configs++
+		}
+
+		rollouts, err := l.rolloutDAO.ListByCandidatePrefix(ctx, tx, common.SecretCiphertextPrefix)
+		if err != nil {
+			return err
+		}
+		for i := range rollouts {
+			rotated, changed, err := l.secretKeyring.Rotate(rollouts[i].CandidateContent)
+			if err != nil {
+				return fmt.Errorf("rollout %d: %w", rollouts[i].ID, err)
+			}
+			if !changed {
+				continue
+			}
+			rollouts[i].CandidateContent = rotated
+			if err := l.rolloutDAO.Save(ctx, tx, &rollouts[i]); err != nil {
+				return err
+			}
+			result.Rollouts++
+		}
+
+		releases, err := l.releaseDAO.ListBySnapshotContent(ctx, tx, common.SecretCiphertextPrefix)
+		if err != nil {
+			return err
+		}
+		for i := range releases {
+			snapshot, changed, err := l.rotateConfigSnapshot(releases[i].Snapshot, true)
+			if err != nil {
+				return fmt.Errorf("release %d: %w", releases[i].ID, err)
+			}
+			if !changed {
+				continue
+			}
+			if err := l.releaseDAO.UpdateSnapshot(ctx, tx, releases[i].ID, snapshot); err != nil {
+				return err
+			}
+			result.Releases++
+		}
+
+		revisions, err := l.revisionDAO.ListByContent(ctx, tx, common.SecretCiphertextPrefix)
+		if err != nil {
+			return err
+		}
+		for i := range revisions {
+			before, beforeChanged, err := l.rotateConfigSnapshot(revisions[i].Before, false)
+			if err != nil {
+				return fmt.Errorf("revision %d: %w", revisions[i].ID, err)
+			}
+			after, afterChanged, err := l.rotateConfigSnapshot(revisions[i].After, false)
+			if err != nil {
+				return fmt.Errorf("revision %d: %w", revisions[i].ID, err)
+			}
+			if !beforeChanged && !afterChanged {
+				continue
+			}
+			if err := l.revisionDAO.UpdateContents(ctx, tx, revisions[i].ID, before, after); err != nil {
+				return err
+			}
+			result.Revisions++
+		}
+
+		requests, err := l.changeDAO.ListByPayloadContent(ctx, tx, common.SecretCiphertextPrefix)
+		if err != nil {
+			return err
+		}
+		rotated := make(map[uint]bool, len(requests))
+		for i := range requests {
+			payload, changed, err := l.rotateChangeRequestPayload(requests[i].Payload)
+			if err != nil {
+				return fmt.Errorf("change request %d: %w", requests[i].ID, err)
+			}
+			if !changed {
+				continue
+			}
+			if err := l.changeDAO.UpdatePayload(ctx, tx, requests[i].ID, payload); err != nil {
+				return err
+			}
+			rotated[requests[i].ID] = true
+		}
+		items, err := l.changeDAO.ListItemsByContent(ctx, tx, common.SecretCiphertextPrefix)
+		if err != nil {
+			return err
+		}
+		for i := range items {
+			before, beforeChanged, err := l.rotateConfigSnapshot(items[i].Before, false)
+			if err != nil {
+				return fmt.Errorf("change request %d: %w", items[i].ChangeRequestID, err)
+			}
+			after, afterChanged, err := l.rotateConfigSnapshot(items[i].After, false)
+			if err != nil {
+				return fmt.Errorf("change request %d: %w", items[i].ChangeRequestID, err)
+			}
+			if !beforeChanged && !afterChanged {
+				continue
+			}
+			if err := l.changeDAO.UpdateItemContents(ctx, tx, items[i].ID, before, after); err != nil {
+				return err
+			}
+			rotated[items[i].ChangeRequestID] = true
+		}
+		result.ChangeRequests = len(rotated)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 快照只是缓存，个别失败不影响已提交的轮换
+	result.Snapshots = l.snapshots.rewrite(func(snapshot *runtimeSnapshot) (bool, error) {
+		return l.rotateRuntimeSource(&snapshot.runtimeSource)
+	})
+
+	// 缓存中可能仍保存旧密钥加密的内容
+	if l.redisClient != nil {
+		if err := redis.DeleteByPattern(ctx, l.redisClient, "rainbow_bridge:config:*"); err != nil {
+			fmt.Printf("Failed to clear config cache: %v\n", err)
+		}
+	}
+	return result, nil
+}
+
+// rotateConfigSnapshot re-encrypts the secret configs of a JSON encoded config
+// (list is false) or config list (list is true).
+func (l *Logic) rotateConfigSnapshot(snapshot string, list bool) (string, bool, error) {
+	if snapshot == "" {
+		return snapshot, false, nil
+	}
+	var configs []model.Config
+	if list {
+		if err := json.Unmarshal([]byte(snapshot), &configs); err != nil {
+			return "", false, err
+		}
+	} else {
+		configs = make([]model.Config, 1)
+		if err := json.Unmarshal([]byte(snapshot), &configs[0]); err != nil {
+			return "", false, err
+		}
+	}
+
+	changed := false
+	for i := range configs {
+		if configs[i].Type != "secret" {
+			continue
+		}
+		rotated, ok, err := l.secretKeyring.Rotate(configs[i].Content)
+		if err != nil {
+			return "", false, err
+		}
+		if ok {
+			configs[i].Content = rotated
+			changed = true
+		}
+	}
+	if !changed {
+		return snapshot, false, nil
+	}
+
+	var data []byte
+	var err error
+	if list {
+		data, err = json.Marshal(configs)
+	} else {
+		data, err = json.Marshal(configs[0])
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return string(data), true, nil
+}
+
+// rotateChangeRequestPayload re-encrypts the secrets of a JSON encoded change
+// request payload: its configs, batch operations and rollout candidate.
+func (l *Logic) rotateChangeRequestPayload(payload string) (string, bool, error) {
+	var decoded changeRequestPayload
+	if err := json.Unmarshal([]byte(payload), &decoded); err != nil {
+		return "", false, err
+	}
+
+	changed := false
+	rotate := func(content *string) error {
+		rotated, ok, err := l.secretKeyring.Rotate(*content)
+		if err != nil {
+			return err
+		}
+		if ok {
+			*content = rotated
+			changed = true
+		}
+		return nil
+	}
+	for i := range decoded.Configs {
+		if decoded.Configs[i].Type != "secret" {
+			continue
+		}
+		if err := rotate(&decoded.Configs[i].Content); err != nil {
+			return "", false, err
+		}
+	}
+	for i := range decoded.Operations {
+		if decoded.Operations[i].Config.Type != "secret" {
+			continue
+		}
+		if err := rotate(&decoded.Operations[i].Config.Content); err != nil {
+			return "", false, err
+		}
+	}
+	if decoded.Rollout != nil && decoded.Rollout.Input != nil {
+		if err := rotate(&decoded.Rollout.Input.CandidateContent); err != nil {
+			return "", false, err
+		}
+	}
+	if !changed {
+		return payload, false, nil
+	}
+
+	data, err := json.Marshal(&decoded)
+	if err != nil {
+		return "", false, err
+	}
+	return string(data), true, nil
+}
+
+// rotateRuntimeSource re-encrypts the secret configs and rollout candidates of
+// a runtime source in place.
+func (l *Logic) rotateRuntimeSource(source *runtimeSource) (bool, error) {
+	changed := false
+	for i := range source.Configs {
+		if source.Configs[i].Type != "secret" {
+			continue
+		}
+		rotated, ok, err := l.secretKeyring.Rotate(source.Configs[i].Content)
+		if err != nil {
+			return false, fmt.Errorf("config %s: %w", source.Configs[i].ResourceKey, err)
+		}
+		if ok {
+			source.Configs[i].Content = rotated
+			changed = true
+		}
+	}
+	for i := range source.Rollouts {
+		rotated, ok, err := l.secretKeyring.Rotate(source.Rollouts[i].CandidateContent)
+		if err != nil {
+			return false, fmt.Errorf("rollout %d: %w", source.Rollouts[i].ID, err)
+		}
+		if ok {
+			source.Rollouts[i].CandidateContent = rotated
+			changed = true
+		}
+	}
+	return changed, nil
+}
+
+// encryptSecretContent encrypts the plaintext content of a secret config in
+// place. Content that already is a ciphertext of a known key (configs copied
+// by migration or rollback) is kept as is.
+func (l *Logic) encryptSecretContent(cfg *model.Config) error {
+	if cfg.Content == "" {
+		return errors.New("密钥内容不能为空")
+	}
+	if cfg.Content == SecretContentMask {
+		return errors.New("密钥内容不能为掩码，请填写明文")
+	}
+	if l.secretKeyring == nil {
+		return ErrSecretKeyNotConfigured
+	}
+	if common.IsSecretCiphertext(cfg.Content) {
+		if _, err := l.secretKeyring.Decrypt(cfg.Content); err != nil {
+			return fmt.Errorf("密钥内容无法解密: %w", err)
+		}
+		return nil
+	}
+	ciphertext, err := l.secretKeyring.Encrypt(cfg.Content)
+	if err != nil {
+		return err
+	}
+	cfg.Content = ciphertext
+	return nil
+}
+
+// revealSecrets decrypts the secret configs served to runtime clients. Secrets
+// that cannot be decrypted are left out rather than served as ciphertext.
+func (l *Logic) revealSecrets(configs []model.Config) []model.Config {
+	revealed := make([]model.Config, 0, len(configs))
+	for i := range configs {
+		cfg := configs[i]
+		if cfg.Type == "secret" {
+			if l.secretKeyring == nil {
+				fmt.Printf("Skipping secret config %s: secret key not configured\n", cfg.Alias)
+				continue
+			}
+			plaintext, err := l.secretKeyring.Decrypt(cfg.Content)
+			if err != nil {
+				fmt.Printf("Skipping secret config %s: %v\n", cfg.Alias, err)
+				continue
+			}
+			cfg.Content = plaintext
+		}
+		revealed = append(revealed, cfg)
+	}
+	return revealed
+}
@@ -0,0 +1,443 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"gorm.io/gorm"
+)
+
+// runtimeSnapshot is the last known good runtime source of a pipeline, with
+// what GetRuntimeConfig and the runtime authorizer need besides it. It is
+// served while the database is unavailable. Configs are kept as stored, so
+// secrets stay encrypted and visibility is applied when rendering.
+type runtimeSnapshot struct {
+	runtimeSource
+	EnvironmentKey  string    `json:"environment_key"`
+	EnvironmentName string    `json:"environment_name"`
+	PipelineKey     string    `json:"pipeline_key"`
+	PipelineName    string    `json:"pipeline_name"`
+	Revision        int64     `json:"revision"`
+	RequiresAPIKey  bool      `json:"requires_api_key"`
+	TakenAt         time.Time `json:"taken_at"`
+}
+
+// sameState reports whether two snapshots were taken from the same state of a
+// pipeline; every change of the runtime source advances the revision.
+func (s *runtimeSnapshot) sameState(other *runtimeSnapshot) bool {
+	return other != nil && s.Revision == other.Revision && s.RequiresAPIKey == other.RequiresAPIKey &&
+		s.EnvironmentName == other.EnvironmentName && s.PipelineName == other.PipelineName &&
+		s.DefaultLocale == other.DefaultLocale
+}
+
+// runtimeSnapshotStore keeps the runtime snapshot of every pipeline served by
+// this process and, when dir is set, a copy of each on disk for restarts.
+type runtimeSnapshotStore struct {
+	mu        sync.Mutex
+	dir       string
+	snapshots map[[2]string]*runtimeSnapshot
+	// writeMu orders the writes of snapshot files.
+	writeMu sync.Mutex
+	// stale holds the pipelines waiting for a background refresh; refreshing
+	// is set while a goroutine works through them, and refreshes counts it.
+	stale      map[[2]string]bool
+	refreshing bool
+	refreshes  sync.WaitGroup
+}
+
+func newRuntimeSnapshotStore() *runtimeSnapshotStore {
+	return &runtimeSnapshotStore{
+		snapshots: make(map[[2]string]*runtimeSnapshot),
+		stale:     make(map[[2]string]bool),
+	}
+}
+
+// setDir persists snapshots to dir, creating it when missing.
+func (s *runtimeSnapshotStore) setDir(dir string) error {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("create runtime snapshot dir: %w", err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dir = dir
+	return nil
+}
+
+// get returns the snapshot of a pipeline, reading it from disk when this
+// process holds none; nil when there is no snapshot.
+func (s *runtimeSnapshotStore) get(environmentKey, pipelineKey string) *runtimeSnapshot {
+	key := [2]string{environmentKey, pipelineKey}
+	s.mu.Lock()
+	snapshot, dir := s.snapshots[key], s.dir
+	s.mu.Unlock()
+	if snapshot != nil || dir == "" {
+		return snapshot
+	}
+
+	data, err := os.ReadFile(runtimeSnapshotPath(dir, environmentKey, pipelineKey))
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			fmt.Printf("Failed to read runtime snapshot: %v\n", err)
+		}
+		return nil
+	}
+	snapshot = &runtimeSnapshot{}
+	if err := json.Unmarshal(data, snapshot); err != nil {
+		fmt.Printf("Failed to decode runtime snapshot: %v\n", err)
+		return nil
+	}
+	if snapshot.EnvironmentKey != environmentKey || snapshot.PipelineKey != pipelineKey {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if current := s.snapshots[key]; current != nil {
+		return current
+	}
+	s.snapshots[key] = snapshot
+	return snapshot
+}
+
+// put replaces the snapshot of a pipeline. The file on disk is only rewritten
+// when the pipeline changed since the snapshot it holds.
+func (s *runtimeSnapshotStore) put(snapshot *runtimeSnapshot) {
+	key := [2]string{snapshot.EnvironmentKey, snapshot.PipelineKey}
+	s.mu.Lock()
+	previous, dir := s.snapshots[key], s.dir
+	s.snapshots[key] = snapshot
+	s.mu.Unlock()
+	if dir == "" || snapshot.sameState(previous) {
+		return
+	}
+	s.persist(dir, key)
+}
+
+// persist writes the snapshot held for a pipeline to disk. Writers call it
+// after replacing the snapshot, so the last file written holds the latest one.
+func (s *runtimeSnapshotStore) persist(dir string, key [2]string) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	s.mu.Lock()
+	snapshot := s.snapshots[key]
+	s.mu.Unlock()
+	if snapshot == nil {
+		return
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		fmt.Printf("Failed to encode runtime snapshot: %v\n", err)
+		return
+	}
+	if err := writeFileAtomic(runtimeSnapshotPath(dir, key[0], key[1]), data); err != nil {
+		fmt.Printf("Failed to write runtime snapshot: %v\n", err)
+	}
+}
+
+// rewrite passes a copy of every snapshot held in memory or left on disk to
+// fn, and stores the copies fn changed. A snapshot replaced meanwhile by a
+// newer one is skipped. It returns how many snapshots were rewritten.
+func (s *runtimeSnapshotStore) rewrite(fn func(*runtimeSnapshot) (bool, error)) int {
+	s.mu.Lock()
+	dir := s.dir
+	s.mu.Unlock()
+	keys := s.pipelines()
+	if dir != "" {
+		keys = append(keys, s.storedPipelines(dir)...)
+	}
+
+	rewritten := 0
+	seen := make(map[[2]string]bool, len(keys))
+	for _, key := range keys {
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		snapshot := s.get(key[0], key[1])
+		if snapshot == nil {
+			continue
+		}
+		updated := *snapshot
+		updated.Configs = slices.Clone(snapshot.Configs)
+		updated.Rollouts = slices.Clone(snapshot.Rollouts)
+		changed, err := fn(&updated)
+		if err != nil {
+			fmt.Printf("Failed to rewrite runtime snapshot %s/%s: %v\n", key[0], key[1], err)
+			continue
+		}
+		if !changed {
+			continue
+		}
+
+		s.mu.Lock()
+		current := s.snapshots[key] == snapshot
+		if current {
+			s.snapshots[key] = &updated
+		}
+		s.mu.Unlock()
+		if !current {
+			continue
+		}
+		if dir != "" {
+			s.persist(dir, key)
+		}
+		rewritten++
+	}
+	return rewritten
+}
+
+// storedPipelines returns the environment/pipeline pairs of the snapshot
+// files in dir.
+func (s *runtimeSnapshotStore) storedPipelines(dir string) [][2]string {
+	paths, err := filepath.Glob(filepath.Join(dir, "runtime-*.json"))
+	if err != nil {
+		fmt.Printf("Failed to list runtime snapshots: %v\n", err)
+		return nil
+	}
+	keys := make([][2]string, 0, len(paths))
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			fmt.Printf("Failed to read runtime snapshot: %v\n", err)
+			continue
+		}
+		var snapshot runtimeSnapshot
+		if err := json.Unmarshal(data, &snapshot); err != nil {
+			fmt.Printf("Failed to decode runtime snapshot: %v\n", err)
+			continue
+		}
+		keys = append(keys, [2]string{snapshot.EnvironmentKey, snapshot.PipelineKey})
+	}
+	return keys
+}
+
+// remove drops the snapshot of a deleted pipeline.
+func (s *runtimeSnapshotStore) remove(environmentKey, pipelineKey string) {
+	s.mu.Lock()
+	delete(s.snapshots, [2]string{environmentKey, pipelineKey})
+	dir := s.dir
+	s.mu.Unlock()
+	if dir == "" {
+		return
+	}
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	if err := os.Remove(runtimeSnapshotPath(dir, environmentKey, pipelineKey)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		fmt.Printf("Failed to remove runtime snapshot: %v\n", err)
+	}
+}
+
+// pipelines returns the environment/pipeline pairs held in memory.
+func (s *runtimeSnapshotStore) pipelines() [][2]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	keys := make([][2]string, 0, len(s.snapshots))
+	for key := range s.snapshots {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// markStale queues pipelines for a background refresh. It reports whether
+// the caller must start the goroutine working through them.
+func (s *runtimeSnapshotStore) markStale(keys [][2]string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, key := range keys {
+		s.stale[key] = true
+	}
+	if s.refreshing || len(s.stale) == 0 {
+		return false
+	}
+	s.refreshing = true
+	s.refreshes.Add(1)
+	return true
+}
+
+// nextStale takes a pipeline queued for refresh. When none is left it
+// reports false, and the refreshing goroutine must stop.
+func (s *runtimeSnapshotStore) nextStale() ([2]string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key := range s.stale {
+		delete(s.stale, key)
+		return key, true
+	}
+	s.refreshing = false
+	s.refreshes.Done()
+	return [2]string{}, false
+}
+
+// runtimeSnapshotPath names snapshot files by a hash, as keys are free text.
+func runtimeSnapshotPath(dir, environmentKey, pipelineKey string) string {
+	sum := sha256.Sum256([]byte(environmentKey + "\x00" + pipelineKey))
+	return filepath.Join(dir, "runtime-"+hex.EncodeToString(sum[:16])+".json")
+}
+
+// writeFileAtomic replaces path with data, so readers never see a partial file.
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// --------------------- Runtime snapshot operations ---------------------
+
+// LoadRuntimeSnapshot loads the runtime source of a pipeline and keeps it as
+// the last known good snapshot. It fails with ErrEnvironmentNotFound or
+// ErrPipelineNotFound when the pipeline does not exist, dropping its snapshot.
+func (l *Logic) LoadRuntimeSnapshot(ctx context.Context, environmentKey, pipelineKey string) (*runtimeSnapshot, error) {
+	env, err := l.environmentDAO.GetByKey(ctx, l.db, environmentKey)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			l.snapshots.remove(environmentKey, pipelineKey)
+			return nil, fmt.Errorf("%w: %s", ErrEnvironmentNotFound, environmentKey)
+		}
+		return nil, err
+	}
+	pipeline, err := l.pipelineDAO.GetByKey(ctx, l.db, environmentKey, pipelineKey)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			l.snapshots.remove(environmentKey, pipelineKey)
+			return nil, fmt.Errorf("%w: %s/%s", ErrPipelineNotFound, environmentKey, pipelineKey)
+		}
+		return nil, err
+	}
+
+	// 先读取版本号再加载配置，加载期间的变更会在下次监听时返回
+	revision, err := l.RuntimeRevision(ctx, environmentKey, pipelineKey)
+	if err != nil {
+		return nil, err
+	}
+	source, err := l.loadRuntimeSource(ctx, environmentKey, pipelineKey)
+	if err != nil {
+		return nil, err
+	}
+	requiresAPIKey, err := l.PipelineRequiresAPIKey(ctx, environmentKey, pipelineKey)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &runtimeSnapshot{
+		runtimeSource:   *source,
+		EnvironmentKey:  env.EnvironmentKey,
+		EnvironmentName: env.EnvironmentName,
+		PipelineKey:     pipeline.PipelineKey,
+		PipelineName:    pipeline.PipelineName,
+		Revision:        revision,
+		RequiresAPIKey:  requiresAPIKey,
+		TakenAt:         time.Now(),
+	}
+	l.snapshots.put(snapshot)
+	return snapshot, nil
+}
+
+// lastRuntimeSnapshot returns the last known good snapshot of a pipeline, or
+// nil when none was taken by this process or left on disk.
+func (l *Logic) lastRuntimeSnapshot(environmentKey, pipelineKey string) *runtimeSnapshot {
+	return l.snapshots.get(environmentKey, pipelineKey)
+}
+
+// refreshRuntimeSnapshots reloads the snapshots held for the pipelines the
+// changes cover, so they include the writes of this process; changes of
+// BasePipelineKey cover every pipeline of the environment. Failures keep the
+// previous snapshot.
+func (l *Logic) refreshRuntimeSnapshots(ctx context.Context, entries []model.RuntimeChangeLog) {
+	var stale [][2]string
+	for _, key := range l.snapshots.pipelines() {
+		for i := range entries {
+			if entries[i].EnvironmentKey == key[0] && (entries[i].PipelineKey == key[1] || entries[i].PipelineKey == model.BasePipelineKey) {
+				stale = append(stale, key)
+				break
+			}
+		}
+	}
+	l.queueRuntimeSnapshotRefresh(ctx, stale)
+}
+
+// refreshAllRuntimeSnapshots reloads every snapshot held, e.g. after all
+// configs were replaced.
+func (l *Logic) refreshAllRuntimeSnapshots(ctx context.Context) {
+	l.queueRuntimeSnapshotRefresh(ctx, l.snapshots.pipelines())
+}
+
+// queueRuntimeSnapshotRefresh reloads snapshots in the background, so writes
+// do not wait for the runtime sources of every pipeline they cover. A
+// pipeline queued again while it is reloaded is reloaded once more.
+func (l *Logic) queueRuntimeSnapshotRefresh(ctx context.Context, keys [][2]string) {
+	if !l.snapshots.markStale(keys) {
+		return
+	}
+	// 写入请求结束后继续刷新
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		for {
+			key, ok := l.snapshots.nextStale()
+			if !ok {
+				return
+			}
+			l.refreshRuntimeSnapshot(ctx, key[0], key[1])
+		}
+	}()
+}
+
+func (l *Logic) refreshRuntimeSnapshot(ctx context.Context, environmentKey, pipelineKey string) {
+	if _, err := l.LoadRuntimeSnapshot(ctx, environmentKey, pipelineKey); runtimeSourceUnavailable(err) {
+		fmt.Printf("Failed to refresh runtime snapshot: %v\n", err)
+	}
+}
+
+// errDBClosed is the message of the unexported error database/sql returns
+// once the connection pool is closed.
+const errDBClosed = "sql: database is closed"
+
+// runtimeSourceUnavailable reports whether err is a failure to reach the
+// database: a connection, network or driver error, including PostgreSQL
+// connection exceptions and server shutdowns. Only then is the last
+// known good snapshot served; missing environments or pipelines, failed
+// queries and requests canceled or timed out by the client are returned as is.
+func runtimeSourceUnavailable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var netErr net.Error
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		// 08 类为连接异常，57P01-57P03 为服务端关闭或尚不接受连接
+		return strings.HasPrefix(pgErr.Code, "08") || slices.Contains([]string{"57P01", "57P02", "57P03"}, pgErr.Code)
+	}
+	return errors.As(err, &netErr) ||
+		pgconn.SafeToRetry(err) ||
+		errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, sql.ErrConnDone) ||
+		errors.Is(err, mysql.ErrInvalidConn) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		strings.Contains(err.Error(), errDBClosed)
+}
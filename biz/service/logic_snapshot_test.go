@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/yi-nology/rainbow_bridge/biz/dal/db"
+)
+
+// retryablePgError stands for the pgconn errors raised before a query was
+// sent, which report SafeToRetry.
+type retryablePgError struct{}
+
+func (retryablePgError) Error() string     { return "failed to write startup message" }
+func (retryablePgError) SafeToRetry() bool { return true }
+
+func TestRuntimeSourceUnavailable(t *testing.T) {
+	gdb := db.SetupTestDB(t)
+	sqliteQueryErr := gdb.Exec("SELECT missing FROM config").Error
+	sqlDB, err := gdb.DB()
+	if err != nil {
+		t.Fatalf("DB failed: %v", err)
+	}
+	if err := sqlDB.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	sqliteClosedErr := gdb.Exec("SELECT 1").Error
+	if sqliteQueryErr == nil || sqliteClosedErr == nil {
+		t.Fatalf("sqlite errors = %v, %v; want both set", sqliteQueryErr, sqliteClosedErr)
+	}
+
+	cases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"missing environment", fmt.Errorf("%w: prod", ErrEnvironmentNotFound), false},
+		{"missing pipeline", fmt.Errorf("%w: prod/web", ErrPipelineNotFound), false},
+		{"query error", errors.New("no such column: content"), false},
+		{"canceled", fmt.Errorf("load configs: %w", context.Canceled), false},
+		{"deadline", fmt.Errorf("load configs: %w", context.DeadlineExceeded), false},
+		{"dial", &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}, true},
+		{"bad connection", fmt.Errorf("load configs: %w", driver.ErrBadConn), true},
+		{"closed pool", errors.New("sql: database is closed"), true},
+		{"sqlite query error", sqliteQueryErr, false},
+		{"sqlite closed database", sqliteClosedErr, true},
+		{"mysql query error", &mysql.MySQLError{Number: 1054, Message: "Unknown column 'content'"}, false},
+		{"invalid mysql connection", mysql.ErrInvalidConn, true},
+		{"postgres query error", &pgconn.PgError{Code: "42703", Message: `column "content" does not exist`}, false},
+		{"postgres connection failure", fmt.Errorf("load configs: %w", &pgconn.PgError{Code: "08006"}), true},
+		{"postgres admin shutdown", &pgconn.PgError{Code: "57P01"}, true},
+		{"postgres safe to retry", fmt.Errorf("load configs: %w", retryablePgError{}), true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := runtimeSourceUnavailable(tc.err); got != tc.want {
+				t.Fatalf("runtimeSourceUnavailable(%v) = %t, want %t", tc.err, got, tc.want)
+			}
+		})
+	}
+}
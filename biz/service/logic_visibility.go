@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"github.com/yi-nology/rainbow_bridge/pkg/common"
+)
+
+// --------------------- Config visibility ---------------------
+
+// Cache scopes of configVisibility.
+const (
+	configScopePerm   = "perm"
+	configScopePublic = "public"
+)
+
+// configVisibility is the policy deciding which configs a request may see.
+// Configs marked is_perm are visible to signed-in users and to API keys with
+// the perm-configs scope only; to everyone else they do not exist. Reads apply
+// it after their cache lookup, so cached configs are shared by all callers;
+// caches of results rendered for a request are keyed by its cacheScope.
+type configVisibility struct {
+	perm bool
+}
+
+// configVisibilityFor returns the visibility of the caller in ctx.
+func configVisibilityFor(ctx context.Context) configVisibility {
+	return configVisibility{perm: canReadPermConfigs(ctx)}
+}
+
+// canReadPermConfigs reports whether the caller may read configs marked
+// is_perm: requests authenticated with an API key need the perm-configs
+// scope, other requests a user identity.
+func canReadPermConfigs(ctx context.Context) bool {
+	if key, ok := common.GetAPIKey(ctx); ok {
+		return key.HasScope(common.APIKeyScopePermConfigs)
+	}
+	userID, ok := common.GetUserID(ctx)
+	return ok && userID != 0
+}
+
+// visible reports whether cfg may be shown; a nil config hides nothing.
+func (v configVisibility) visible(cfg *model.Config) bool {
+	return cfg == nil || v.perm || !cfg.IsPerm
+}
+
+// visibleEvent reports whether the change event of a config may be shown.
+func (v configVisibility) visibleEvent(event *model.ChangeEvent) bool {
+	return v.perm || !event.IsPerm
+}
+
+// check fails with ErrResourceNotFound for a hidden config, so that callers
+// cannot tell it from a missing one.
+func (v configVisibility) check(cfg *model.Config) error {
+	if !v.visible(cfg) {
+		return ErrResourceNotFound
+	}
+	return nil
+}
+
+// filter returns configs without the hidden ones, leaving configs untouched.
+func (v configVisibility) filter(configs []model.Config) []model.Config {
+	if v.perm {
+		return configs
+	}
+	filtered := make([]model.Config, 0, len(configs))
+	for i := range configs {
+		if v.visible(&configs[i]) {
+			filtered = append(filtered, configs[i])
+		}
+	}
+	return filtered
+}
+
+// redact returns cfg, or nil when it is hidden; used for the snapshots kept by
+// revisions and change requests.
+func (v configVisibility) redact(cfg *model.Config) *model.Config {
+	if !v.visible(cfg) {
+		return nil
+	}
+	return cfg
+}
+
+// cacheScope names the callers that may share results rendered for this one.
+func (v configVisibility) cacheScope() string {
+	if v.perm {
+		return configScopePerm
+	}
+	return configScopePublic
+}
@@ -0,0 +1,248 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/db"
+	"github.com/yi-nology/rainbow_bridge/biz/model/common"
+	configpb "github.com/yi-nology/rainbow_bridge/biz/model/config"
+	envpb "github.com/yi-nology/rainbow_bridge/biz/model/environment"
+	rolloutpb "github.com/yi-nology/rainbow_bridge/biz/model/rollout"
+	"github.com/yi-nology/rainbow_bridge/biz/model/transfer"
+	pkgcommon "github.com/yi-nology/rainbow_bridge/pkg/common"
+	"github.com/yi-nology/rainbow_bridge/pkg/config"
+)
+
+const protectedContent = "perm-only-value"
+
+// TestConfigVisibility checks every read returning config content against
+// callers that may and may not see configs marked is_perm.
+func TestConfigVisibility(t *testing.T) {
+	gdb := db.SetupTestDB(t)
+	defer db.CleanupTestDB(t, gdb)
+	s := NewService(gdb, nil, "", &config.Config{})
+
+	user := pkgcommon.ContextWithUserID(context.Background(), 1)
+	if err := s.AddEnvironment(user, &envpb.Environment{EnvironmentKey: "prod", EnvironmentName: "Prod", IsActive: true}); err != nil {
+		t.Fatalf("AddEnvironment failed: %v", err)
+	}
+	var protectedKey string
+	for _, cfg := range []*common.ResourceConfig{
+		{Name: "Token", Alias: "token", Type: "text", Content: protectedContent, IsPerm: true},
+		{Name: "Greeting", Alias: "greeting", Type: "text", Content: "token=${token}"},
+	} {
+		cfg.EnvironmentKey, cfg.PipelineKey = "prod", "default"
+		added, err := s.AddConfig(user, cfg)
+		if err != nil {
+			t.Fatalf("AddConfig %s failed: %v", cfg.Alias, err)
+		}
+		if cfg.IsPerm {
+			protectedKey = added.GetResourceKey()
+		}
+	}
+	if _, err := s.PublishRelease(user, "prod", "default", "v1"); err != nil {
+		t.Fatalf("PublishRelease failed: %v", err)
+	}
+	// 发布后新增的受保护配置出现在版本对比中
+	if _, err := s.AddConfig(user, &common.ResourceConfig{
+		EnvironmentKey: "prod", PipelineKey: "default", Name: "Unreleased", Alias: "unreleased", Type: "text", Content: protectedContent + "-2", IsPerm: true,
+	}); err != nil {
+		t.Fatalf("AddConfig unreleased failed: %v", err)
+	}
+	if _, err := s.CreateRollout(user, &rolloutpb.CreateRolloutRequest{
+		EnvironmentKey: "prod", PipelineKey: "default", Alias: "token", CandidateContent: protectedContent + "-3", Percentage: 10, BucketHeader: "X-Device-Id",
+	}); err != nil {
+		t.Fatalf("CreateRollout failed: %v", err)
+	}
+
+	callers := []struct {
+		name    string
+		ctx     context.Context
+		visible bool
+	}{
+		{"Anonymous", context.Background(), false},
+		{"User", user, true},
+		{"APIKey", pkgcommon.ContextWithAPIKey(context.Background(), &pkgcommon.APIKeyIdentity{
+			EnvironmentKey: "prod", PipelineKey: "default", Scopes: []string{pkgcommon.APIKeyScopeRuntimeRead},
+		}), false},
+		{"PermAPIKey", pkgcommon.ContextWithAPIKey(context.Background(), &pkgcommon.APIKeyIdentity{
+			EnvironmentKey: "prod", PipelineKey: "default", Scopes: []string{pkgcommon.APIKeyScopeRuntimeRead, pkgcommon.APIKeyScopePermConfigs},
+		}), true},
+	}
+	for _, caller := range callers {
+		t.Run(caller.name, func(t *testing.T) {
+			ctx := caller.ctx
+			// expose fails unless the protected content shows up exactly when the caller may see it
+			expose := func(path string, value any, err error) {
+				t.Helper()
+				if err != nil {
+					t.Fatalf("%s failed: %v", path, err)
+				}
+				data, err := json.Marshal(value)
+				if err != nil {
+					t.Fatalf("%s: marshal result: %v", path, err)
+				}
+				if got := bytes.Contains(data, []byte(protectedContent)); got != caller.visible {
+					t.Errorf("%s: protected content returned = %v, want %v", path, got, caller.visible)
+				}
+			}
+			hidden := func(path string, err error) {
+				t.Helper()
+				if caller.visible && err != nil {
+					t.Errorf("%s failed: %v", path, err)
+				}
+				if !caller.visible && !errors.Is(err, ErrResourceNotFound) {
+					t.Errorf("%s: err = %v, want ErrResourceNotFound", path, err)
+				}
+			}
+
+			runtimeConfig, err := s.GetRuntimeConfig(ctx, "prod", "default")
+			expose("GetRuntimeConfig", runtimeConfig, err)
+			configMap, err := s.logic.ListConfigsAsMap(ctx, "prod", "default")
+			expose("ListConfigsAsMap", configMap, err)
+			archive, _, err := s.ExportStaticPackage(ctx, "prod", "default", false)
+			expose("ExportStaticPackage", readZipEntries(t, archive), err)
+
+			configs, err := s.ListConfigs(ctx, "prod", "default", "", "", "", false, false)
+			expose("ListConfigs", configs, err)
+			found, total, err := s.SearchConfigs(ctx, &configpb.SearchConfigRequest{EnvironmentKey: "prod"})
+			expose("SearchConfigs", found, err)
+			if want := map[bool]int64{true: 3, false: 1}[caller.visible]; total != want {
+				t.Errorf("SearchConfigs total = %d, want %d", total, want)
+			}
+			detail, err := s.GetConfigDetail(ctx, "prod", "default", protectedKey)
+			hidden("GetConfigDetail", err)
+			expose("GetConfigDetail", detail, nil)
+			_, err = s.PreviewConfig(ctx, "prod", "default", protectedKey)
+			hidden("PreviewConfig", err)
+			for _, cfg := range configs {
+				if cfg.GetAlias() == "greeting" {
+					preview, err := s.PreviewConfig(ctx, "prod", "default", cfg.GetResourceKey())
+					expose("PreviewConfig(greeting)", preview, err)
+				}
+			}
+			history, _, err := s.ListConfigHistory(ctx, "prod", "default", protectedKey, 1, 20)
+			expose("ListConfigHistory", history, err)
+			rollouts, err := s.ListRollouts(ctx, "prod", "default", "")
+			expose("ListRollouts", rollouts, err)
+			_, err = s.UpdateConfigLabels(ctx, &configpb.UpdateConfigLabelsRequest{
+				EnvironmentKey: "prod", PipelineKey: "default", ResourceKey: protectedKey, Labels: map[string]string{"team": "core"},
+			})
+			hidden("UpdateConfigLabels", err)
+
+			release, err := s.GetRelease(ctx, "prod", "default", 1)
+			expose("GetRelease", release, err)
+			diffs, err := s.CompareReleases(ctx, "prod", "default", 1, 0)
+			expose("CompareReleases", diffs, err)
+			diffs, err = s.CompareReleases(ctx, "prod", "default", 0, 1)
+			expose("CompareReleases(reverse)", diffs, err)
+
+			exported, err := s.ExportConfigs(ctx, "prod", "default")
+			expose("ExportConfigs", exported, err)
+			selective, _, err := s.ExportConfigsSelective(ctx, []*transfer.ExportSelection{{EnvironmentKey: "prod"}}, "zip")
+			expose("ExportConfigsSelective", readZipEntries(t, selective), err)
+			tree, err := s.GetExportTree(ctx)
+			if err != nil {
+				t.Fatalf("GetExportTree failed: %v", err)
+			}
+			if want := map[bool]int32{true: 3, false: 1}[caller.visible]; tree.Environments[0].Pipelines[0].ConfigCount != want {
+				t.Errorf("GetExportTree config count = %d, want %d", tree.Environments[0].Pipelines[0].ConfigCount, want)
+			}
+		})
+	}
+
+	etags := make(map[bool]string)
+	for _, caller := range callers {
+		validator, err := s.RuntimeConfigValidator(caller.ctx, "prod", "default")
+		if err != nil {
+			t.Fatalf("RuntimeConfigValidator failed: %v", err)
+		}
+		if etag, ok := etags[caller.visible]; ok && etag != validator.ETag {
+			t.Errorf("%s: ETag %s differs from %s for callers seeing the same configs", caller.name, validator.ETag, etag)
+		}
+		etags[caller.visible] = validator.ETag
+	}
+	if etags[true] == etags[false] {
+		t.Error("expected different ETags for callers seeing different configs")
+	}
+}
+
+// TestChangeStreamVisibility checks that change events of configs marked
+// is_perm only reach streams of callers that may see those configs.
+func TestChangeStreamVisibility(t *testing.T) {
+	gdb := db.SetupTestDB(t)
+	defer db.CleanupTestDB(t, gdb)
+	s := NewService(gdb, nil, "", &config.Config{})
+
+	user := pkgcommon.ContextWithUserID(context.Background(), 1)
+	if err := s.AddEnvironment(user, &envpb.Environment{EnvironmentKey: "prod", EnvironmentName: "Prod", IsActive: true}); err != nil {
+		t.Fatalf("AddEnvironment failed: %v", err)
+	}
+	for _, cfg := range []*common.ResourceConfig{
+		{Name: "Token", Alias: "token", Type: "text", Content: protectedContent, IsPerm: true},
+		{Name: "Greeting", Alias: "greeting", Type: "text", Content: "hello"},
+	} {
+		cfg.EnvironmentKey, cfg.PipelineKey = "prod", "default"
+		if _, err := s.AddConfig(user, cfg); err != nil {
+			t.Fatalf("AddConfig %s failed: %v", cfg.Alias, err)
+		}
+	}
+
+	// streamed replays the events of a stream resumed from the start
+	streamed := func(ctx context.Context) []string {
+		t.Helper()
+		ctx, cancel := context.WithTimeout(ctx, 200*time.Millisecond)
+		defer cancel()
+		var aliases []string
+		err := s.logic.StreamChangeEvents(ctx, "prod", "default", 0, true, func(msg *ChangeStreamMessage) error {
+			if msg.Event != nil {
+				aliases = append(aliases, msg.Event.Alias)
+			}
+			return nil
+		})
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("StreamChangeEvents = %v, want it to run until the deadline", err)
+		}
+		return aliases
+	}
+	if aliases := streamed(context.Background()); len(aliases) != 1 || aliases[0] != "greeting" {
+		t.Fatalf("anonymous stream sent %v, want only the greeting", aliases)
+	}
+	if aliases := streamed(user); len(aliases) != 2 {
+		t.Fatalf("user stream sent %v, want both configs", aliases)
+	}
+}
+
+// readZipEntries returns the contents of the files in a zip archive.
+func readZipEntries(t *testing.T, data []byte) []string {
+	t.Helper()
+	if len(data) == 0 {
+		return nil
+	}
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("open zip: %v", err)
+	}
+	entries := make([]string, 0, len(reader.File))
+	for _, file := range reader.File {
+		rc, err := file.Open()
+		if err != nil {
+			t.Fatalf("open %s: %v", file.Name, err)
+		}
+		content, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatalf("read %s: %v", file.Name, err)
+		}
+		entries = append(entries, strings.ToValidUTF8(string(content), ""))
+	}
+	return entries
+}
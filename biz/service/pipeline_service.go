@@ -3,16 +3,21 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
 	plpb "github.com/yi-nology/rainbow_bridge/biz/model/pipeline"
+	"github.com/yi-nology/rainbow_bridge/pkg/util"
 	"gorm.io/gorm"
 )
 
 var (
-	ErrPipelineNotFound    = errors.New("pipeline not found")
-	ErrPipelineKeyRequired = errors.New("pipeline_key is required")
-	ErrPipelineKeyExists   = errors.New("pipeline_key already exists")
+	ErrPipelineNotFound      = errors.New("pipeline not found")
+	ErrPipelineKeyRequired   = errors.New("pipeline_key is required")
+	ErrPipelineKeyExists     = errors.New("pipeline_key already exists")
+	ErrPipelineKeyReserved   = errors.New("pipeline_key " + model.BasePipelineKey + " is reserved for environment base configs")
+	ErrPipelineLocaleInvalid = errors.New("invalid pipeline locale")
 )
 
 // AddPipeline creates a new pipeline.
@@ -23,6 +28,13 @@ func (s *Service) AddPipeline(ctx context.Context, environmentKey string, pl *pl
 	if environmentKey == "" {
 		return errors.New("environment_key is required")
 	}
+	if pl.GetPipelineKey() == model.BasePipelineKey {
+		return ErrPipelineKeyReserved
+	}
+	overrides, err := s.logic.checkEnvironmentWritable(ctx, "pipeline.create", environmentKey)
+	if err != nil {
+		return err
+	}
 
 	exists, err := s.logic.pipelineDAO.ExistsByKey(ctx, s.logic.db, environmentKey, pl.GetPipelineKey())
 	if err != nil {
@@ -31,6 +43,10 @@ func (s *Service) AddPipeline(ctx context.Context, environmentKey string, pl *pl
 	if exists {
 		return ErrPipelineKeyExists
 	}
+	defaultLocale, locales, err := pipelineLocalesFromPB(pl)
+	if err != nil {
+		return err
+	}
 
 	entity := &model.Pipeline{
 		EnvironmentKey: environmentKey,
@@ -39,8 +55,12 @@ func (s *Service) AddPipeline(ctx context.Context, environmentKey string, pl *pl
 		Description:    pl.GetDescription(),
 		SortOrder:      int(pl.GetSortOrder()),
 		IsActive:       pl.GetIsActive(),
+		DefaultLocale:  defaultLocale,
+		Locales:        locales,
 	}
-	return s.logic.pipelineDAO.Create(ctx, s.logic.db, entity)
+	return s.logic.transactWithOverrides(ctx, overrides, func(tx *gorm.DB) error {
+		return s.logic.pipelineDAO.Create(ctx, tx, entity)
+	})
 }
 
 // UpdatePipeline updates an existing pipeline.
@@ -60,6 +80,15 @@ func (s *Service) UpdatePipeline(ctx context.Context, environmentKey string, pl
 		return err
 	}
 
+	overrides, err := s.logic.checkEnvironmentWritable(ctx, "pipeline.update", environmentKey)
+	if err != nil {
+		return err
+	}
+	defaultLocale, locales, err := pipelineLocalesFromPB(pl)
+	if err != nil {
+		return err
+	}
+
 	entity := &model.Pipeline{
 		EnvironmentKey: environmentKey,
 		PipelineKey:    pl.GetPipelineKey(),
@@ -68,7 +97,22 @@ func (s *Service) UpdatePipeline(ctx context.Context, environmentKey string, pl
 		SortOrder:      int(pl.GetSortOrder()),
 		IsActive:       pl.GetIsActive(),
 	}
-	return s.logic.pipelineDAO.Update(ctx, s.logic.db, entity)
+	err = s.logic.transactWithOverrides(ctx, overrides, func(tx *gorm.DB) error {
+		if err := s.logic.pipelineDAO.Update(ctx, tx, entity); err != nil {
+			return err
+		}
+		if err := s.logic.pipelineDAO.SetLocales(ctx, tx, environmentKey, entity.PipelineKey, defaultLocale, locales); err != nil {
+			return err
+		}
+		// 默认语言影响按语言下发的配置
+		return s.logic.recordRuntimeChange(ctx, tx, environmentKey, entity.PipelineKey)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.logic.invalidateConfigCache(ctx, environmentKey, entity.PipelineKey, "")
+	return nil
 }
 
 // DeletePipeline deletes a pipeline by key.
@@ -87,8 +131,18 @@ func (s *Service) DeletePipeline(ctx context.Context, environmentKey, pipelineKe
 		}
 		return err
 	}
+	overrides, err := s.logic.checkEnvironmentWritable(ctx, "pipeline.delete", environmentKey)
+	if err != nil {
+		return err
+	}
 
-	return s.logic.pipelineDAO.Delete(ctx, s.logic.db, environmentKey, pipelineKey)
+	err = s.logic.transactWithOverrides(ctx, overrides, func(tx *gorm.DB) error {
+		return s.logic.pipelineDAO.Delete(ctx, tx, environmentKey, pipelineKey)
+	})
+	if err != nil {
+		return err
+	}
+	return s.logic.revokePipelineAPIKeys(ctx, environmentKey, pipelineKey)
 }
 
 // GetPipeline returns a pipeline by key.
@@ -136,7 +190,43 @@ func modelPipelineToPB(pl *model.Pipeline) *plpb.Pipeline {
 		Description:    pl.Description,
 		SortOrder:      int32(pl.SortOrder),
 		IsActive:       pl.IsActive,
+		DefaultLocale:  pl.DefaultLocale,
+		Locales:        splitPipelineLocales(pl.Locales),
+	}
+}
+
+// pipelineLocalesFromPB validates the locales of a pipeline and returns them as
+// stored: the normalised default locale and the further locales comma separated.
+func pipelineLocalesFromPB(pl *plpb.Pipeline) (string, string, error) {
+	var defaultLocale string
+	if tag := strings.TrimSpace(pl.GetDefaultLocale()); tag != "" {
+		normalized, err := util.NormalizeLocale(tag)
+		if err != nil {
+			return "", "", fmt.Errorf("%w: %v", ErrPipelineLocaleInvalid, err)
+		}
+		defaultLocale = normalized
+	}
+	locales, err := util.ParseLocaleList(strings.Join(pl.GetLocales(), ","))
+	if err != nil {
+		return "", "", fmt.Errorf("%w: %v", ErrPipelineLocaleInvalid, err)
+	}
+	others := make([]string, 0, len(locales))
+	for _, locale := range locales {
+		if locale != defaultLocale {
+			others = append(others, locale)
+		}
+	}
+	if len(others) > 0 && defaultLocale == "" {
+		return "", "", fmt.Errorf("%w: default_locale is required with locales", ErrPipelineLocaleInvalid)
+	}
+	return defaultLocale, strings.Join(others, ","), nil
+}
+
+func splitPipelineLocales(raw string) []string {
+	if raw == "" {
+		return nil
 	}
+	return strings.Split(raw, ",")
 }
 
 func pipelineSliceToPB(pls []model.Pipeline) []*plpb.Pipeline {
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	releasepb "github.com/yi-nology/rainbow_bridge/biz/model/release"
+)
+
+var ErrReleaseVersionRequired = errors.New("version is required")
+
+// --------------------- Release operations ---------------------
+
+// PublishRelease freezes the current configs of an environment/pipeline into a
+// new active release. In environments that require approval it is queued as a
+// change request, and so is activating a release.
+func (s *Service) PublishRelease(ctx context.Context, environmentKey, pipelineKey, description string) (*releasepb.ConfigRelease, error) {
+	if environmentKey == "" {
+		return nil, ErrEnvironmentKeyRequired
+	}
+	if pipelineKey == "" {
+		return nil, ErrPipelineKeyRequired
+	}
+	summary := fmt.Sprintf("发布 %s/%s 新版本", environmentKey, pipelineKey)
+	change := &releaseChange{EnvironmentKey: environmentKey, PipelineKey: pipelineKey, Description: description}
+	if err := s.queueReleaseChange(ctx, model.ChangeRequestReleasePublish, summary, change); err != nil {
+		return nil, err
+	}
+	release, err := s.logic.PublishRelease(ctx, environmentKey, pipelineKey, description)
+	if err != nil {
+		return nil, err
+	}
+	return s.releaseModelToPB(ctx, release, false)
+}
+
+// ListReleases returns the releases of an environment/pipeline without their configs.
+func (s *Service) ListReleases(ctx context.Context, environmentKey, pipelineKey string, page, pageSize int) ([]*releasepb.ConfigRelease, int64, error) {
+	if environmentKey == "" {
+		return nil, 0, ErrEnvironmentKeyRequired
+	}
+	if pipelineKey == "" {
+		return nil, 0, ErrPipelineKeyRequired
+	}
+	releases, total, err := s.logic.ListReleases(ctx, environmentKey, pipelineKey, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	list := make([]*releasepb.ConfigRelease, 0, len(releases))
+	for i := range releases {
+		item, err := s.releaseModelToPB(ctx, &releases[i], false)
+		if err != nil {
+			return nil, 0, err
+		}
+		list = append(list, item)
+	}
+	return list, total, nil
+}
+
+// GetRelease returns a release together with the configs frozen in it.
+func (s *Service) GetRelease(ctx context.Context, environmentKey, pipelineKey string, version int) (*releasepb.ConfigRelease, error) {
+	if environmentKey == "" {
+		return nil, ErrEnvironmentKeyRequired
+	}
+	if pipelineKey == "" {
+		return nil, ErrPipelineKeyRequired
+	}
+	if version <= 0 {
+		return nil, ErrReleaseVersionRequired
+	}
+	release, err := s.logic.GetRelease(ctx, environmentKey, pipelineKey, version)
+	if err != nil {
+		return nil, err
+	}
+	return s.releaseModelToPB(ctx, release, true)
+}
+
+// CompareReleases diffs two releases; version 0 stands for the unpublished working configs.
+func (s *Service) CompareReleases(ctx context.Context, environmentKey, pipelineKey string, fromVersion, toVersion int) ([]*releasepb.ReleaseConfigDiff, error) {
+	if environmentKey == "" {
+		return nil, ErrEnvironmentKeyRequired
+	}
+	if pipelineKey == "" {
+		return nil, ErrPipelineKeyRequired
+	}
+	if fromVersion < 0 || toVersion < 0 {
+		return nil, ErrReleaseVersionRequired
+	}
+	diffs, err := s.logic.CompareReleases(ctx, environmentKey, pipelineKey, fromVersion, toVersion)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*releasepb.ReleaseConfigDiff, 0, len(diffs))
+	for _, diff := range diffs {
+		list = append(list, &releasepb.ReleaseConfigDiff{
+			ResourceKey: diff.ResourceKey,
+			Alias:       diff.Alias,
+			Change:      diff.Change,
+			Before:      s.decorateConfig(modelConfigToPB(diff.Before)),
+			After:       s.decorateConfig(modelConfigToPB(diff.After)),
+		})
+	}
+	return list, nil
+}
+
+// ActivateRelease makes an existing release the one served to runtime clients.
+func (s *Service) ActivateRelease(ctx context.Context, environmentKey, pipelineKey string, version int) (*releasepb.ConfigRelease, error) {
+	if environmentKey == "" {
+		return nil, ErrEnvironmentKeyRequired
+	}
+	if pipelineKey == "" {
+		return nil, ErrPipelineKeyRequired
+	}
+	if version <= 0 {
+		return nil, ErrReleaseVersionRequired
+	}
+	summary := fmt.Sprintf("切换 %s/%s 至版本 %d", environmentKey, pipelineKey, version)
+	change := &releaseChange{EnvironmentKey: environmentKey, PipelineKey: pipelineKey, Version: version}
+	if err := s.queueReleaseChange(ctx, model.ChangeRequestReleaseActivate, summary, change); err != nil {
+		return nil, err
+	}
+	release, err := s.logic.ActivateRelease(ctx, environmentKey, pipelineKey, version)
+	if err != nil {
+		return nil, err
+	}
+	return s.releaseModelToPB(ctx, release, false)
+}
+
+// queueReleaseChange turns a release write to an environment that requires
+// approval into a change request, like queueRolloutChange; it returns nil when
+// the write may go ahead.
+func (s *Service) queueReleaseChange(ctx context.Context, operation, summary string, change *releaseChange) error {
+	required, err := s.logic.approvalEnvironments(ctx, change.EnvironmentKey)
+	if err != nil || len(required) == 0 {
+		return err
+	}
+	return s.submitChangeRequest(ctx, operation, summary, &changeRequestPayload{Release: change}, required)
+}
+
+// releaseModelToPB converts a release; withConfigs adds the configs frozen in
+// it that are visible to the caller.
+func (s *Service) releaseModelToPB(ctx context.Context, release *model.ConfigRelease, withConfigs bool) (*releasepb.ConfigRelease, error) {
+	item := &releasepb.ConfigRelease{
+		Id:             int64(release.ID),
+		EnvironmentKey: release.EnvironmentKey,
+		PipelineKey:    release.PipelineKey,
+		Version:        int32(release.Version),     // #nosec G115 -- release versions fit in int32
+		ConfigCount:    int32(release.ConfigCount), // #nosec G115 -- count will not exceed int32
+		Description:    release.Description,
+		IsActive:       release.IsActive,
+		OperatorId:     int32(release.OperatorID), // #nosec G115 -- user IDs fit in int32
+		OperatorName:   release.OperatorName,
+		CreatedAt:      release.CreatedAt.Format(time.RFC3339),
+	}
+	if release.ActivatedAt != nil {
+		item.ActivatedAt = release.ActivatedAt.Format(time.RFC3339)
+	}
+	if withConfigs {
+		configs, err := decodeReleaseSnapshot(release)
+		if err != nil {
+			return nil, err
+		}
+		item.Configs = s.decorateConfigList(configSliceToPB(configVisibilityFor(ctx).filter(configs)))
+	}
+	return item, nil
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	rolloutpb "github.com/yi-nology/rainbow_bridge/biz/model/rollout"
+)
+
+var ErrRolloutIDRequired = errors.New("id is required")
+
+// --------------------- Rollout operations ---------------------
+
+// CreateRollout starts a percentage-based rollout of a candidate config value.
+// In environments that require approval it is queued as a change request, and
+// so are ramps and promotions.
+func (s *Service) CreateRollout(ctx context.Context, req *rolloutpb.CreateRolloutRequest) (*rolloutpb.ConfigRollout, error) {
+	if req == nil {
+		return nil, errors.New("rollout payload required")
+	}
+	if req.EnvironmentKey == "" {
+		return nil, ErrEnvironmentKeyRequired
+	}
+	if req.PipelineKey == "" {
+		return nil, ErrPipelineKeyRequired
+	}
+	if req.ResourceKey == "" && req.Alias == "" {
+		return nil, errors.New("alias or resource_key is required")
+	}
+	input := &RolloutInput{
+		EnvironmentKey:   req.EnvironmentKey,
+		PipelineKey:      req.PipelineKey,
+		Alias:            req.Alias,
+		ResourceKey:      req.ResourceKey,
+		CandidateContent: req.CandidateContent,
+		Percentage:       int(req.Percentage),
+		BucketHeader:     req.BucketHeader,
+	}
+	if err := s.queueRolloutChange(ctx, model.ChangeRequestRolloutCreate, input.EnvironmentKey, "", &rolloutChange{Input: input}); err != nil {
+		return nil, err
+	}
+	rollout, err := s.logic.CreateRollout(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return rolloutModelToPB(rollout), nil
+}
+
+// ListRollouts returns the rollouts of an environment/pipeline, optionally filtered by status.
+func (s *Service) ListRollouts(ctx context.Context, environmentKey, pipelineKey, status string) ([]*rolloutpb.ConfigRollout, error) {
+	if environmentKey == "" {
+		return nil, ErrEnvironmentKeyRequired
+	}
+	if pipelineKey == "" {
+		return nil, ErrPipelineKeyRequired
+	}
+	rollouts, err := s.logic.ListRollouts(ctx, environmentKey, pipelineKey, status)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*rolloutpb.ConfigRollout, 0, len(rollouts))
+	for i := range rollouts {
+		list = append(list, rolloutModelToPB(&rollouts[i]))
+	}
+	return list, nil
+}
+
+// RampRollout changes the percentage of a running or paused rollout.
+func (s *Service) RampRollout(ctx context.Context, id int64, percentage int32) (*rolloutpb.ConfigRollout, error) {
+	if id <= 0 {
+		return nil, ErrRolloutIDRequired
+	}
+	current, err := s.logic.getRollout(ctx, uint(id))
+	if err != nil {
+		return nil, err
+	}
+	summary := fmt.Sprintf("调整灰度 %s（%s/%s）至 %d%%", current.Alias, current.EnvironmentKey, current.PipelineKey, percentage)
+	if err := s.queueRolloutChange(ctx, model.ChangeRequestRolloutRamp, current.EnvironmentKey, summary, &rolloutChange{ID: current.ID, Percentage: int(percentage)}); err != nil {
+		return nil, err
+	}
+	rollout, err := s.logic.RampRollout(ctx, uint(id), int(percentage))
+	if err != nil {
+		return nil, err
+	}
+	return rolloutModelToPB(rollout), nil
+}
+
+// PauseRollout stops serving the candidate until the rollout is ramped again.
+func (s *Service) PauseRollout(ctx context.Context, id int64) (*rolloutpb.ConfigRollout, error) {
+	if id <= 0 {
+		return nil, ErrRolloutIDRequired
+	}
+	rollout, err := s.logic.PauseRollout(ctx, uint(id))
+	if err != nil {
+		return nil, err
+	}
+	return rolloutModelToPB(rollout), nil
+}
+
+// PromoteRollout writes the candidate into the config for everyone.
+func (s *Service) PromoteRollout(ctx context.Context, id int64) (*rolloutpb.ConfigRollout, error) {
+	if id <= 0 {
+		return nil, ErrRolloutIDRequired
+	}
+	current, err := s.logic.getRollout(ctx, uint(id))
+	if err != nil {
+		return nil, err
+	}
+	if err := s.queueRolloutChange(ctx, model.ChangeRequestRolloutPromote, current.EnvironmentKey, "", &rolloutChange{ID: current.ID}); err != nil {
+		return nil, err
+	}
+	rollout, err := s.logic.PromoteRollout(ctx, uint(id))
+	if err != nil {
+		return nil, err
+	}
+	return rolloutModelToPB(rollout), nil
+}
+
+// AbortRollout discards the candidate.
+func (s *Service) AbortRollout(ctx context.Context, id int64) (*rolloutpb.ConfigRollout, error) {
+	if id <= 0 {
+		return nil, ErrRolloutIDRequired
+	}
+	rollout, err := s.logic.AbortRollout(ctx, uint(id))
+	if err != nil {
+		return nil, err
+	}
+	return rolloutModelToPB(rollout), nil
+}
+
+// queueRolloutChange turns a rollout write to an environment that requires
+// approval into a change request, like queueConfigChange; it returns nil when
+// the write may go ahead.
+func (s *Service) queueRolloutChange(ctx context.Context, operation, environmentKey, summary string, change *rolloutChange) error {
+	required, err := s.logic.approvalEnvironments(ctx, environmentKey)
+	if err != nil || len(required) == 0 {
+		return err
+	}
+	return s.submitChangeRequest(ctx, operation, summary, &changeRequestPayload{Rollout: change}, required)
+}
+
+func rolloutModelToPB(rollout *model.ConfigRollout) *rolloutpb.ConfigRollout {
+	return &rolloutpb.ConfigRollout{
+		Id:               int64(rollout.ID),
+		EnvironmentKey:   rollout.EnvironmentKey,
+		PipelineKey:      rollout.PipelineKey,
+		ResourceKey:      rollout.ResourceKey,
+		Alias:            rollout.Alias,
+		CandidateContent: maskSecretContent(rollout.CandidateContent),
+		Percentage:       int32(rollout.Percentage), // #nosec G115 -- percentage is within [0, 100]
+		BucketHeader:     rollout.BucketHeader,
+		Status:           rollout.Status,
+		OperatorName:     rollout.OperatorName,
+		CreatedAt:        rollout.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:        rollout.UpdatedAt.Format(time.RFC3339),
+	}
+}
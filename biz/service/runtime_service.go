@@ -4,6 +4,7 @@ import (
 	"archive/zip"
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -12,15 +13,24 @@ import (
 	"path"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/minio/minio-go/v7"
 	"github.com/yi-nology/rainbow_bridge/biz/model/common"
 	"github.com/yi-nology/rainbow_bridge/biz/model/runtime"
+	pkgcommon "github.com/yi-nology/rainbow_bridge/pkg/common"
 	"gorm.io/gorm"
 )
 
+// Bounds of the wait of a runtime watch.
+const (
+	defaultRuntimeWatchTimeout = 30 * time.Second
+	maxRuntimeWatchTimeout     = 60 * time.Second
+)
+
 // --------------------- Runtime operations ---------------------
 
 // GetRuntimeOverview returns all environments and their pipelines.
@@ -63,7 +73,52 @@ func (s *Service) GetRuntimeOverview(ctx context.Context) (*runtime.RuntimeOverv
 	}, nil
 }
 
+// GetSigningKeys returns the public keys runtime payload signatures verify
+// with, the current key first. The list is empty when signing is disabled.
+func (s *Service) GetSigningKeys(ctx context.Context) (*runtime.SigningKeysResponse, error) {
+	keys := make([]*runtime.SigningKey, 0)
+	if s.signer != nil {
+		for _, key := range s.signer.Keys() {
+			keys = append(keys, &runtime.SigningKey{
+				KeyId:     key.KeyID,
+				Algorithm: pkgcommon.SigningAlgorithm,
+				PublicKey: base64.StdEncoding.EncodeToString(key.PublicKey),
+				Current:   key.Current,
+			})
+		}
+	}
+	return &runtime.SigningKeysResponse{
+		Code: 200,
+		Msg:  "OK",
+		Data: &runtime.SigningKeysData{Keys: keys},
+	}, nil
+}
+
+// SignRuntimeData signs the RFC 8785 canonical JSON of data, the data object
+// of a runtime config as sent to clients. It returns nil when signing is disabled.
+func (s *Service) SignRuntimeData(data any) (*runtime.RuntimeSignature, error) {
+	if s.signer == nil {
+		return nil, nil
+	}
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	canonical, err := pkgcommon.CanonicalJSON(encoded)
+	if err != nil {
+		return nil, err
+	}
+	return &runtime.RuntimeSignature{
+		KeyId:     s.signer.KeyID(),
+		Algorithm: pkgcommon.SigningAlgorithm,
+		Signature: s.signer.Sign(canonical),
+	}, nil
+}
+
 // GetRuntimeConfig returns runtime configuration with environment info.
+// Every successful read refreshes the last known good snapshot of the
+// pipeline; while the database is unavailable the snapshot is served instead,
+// marked stale.
 func (s *Service) GetRuntimeConfig(ctx context.Context, environmentKey, pipelineKey string) (*runtime.RuntimeConfigResponse, error) {
 	if environmentKey == "" {
 		return nil, errors.New("x-environment header is required")
@@ -72,52 +127,52 @@ func (s *Service) GetRuntimeConfig(ctx context.Context, environmentKey, pipeline
 		return nil, errors.New("x-pipeline header is required")
 	}
 
-	// 验证环境是否存在
-	env, err := s.logic.environmentDAO.GetByKey(ctx, s.logic.db, environmentKey)
+	// 加载环境、渠道与已发布的业务配置（未发布过时回退到当前配置）
+	snapshot, err := s.logic.LoadRuntimeSnapshot(ctx, environmentKey, pipelineKey)
+	stale := false
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("environment not found: %s", environmentKey)
+		if !runtimeSourceUnavailable(err) {
+			return nil, err
 		}
-		return nil, err
-	}
-
-	// 验证渠道是否存在
-	pipeline, err := s.logic.pipelineDAO.GetByKey(ctx, s.logic.db, environmentKey, pipelineKey)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("pipeline not found: %s/%s", environmentKey, pipelineKey)
+		// 数据库不可用时返回最近一次成功读取的快照
+		snapshot = s.logic.lastRuntimeSnapshot(environmentKey, pipelineKey)
+		if snapshot == nil {
+			return nil, err
 		}
-		return nil, err
-	}
-
-	// 查询业务配置
-	configs, err := s.logic.ListConfigs(ctx, environmentKey, pipelineKey, "", "", "", false)
-	if err != nil {
-		return nil, err
+		hlog.Warnf("serve stale runtime config of %s/%s taken at %s: %v", environmentKey, pipelineKey, snapshot.TakenAt.Format(time.RFC3339), err)
+		stale = true
 	}
 
 	// 装饰配置列表（处理资源引用）
-	decoratedConfigs := s.decorateConfigList(configSliceToPB(configs))
+	decoratedConfigs := s.decorateConfigList(configSliceToPB(s.logic.renderRuntimeConfigs(ctx, &snapshot.runtimeSource)))
 
 	// 组装响应
-	return &runtime.RuntimeConfigResponse{
+	resp := &runtime.RuntimeConfigResponse{
 		Code: 200,
 		Msg:  "OK",
 		Data: &runtime.RuntimeConfigData{
 			Configs: decoratedConfigs,
 			Environment: &runtime.EnvironmentInfo{
-				EnvironmentKey:  env.EnvironmentKey,
-				EnvironmentName: env.EnvironmentName,
-				PipelineKey:     pipeline.PipelineKey,
-				PipelineName:    pipeline.PipelineName,
+				EnvironmentKey:  snapshot.EnvironmentKey,
+				EnvironmentName: snapshot.EnvironmentName,
+				PipelineKey:     snapshot.PipelineKey,
+				PipelineName:    snapshot.PipelineName,
 			},
+			Revision: snapshot.Revision,
 		},
-	}, nil
+	}
+	if stale {
+		resp.Stale = true
+		resp.SnapshotAt = snapshot.TakenAt.Format(time.RFC3339)
+	}
+	return resp, nil
 }
 
-// writeRuntimeConfigArchive creates a zip archive with config.json and asset files.
-// The config.json structure matches the RuntimeConfigResponse format.
-func (s *Service) ExportStaticPackage(ctx context.Context, environmentKey, pipelineKey string) ([]byte, string, error) {
+// ExportStaticPackage creates a zip archive with config.json and asset files.
+// The config.json structure matches the RuntimeConfigResponse format. With
+// perLocale, config.<locale>.json is added for every locale of the pipeline,
+// see pipelineLocales.
+func (s *Service) ExportStaticPackage(ctx context.Context, environmentKey, pipelineKey string, perLocale bool) ([]byte, string, error) {
 	if environmentKey == "" {
 		return nil, "", errors.New("environment_key is required")
 	}
@@ -143,8 +198,8 @@ func (s *Service) ExportStaticPackage(ctx context.Context, environmentKey, pipel
 		return nil, "", err
 	}
 
-	// 查询业务配置
-	configs, err := s.logic.ListConfigs(ctx, environmentKey, pipelineKey, "", "", "", false)
+	// 查询已发布的业务配置（未发布过时回退到当前配置），config.json 使用渠道默认语言
+	configs, err := s.logic.ListRuntimeConfigs(pkgcommon.ContextWithLocales(ctx, nil), environmentKey, pipelineKey)
 	if err != nil {
 		return nil, "", err
 	}
@@ -153,18 +208,37 @@ func (s *Service) ExportStaticPackage(ctx context.Context, environmentKey, pipel
 	decoratedConfigs := s.decorateConfigList(configSliceToPB(configs))
 
 	// 构建与 RuntimeConfigResponse 一致的数据结构
+	environment := &runtime.EnvironmentInfo{
+		EnvironmentKey:  env.EnvironmentKey,
+		EnvironmentName: env.EnvironmentName,
+		PipelineKey:     pipeline.PipelineKey,
+		PipelineName:    pipeline.PipelineName,
+	}
 	runtimeData := &runtime.RuntimeConfigData{
-		Configs: decoratedConfigs,
-		Environment: &runtime.EnvironmentInfo{
-			EnvironmentKey:  env.EnvironmentKey,
-			EnvironmentName: env.EnvironmentName,
-			PipelineKey:     pipeline.PipelineKey,
-			PipelineName:    pipeline.PipelineName,
-		},
+		Configs:     decoratedConfigs,
+		Environment: environment,
+	}
+
+	// 按语言分别生成配置
+	var localized []localizedRuntimeConfig
+	if perLocale {
+		for _, locale := range pipelineLocales(pipeline, configs) {
+			localeConfigs, err := s.logic.ListRuntimeConfigs(pkgcommon.ContextWithLocales(ctx, []string{locale}), environmentKey, pipelineKey)
+			if err != nil {
+				return nil, "", err
+			}
+			localized = append(localized, localizedRuntimeConfig{
+				Locale: locale,
+				Data: &runtime.RuntimeConfigData{
+					Configs:     s.decorateConfigList(configSliceToPB(localeConfigs)),
+					Environment: environment,
+				},
+			})
+		}
 	}
 
 	// 生成 zip 包
-	data, err := s.writeRuntimeConfigArchive(ctx, runtimeData)
+	data, err := s.writeRuntimeConfigArchive(ctx, runtimeData, localized)
 	if err != nil {
 		return nil, "", err
 	}
@@ -174,92 +248,33 @@ func (s *Service) ExportStaticPackage(ctx context.Context, environmentKey, pipel
 	return data, filename, nil
 }
 
-// writeRuntimeConfigArchive creates a zip archive with config.json and asset files.
-// The config.json structure matches RuntimeConfigData format.
-func (s *Service) writeRuntimeConfigArchive(ctx context.Context, runtimeData *runtime.RuntimeConfigData) ([]byte, error) {
+// localizedRuntimeConfig is the runtime config of a pipeline served in one locale.
+type localizedRuntimeConfig struct {
+	Locale string
+	Data   *runtime.RuntimeConfigData
+}
+
+// writeRuntimeConfigArchive creates a zip archive with config.json, a
+// config.<locale>.json per localized config, and asset files.
+// The config.json structure matches RuntimeConfigData format. When signing is
+// enabled, every config file is followed by a <name>.sig holding the
+// RuntimeSignature of its data object.
+func (s *Service) writeRuntimeConfigArchive(ctx context.Context, runtimeData *runtime.RuntimeConfigData, localized []localizedRuntimeConfig) ([]byte, error) {
 	buf := &bytes.Buffer{}
 	zipWriter := zip.NewWriter(buf)
 
-	// 自定义响应结构，处理 JSON 对象
-	type CustomResourceConfig struct {
-		ResourceKey    string      `json:"resource_key"`
-		Alias          string      `json:"alias"`
-		Name           string      `json:"name"`
-		EnvironmentKey string      `json:"environment_key"`
-		PipelineKey    string      `json:"pipeline_key"`
-		Content        interface{} `json:"content"`
-		Type           string      `json:"type"`
-		Remark         string      `json:"remark"`
-		IsPerm         bool        `json:"is_perm"`
-	}
-
-	type CustomRuntimeConfigData struct {
-		Configs     []CustomResourceConfig   `json:"configs"`
-		Environment *runtime.EnvironmentInfo `json:"environment"`
-	}
-
-	type CustomRuntimeConfigResponse struct {
-		Code int32                   `json:"code"`
-		Msg  string                  `json:"msg"`
-		Data CustomRuntimeConfigData `json:"data"`
-	}
-
-	// 转换配置数据
-	customConfigs := make([]CustomResourceConfig, len(runtimeData.Configs))
-	for i, cfg := range runtimeData.Configs {
-		customConfig := CustomResourceConfig{
-			ResourceKey:    cfg.ResourceKey,
-			Alias:          cfg.Alias,
-			Name:           cfg.Name,
-			EnvironmentKey: cfg.EnvironmentKey,
-			PipelineKey:    cfg.PipelineKey,
-			Type:           cfg.Type,
-			Remark:         cfg.Remark,
-			IsPerm:         cfg.IsPerm,
-		}
-
-		// 对于 object 和 keyvalue 类型，解析为 JSON 对象
-		normalizedType := normalizeConfigTypeString(cfg.Type)
-		if normalizedType == "object" || normalizedType == "keyvalue" {
-			var content interface{}
-			if err := json.Unmarshal([]byte(cfg.Content), &content); err == nil {
-				customConfig.Content = content
-			} else {
-				customConfig.Content = cfg.Content
-			}
-		} else {
-			customConfig.Content = cfg.Content
-		}
-
-		customConfigs[i] = customConfig
-	}
-
-	// 构建自定义响应
-	customResponse := CustomRuntimeConfigResponse{
-		Code: 200,
-		Msg:  "OK",
-		Data: CustomRuntimeConfigData{
-			Configs:     customConfigs,
-			Environment: runtimeData.Environment,
-		},
-	}
-
-	// 序列化为 JSON
-	configData, err := json.MarshalIndent(customResponse, "", "  ")
-	if err != nil {
-		return nil, err
-	}
-
-	metaWriter, err := zipWriter.Create("config.json")
-	if err != nil {
-		return nil, err
-	}
-	if _, err := metaWriter.Write(configData); err != nil {
+	if err := s.writeRuntimeConfigFile(zipWriter, "config.json", runtimeData); err != nil {
 		return nil, err
 	}
 
 	// 提取配置中引用的资源文件 ID
 	assetIDs := extractAssetIDsFromCommonConfigs(runtimeData.Configs)
+	for _, item := range localized {
+		if err := s.writeRuntimeConfigFile(zipWriter, "config."+item.Locale+".json", item.Data); err != nil {
+			return nil, err
+		}
+		assetIDs = append(assetIDs, extractAssetIDsFromCommonConfigs(item.Data.Configs)...)
+	}
 	visited := make(map[string]struct{}, len(assetIDs))
 
 	// 构建 files 目录前缀：<base_path>/api/v1/asset/file/
@@ -336,6 +351,143 @@ func (s *Service) writeRuntimeConfigArchive(ctx context.Context, runtimeData *ru
 	return buf.Bytes(), nil
 }
 
+// writeRuntimeConfigFile adds a runtime config file and, when signing is
+// enabled, its detached signature to the archive.
+func (s *Service) writeRuntimeConfigFile(zipWriter *zip.Writer, name string, runtimeData *runtime.RuntimeConfigData) error {
+	configData, err := marshalRuntimeConfigData(runtimeData)
+	if err != nil {
+		return err
+	}
+	writer, err := zipWriter.Create(name)
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(configData); err != nil {
+		return err
+	}
+	if s.signer == nil {
+		return nil
+	}
+
+	// 对文件中的 data 对象签名，与运行时接口的签名范围一致
+	var file struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(configData, &file); err != nil {
+		return err
+	}
+	signature, err := s.SignRuntimeData(file.Data)
+	if err != nil {
+		return err
+	}
+	signatureData, err := json.MarshalIndent(signature, "", "  ")
+	if err != nil {
+		return err
+	}
+	writer, err = zipWriter.Create(name + ".sig")
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write(signatureData)
+	return err
+}
+
+// marshalRuntimeConfigData encodes runtime configs the way config.json stores them.
+func marshalRuntimeConfigData(runtimeData *runtime.RuntimeConfigData) ([]byte, error) {
+	// 自定义响应结构，处理 JSON 对象
+	type CustomResourceConfig struct {
+		ResourceKey    string      `json:"resource_key"`
+		Alias          string      `json:"alias"`
+		Name           string      `json:"name"`
+		EnvironmentKey string      `json:"environment_key"`
+		PipelineKey    string      `json:"pipeline_key"`
+		Content        interface{} `json:"content"`
+		Type           string      `json:"type"`
+		Remark         string      `json:"remark"`
+		IsPerm         bool        `json:"is_perm"`
+		Origin         string      `json:"origin"`
+		// 重命名后仍以旧别名下发的配置
+		Deprecated      bool   `json:"deprecated,omitempty"`
+		RenamedTo       string `json:"renamed_to,omitempty"`
+		DeprecatedUntil string `json:"deprecated_until,omitempty"`
+		// 多语言配置实际下发的语言
+		Locale string `json:"locale,omitempty"`
+	}
+
+	type CustomRuntimeConfigData struct {
+		Configs     []CustomResourceConfig   `json:"configs"`
+		Environment *runtime.EnvironmentInfo `json:"environment"`
+	}
+
+	type CustomRuntimeConfigResponse struct {
+		Code int32                   `json:"code"`
+		Msg  string                  `json:"msg"`
+		Data CustomRuntimeConfigData `json:"data"`
+	}
+
+	// 转换配置数据
+	customConfigs := make([]CustomResourceConfig, len(runtimeData.Configs))
+	for i, cfg := range runtimeData.Configs {
+		customConfig := CustomResourceConfig{
+			ResourceKey:     cfg.ResourceKey,
+			Alias:           cfg.Alias,
+			Name:            cfg.Name,
+			EnvironmentKey:  cfg.EnvironmentKey,
+			PipelineKey:     cfg.PipelineKey,
+			Type:            cfg.Type,
+			Remark:          cfg.Remark,
+			IsPerm:          cfg.IsPerm,
+			Origin:          cfg.Origin,
+			Deprecated:      cfg.Deprecated,
+			RenamedTo:       cfg.RenamedTo,
+			DeprecatedUntil: cfg.DeprecatedUntil,
+			Locale:          cfg.Locale,
+		}
+
+		// 对象、数值与布尔类型按原生 JSON 输出
+		customConfig.Content = TypedConfigContent(cfg.Type, cfg.Content)
+
+		customConfigs[i] = customConfig
+	}
+
+	// 构建自定义响应
+	customResponse := CustomRuntimeConfigResponse{
+		Code: 200,
+		Msg:  "OK",
+		Data: CustomRuntimeConfigData{
+			Configs:     customConfigs,
+			Environment: runtimeData.Environment,
+		},
+	}
+
+	// 序列化为 JSON
+	return json.MarshalIndent(customResponse, "", "  ")
+}
+
+// TypedConfigContent returns config content as the value clients should receive:
+// object and keyvalue content as parsed JSON, number and decimal content as a JSON
+// number, boolean content as a bool, and everything else as the raw string.
+// Content that does not parse for its type is returned unchanged.
+func TypedConfigContent(configType, content string) any {
+	switch normalizeConfigTypeString(configType) {
+	case "object", "keyvalue":
+		var value any
+		if err := json.Unmarshal([]byte(content), &value); err == nil {
+			return value
+		}
+	case "number", "decimal":
+		var value json.Number
+		if err := json.Unmarshal([]byte(content), &value); err == nil {
+			return value
+		}
+	case "boolean":
+		if value, err := strconv.ParseBool(content); err == nil {
+			return value
+		}
+	}
+	return content
+}
+
 // extractAssetIDsFromCommonConfigs extracts asset IDs from common.ResourceConfig list
 func extractAssetIDsFromCommonConfigs(configs []*common.ResourceConfig) []string {
 	var ids []string
@@ -368,3 +520,59 @@ func buildFilesPrefix(basePath string) string {
 	cleanBase := strings.TrimPrefix(basePath, "/")
 	return path.Join(cleanBase, "api", "v1", "asset", "file")
 }
+
+// RuntimeConfigValidator returns the ETag and Last-Modified time of the
+// runtime configs GetRuntimeConfig returns for ctx; see Logic.RuntimeConfigValidator.
+func (s *Service) RuntimeConfigValidator(ctx context.Context, environmentKey, pipelineKey string) (*RuntimeValidator, error) {
+	if err := s.logic.ensurePipelineExists(ctx, environmentKey, pipelineKey); err != nil {
+		return nil, err
+	}
+	return s.logic.RuntimeConfigValidator(ctx, environmentKey, pipelineKey)
+}
+
+// WatchRuntimeChanges waits up to timeoutSeconds (default 30, at most 60) for
+// the runtime configs of an environment/pipeline to change after revision;
+// see Logic.WatchRuntimeChanges.
+func (s *Service) WatchRuntimeChanges(ctx context.Context, environmentKey, pipelineKey string, revision int64, timeoutSeconds int32) (*runtime.RuntimeWatchData, error) {
+	if err := s.logic.ensurePipelineExists(ctx, environmentKey, pipelineKey); err != nil {
+		return nil, err
+	}
+	timeout := defaultRuntimeWatchTimeout
+	if timeoutSeconds > 0 {
+		timeout = time.Duration(timeoutSeconds) * time.Second
+	}
+	if timeout > maxRuntimeWatchTimeout {
+		timeout = maxRuntimeWatchTimeout
+	}
+
+	changes, err := s.logic.WatchRuntimeChanges(ctx, environmentKey, pipelineKey, revision, timeout)
+	if err != nil {
+		return nil, err
+	}
+	return &runtime.RuntimeWatchData{
+		Revision: changes.Revision,
+		Changed:  changes.Changed,
+		Aliases:  changes.Aliases,
+		Full:     changes.Full,
+	}, nil
+}
+
+// ChangeStream delivers the messages of a change stream to a client.
+type ChangeStream interface {
+	// Open starts the response; it is called once the request is validated.
+	Open() error
+	Send(msg *ChangeStreamMessage) error
+}
+
+// StreamChangeEvents streams the config and asset changes of an
+// environment/pipeline to stream; see Logic.StreamChangeEvents. Errors
+// returned before stream is opened concern the request.
+func (s *Service) StreamChangeEvents(ctx context.Context, environmentKey, pipelineKey string, lastEventID int64, resume bool, stream ChangeStream) error {
+	if err := s.logic.ensurePipelineExists(ctx, environmentKey, pipelineKey); err != nil {
+		return err
+	}
+	if err := stream.Open(); err != nil {
+		return err
+	}
+	return s.logic.StreamChangeEvents(ctx, environmentKey, pipelineKey, lastEventID, resume, stream.Send)
+}
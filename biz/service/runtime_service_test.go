@@ -0,0 +1,210 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/db"
+	"github.com/yi-nology/rainbow_bridge/biz/model/common"
+	envpb "github.com/yi-nology/rainbow_bridge/biz/model/environment"
+	"github.com/yi-nology/rainbow_bridge/biz/model/runtime"
+	pkgcommon "github.com/yi-nology/rainbow_bridge/pkg/common"
+	"github.com/yi-nology/rainbow_bridge/pkg/config"
+)
+
+// TestStaticPackageSignature checks that the config.json of a static package
+// verifies against the published signing key.
+func TestStaticPackageSignature(t *testing.T) {
+	gdb := db.SetupTestDB(t)
+	defer db.CleanupTestDB(t, gdb)
+	s := NewService(gdb, nil, "", &config.Config{})
+
+	ctx := pkgcommon.ContextWithUserID(context.Background(), 1)
+	if err := s.AddEnvironment(ctx, &envpb.Environment{EnvironmentKey: "prod", EnvironmentName: "Prod", IsActive: true}); err != nil {
+		t.Fatalf("AddEnvironment failed: %v", err)
+	}
+	if _, err := s.AddConfig(ctx, &common.ResourceConfig{
+		EnvironmentKey: "prod", PipelineKey: "default", Name: "Limits", Alias: "limits", Type: "object", Content: `{"rate": 1.50, "burst": 10}`,
+	}); err != nil {
+		t.Fatalf("AddConfig failed: %v", err)
+	}
+
+	archive, _, err := s.ExportStaticPackage(ctx, "prod", "default", false)
+	if err != nil {
+		t.Fatalf("ExportStaticPackage failed: %v", err)
+	}
+	if _, ok := readZipFiles(t, archive)["config.json.sig"]; ok {
+		t.Fatal("expected no signature while signing is disabled")
+	}
+	keys, err := s.GetSigningKeys(ctx)
+	if err != nil || len(keys.Data.Keys) != 0 {
+		t.Fatalf("GetSigningKeys = %v, %v; want no keys", keys, err)
+	}
+
+	signer, err := pkgcommon.NewPayloadSigner(bytes.Repeat([]byte{7}, ed25519.SeedSize))
+	if err != nil {
+		t.Fatalf("NewPayloadSigner failed: %v", err)
+	}
+	s.SetPayloadSigner(signer)
+	archive, _, err = s.ExportStaticPackage(ctx, "prod", "default", false)
+	if err != nil {
+		t.Fatalf("ExportStaticPackage failed: %v", err)
+	}
+	files := readZipFiles(t, archive)
+
+	var signature runtime.RuntimeSignature
+	if err := json.Unmarshal(files["config.json.sig"], &signature); err != nil {
+		t.Fatalf("decode config.json.sig: %v", err)
+	}
+	keys, err = s.GetSigningKeys(ctx)
+	if err != nil || len(keys.Data.Keys) != 1 {
+		t.Fatalf("GetSigningKeys = %v, %v; want one key", keys, err)
+	}
+	key := keys.Data.Keys[0]
+	if signature.KeyId != key.KeyId || signature.Algorithm != pkgcommon.SigningAlgorithm || !key.Current {
+		t.Fatalf("signature %+v does not match key %+v", &signature, key)
+	}
+	public, err := base64.StdEncoding.DecodeString(key.PublicKey)
+	if err != nil {
+		t.Fatalf("decode public key: %v", err)
+	}
+
+	// 客户端按 RFC 8785 规范化收到的 data 对象后校验
+	var file struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(files["config.json"], &file); err != nil {
+		t.Fatalf("decode config.json: %v", err)
+	}
+	canonical, err := pkgcommon.CanonicalJSON(file.Data)
+	if err != nil {
+		t.Fatalf("CanonicalJSON failed: %v", err)
+	}
+	if !pkgcommon.VerifyPayload(public, canonical, signature.Signature) {
+		t.Fatalf("signature does not verify over %s", canonical)
+	}
+	tampered := bytes.Replace(canonical, []byte(`"burst":10`), []byte(`"burst":11`), 1)
+	if bytes.Equal(tampered, canonical) || pkgcommon.VerifyPayload(public, tampered, signature.Signature) {
+		t.Fatalf("expected a tampered payload to fail verification: %s", tampered)
+	}
+}
+
+// TestRuntimeConfigSnapshot checks that the last known good runtime config is
+// served while the database is down, also by a restarted process.
+func TestRuntimeConfigSnapshot(t *testing.T) {
+	gdb := db.SetupTestDB(t)
+	defer db.CleanupTestDB(t, gdb)
+	dir := t.TempDir()
+	s := NewService(gdb, nil, "", &config.Config{})
+	if err := s.SetRuntimeSnapshotDir(dir); err != nil {
+		t.Fatalf("SetRuntimeSnapshotDir failed: %v", err)
+	}
+
+	user := pkgcommon.ContextWithUserID(context.Background(), 1)
+	if err := s.AddEnvironment(user, &envpb.Environment{EnvironmentKey: "prod", EnvironmentName: "Prod", IsActive: true}); err != nil {
+		t.Fatalf("AddEnvironment failed: %v", err)
+	}
+	var greeting *common.ResourceConfig
+	for _, cfg := range []*common.ResourceConfig{
+		{Name: "Greeting", Alias: "greeting", Type: "text", Content: "hello"},
+		{Name: "Token", Alias: "token", Type: "text", Content: protectedContent, IsPerm: true},
+	} {
+		cfg.EnvironmentKey, cfg.PipelineKey = "prod", "default"
+		added, err := s.AddConfig(user, cfg)
+		if err != nil {
+			t.Fatalf("AddConfig %s failed: %v", cfg.Alias, err)
+		}
+		if cfg.Alias == "greeting" {
+			greeting = added
+		}
+	}
+	resp, err := s.GetRuntimeConfig(context.Background(), "prod", "default")
+	if err != nil {
+		t.Fatalf("GetRuntimeConfig failed: %v", err)
+	}
+	if resp.Stale || resp.SnapshotAt != "" || len(resp.Data.Configs) != 1 {
+		t.Fatalf("unexpected fresh response %+v", resp)
+	}
+
+	// 写入后快照随之刷新
+	greeting.Content = "hi"
+	if _, err := s.UpdateConfig(user, greeting, 0); err != nil {
+		t.Fatalf("UpdateConfig failed: %v", err)
+	}
+	if _, err := s.GetRuntimeConfig(context.Background(), "prod", "missing"); !errors.Is(err, ErrPipelineNotFound) {
+		t.Fatalf("GetRuntimeConfig of a missing pipeline: err = %v, want ErrPipelineNotFound", err)
+	}
+	s.logic.snapshots.refreshes.Wait()
+	sqlDB, err := gdb.DB()
+	if err != nil {
+		t.Fatalf("get sql db: %v", err)
+	}
+	if err := sqlDB.Close(); err != nil {
+		t.Fatalf("close db: %v", err)
+	}
+
+	restarted := NewService(gdb, nil, "", &config.Config{})
+	if err := restarted.SetRuntimeSnapshotDir(dir); err != nil {
+		t.Fatalf("SetRuntimeSnapshotDir failed: %v", err)
+	}
+	for name, svc := range map[string]*Service{"Running": s, "Restarted": restarted} {
+		t.Run(name, func(t *testing.T) {
+			for _, caller := range []struct {
+				ctx  context.Context
+				want int
+			}{{context.Background(), 1}, {user, 2}} {
+				resp, err := svc.GetRuntimeConfig(caller.ctx, "prod", "default")
+				if err != nil {
+					t.Fatalf("GetRuntimeConfig failed: %v", err)
+				}
+				if !resp.Stale || resp.SnapshotAt == "" || resp.Data.Environment.GetEnvironmentName() != "Prod" {
+					t.Fatalf("expected a stale snapshot, got %+v", resp)
+				}
+				if len(resp.Data.Configs) != caller.want {
+					t.Fatalf("got %d configs, want %d", len(resp.Data.Configs), caller.want)
+				}
+				for _, cfg := range resp.Data.Configs {
+					if cfg.GetAlias() == "greeting" && cfg.GetContent() != "hi" {
+						t.Fatalf("greeting = %q, want the content written before the outage", cfg.GetContent())
+					}
+				}
+			}
+			if _, err := svc.AuthorizeRuntime(context.Background(), "", "prod", "default", pkgcommon.APIKeyScopeRuntimeRead); err != nil {
+				t.Fatalf("AuthorizeRuntime failed: %v", err)
+			}
+			if _, err := svc.GetRuntimeConfig(context.Background(), "prod", "other"); err == nil {
+				t.Fatal("expected pipelines without a snapshot to fail")
+			}
+		})
+	}
+}
+
+// readZipFiles returns the files of a zip archive by name.
+func readZipFiles(t *testing.T, data []byte) map[string][]byte {
+	t.Helper()
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("open zip: %v", err)
+	}
+	files := make(map[string][]byte, len(reader.File))
+	for _, file := range reader.File {
+		rc, err := file.Open()
+		if err != nil {
+			t.Fatalf("open %s: %v", file.Name, err)
+		}
+		content, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatalf("read %s: %v", file.Name, err)
+		}
+		files[file.Name] = content
+	}
+	return files
+}
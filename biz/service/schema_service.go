@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"github.com/yi-nology/rainbow_bridge/biz/model/common"
+	schemapb "github.com/yi-nology/rainbow_bridge/biz/model/schema"
+	"github.com/yi-nology/rainbow_bridge/pkg/util"
+)
+
+var ErrConfigSchemaIDRequired = errors.New("id is required")
+
+// --------------------- Config schema operations ---------------------
+
+// CreateConfigSchema attaches a JSON Schema to an alias or alias pattern.
+func (s *Service) CreateConfigSchema(ctx context.Context, req *schemapb.CreateSchemaRequest) (*schemapb.ConfigSchema, error) {
+	if req == nil {
+		return nil, errors.New("schema payload required")
+	}
+	schema, err := s.logic.CreateConfigSchema(ctx, &ConfigSchemaInput{
+		EnvironmentKey: req.EnvironmentKey,
+		PipelineKey:    req.PipelineKey,
+		AliasPattern:   req.AliasPattern,
+		Schema:         req.Schema,
+		Description:    req.Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return configSchemaModelToPB(schema), nil
+}
+
+// UpdateConfigSchema replaces an existing schema.
+func (s *Service) UpdateConfigSchema(ctx context.Context, req *schemapb.UpdateSchemaRequest) (*schemapb.ConfigSchema, error) {
+	if req == nil || req.Id <= 0 {
+		return nil, ErrConfigSchemaIDRequired
+	}
+	schema, err := s.logic.UpdateConfigSchema(ctx, &ConfigSchemaInput{
+		ID:           uint(req.Id),
+		AliasPattern: req.AliasPattern,
+		Schema:       req.Schema,
+		Description:  req.Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return configSchemaModelToPB(schema), nil
+}
+
+// DeleteConfigSchema detaches a schema.
+func (s *Service) DeleteConfigSchema(ctx context.Context, id int64) error {
+	if id <= 0 {
+		return ErrConfigSchemaIDRequired
+	}
+	return s.logic.DeleteConfigSchema(ctx, uint(id))
+}
+
+// ListConfigSchemas returns the schemas of an environment, or of one of its pipelines.
+func (s *Service) ListConfigSchemas(ctx context.Context, environmentKey, pipelineKey string) ([]*schemapb.ConfigSchema, error) {
+	if environmentKey == "" {
+		return nil, ErrEnvironmentKeyRequired
+	}
+	schemas, err := s.logic.ListConfigSchemas(ctx, environmentKey, pipelineKey)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*schemapb.ConfigSchema, 0, len(schemas))
+	for i := range schemas {
+		list = append(list, configSchemaModelToPB(&schemas[i]))
+	}
+	return list, nil
+}
+
+// RevalidateConfigs checks the stored configs against their current schemas.
+func (s *Service) RevalidateConfigs(ctx context.Context, environmentKey, pipelineKey string) (*schemapb.RevalidateData, error) {
+	if environmentKey == "" {
+		return nil, ErrEnvironmentKeyRequired
+	}
+	checked, failures, err := s.logic.RevalidateConfigs(ctx, environmentKey, pipelineKey)
+	if err != nil {
+		return nil, err
+	}
+	data := &schemapb.RevalidateData{
+		Checked:  int32(checked), // #nosec G115 -- count will not exceed int32
+		Failures: make([]*schemapb.SchemaFailure, 0, len(failures)),
+	}
+	for _, failure := range failures {
+		data.Failures = append(data.Failures, &schemapb.SchemaFailure{
+			EnvironmentKey: failure.Config.EnvironmentKey,
+			PipelineKey:    failure.Config.PipelineKey,
+			ResourceKey:    failure.Config.ResourceKey,
+			Alias:          failure.Config.Alias,
+			SchemaId:       int64(failure.Err.SchemaID),
+			AliasPattern:   failure.Err.AliasPattern,
+			Violations:     SchemaViolationsToPB(failure.Err.Violations),
+		})
+	}
+	return data, nil
+}
+
+// SchemaViolationsToPB converts schema violations for API responses.
+func SchemaViolationsToPB(violations []util.SchemaViolation) []*common.SchemaViolation {
+	list := make([]*common.SchemaViolation, 0, len(violations))
+	for _, v := range violations {
+		list = append(list, &common.SchemaViolation{Path: v.Path, Message: v.Message})
+	}
+	return list
+}
+
+func configSchemaModelToPB(schema *model.ConfigSchema) *schemapb.ConfigSchema {
+	return &schemapb.ConfigSchema{
+		Id:             int64(schema.ID),
+		EnvironmentKey: schema.EnvironmentKey,
+		PipelineKey:    schema.PipelineKey,
+		AliasPattern:   schema.AliasPattern,
+		Schema:         schema.Schema,
+		Description:    schema.Description,
+		OperatorName:   schema.OperatorName,
+		CreatedAt:      schema.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      schema.UpdatedAt.Format(time.RFC3339),
+	}
+}
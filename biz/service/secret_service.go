@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/yi-nology/rainbow_bridge/biz/model/common"
+	configpb "github.com/yi-nology/rainbow_bridge/biz/model/config"
+	pkgcommon "github.com/yi-nology/rainbow_bridge/pkg/common"
+)
+
+// ErrSecretAccessDenied is returned when the caller's role may not reveal secrets or rotate keys.
+var ErrSecretAccessDenied = errors.New("当前用户无权查看或轮换密钥")
+
+// --------------------- Secret operations ---------------------
+
+// RevealSecret returns a secret config with its decrypted content.
+func (s *Service) RevealSecret(ctx context.Context, environmentKey, pipelineKey, resourceKey string) (*common.ResourceConfig, error) {
+	if !s.canManageSecrets(ctx) {
+		return nil, ErrSecretAccessDenied
+	}
+	cfg, err := s.logic.RevealSecret(ctx, environmentKey, pipelineKey, resourceKey)
+	if err != nil {
+		return nil, err
+	}
+	return modelConfigToPB(cfg), nil
+}
+
+// RotateSecrets re-encrypts stored secrets with the current key.
+func (s *Service) RotateSecrets(ctx context.Context) (*configpb.RotateSecretsData, error) {
+	if !s.canManageSecrets(ctx) {
+		return nil, ErrSecretAccessDenied
+	}
+	result, err := s.logic.RotateSecrets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &configpb.RotateSecretsData{
+		Configs:        int32(result.Configs),        // #nosec G115 -- row counts will not exceed int32
+		Rollouts:       int32(result.Rollouts),       // #nosec G115 -- row counts will not exceed int32
+		Releases:       int32(result.Releases),       // #nosec G115 -- row counts will not exceed int32
+		Revisions:      int32(result.Revisions),      // #nosec G115 -- row counts will not exceed int32
+		ChangeRequests: int32(result.ChangeRequests), // #nosec G115 -- row counts will not exceed int32
+		Snapshots:      int32(result.Snapshots),      // #nosec G115 -- row counts will not exceed int32
+	}, nil
+}
+
+// canManageSecrets reports whether the authenticated role is listed in secret.reveal_roles.
+func (s *Service) canManageSecrets(ctx context.Context) bool {
+	role := pkgcommon.GetUserRole(ctx)
+	if role == "" || s.config == nil {
+		return false
+	}
+	return slices.Contains(s.config.Secret.RevealRoles, role)
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/db"
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"github.com/yi-nology/rainbow_bridge/biz/model/common"
+	envpb "github.com/yi-nology/rainbow_bridge/biz/model/environment"
+	rolloutpb "github.com/yi-nology/rainbow_bridge/biz/model/rollout"
+	pkgcommon "github.com/yi-nology/rainbow_bridge/pkg/common"
+	"github.com/yi-nology/rainbow_bridge/pkg/config"
+)
+
+// TestRotateSecretsChangeRequestsAndSnapshots checks that key rotation also
+// re-encrypts pending change requests and the runtime snapshots on disk.
+func TestRotateSecretsChangeRequestsAndSnapshots(t *testing.T) {
+	gdb := db.SetupTestDB(t)
+	defer db.CleanupTestDB(t, gdb)
+	dir := t.TempDir()
+	s := NewService(gdb, nil, "", &config.Config{})
+	if err := s.SetRuntimeSnapshotDir(dir); err != nil {
+		t.Fatalf("SetRuntimeSnapshotDir failed: %v", err)
+	}
+	oldKey, newKey := bytes.Repeat([]byte{1}, 32), bytes.Repeat([]byte{2}, 32)
+	keyring, err := pkgcommon.NewSecretKeyring(oldKey)
+	if err != nil {
+		t.Fatalf("NewSecretKeyring failed: %v", err)
+	}
+	s.SetSecretKeyring(keyring)
+
+	requester := pkgcommon.ContextWithUsername(pkgcommon.ContextWithUserID(context.Background(), 1), "alice")
+	reviewer := pkgcommon.ContextWithUsername(pkgcommon.ContextWithUserID(context.Background(), 2), "bob")
+	if err := s.AddEnvironment(requester, &envpb.Environment{EnvironmentKey: "prod", EnvironmentName: "Prod", IsActive: true}); err != nil {
+		t.Fatalf("AddEnvironment failed: %v", err)
+	}
+	token, err := s.AddConfig(requester, &common.ResourceConfig{
+		EnvironmentKey: "prod", PipelineKey: "default", Name: "Token", Alias: "token", Type: "secret", Content: "plain-token",
+	})
+	if err != nil {
+		t.Fatalf("AddConfig failed: %v", err)
+	}
+	if _, err := s.GetRuntimeConfig(context.Background(), "prod", "default"); err != nil {
+		t.Fatalf("GetRuntimeConfig failed: %v", err)
+	}
+	if err := s.logic.environmentDAO.SetRequireApproval(requester, gdb, "prod", true); err != nil {
+		t.Fatalf("SetRequireApproval failed: %v", err)
+	}
+
+	var update *ChangeRequestPendingError
+	token.Content = "plain-update"
+	if _, err := s.UpdateConfig(requester, token, 0); !errors.As(err, &update) {
+		t.Fatalf("expected the update to be queued, got %v", err)
+	}
+	var rollout *ChangeRequestPendingError
+	if _, err := s.CreateRollout(requester, &rolloutpb.CreateRolloutRequest{
+		EnvironmentKey: "prod", PipelineKey: "default", Alias: "token", CandidateContent: "plain-rollout", Percentage: 10,
+	}); !errors.As(err, &rollout) {
+		t.Fatalf("expected the rollout to be queued, got %v", err)
+	}
+
+	// 新密钥生效，旧密钥仅用于解密
+	keyring, err = pkgcommon.NewSecretKeyring(newKey, oldKey)
+	if err != nil {
+		t.Fatalf("NewSecretKeyring failed: %v", err)
+	}
+	s.SetSecretKeyring(keyring)
+	result, err := s.logic.RotateSecrets(context.Background())
+	if err != nil {
+		t.Fatalf("RotateSecrets failed: %v", err)
+	}
+	if result.ChangeRequests != 2 || result.Snapshots != 1 {
+		t.Fatalf("RotateSecrets = %+v, want 2 change requests and 1 snapshot", result)
+	}
+
+	oldCiphertext := pkgcommon.SecretCiphertextPrefix + pkgcommon.SecretKeyID(oldKey)
+	var requests []model.ConfigChangeRequest
+	if err := gdb.Find(&requests).Error; err != nil {
+		t.Fatalf("list change requests: %v", err)
+	}
+	for i := range requests {
+		if strings.Contains(requests[i].Payload, oldCiphertext) {
+			t.Errorf("%s payload still uses the old key", requests[i].Operation)
+		}
+	}
+	var items []model.ConfigChangeRequestItem
+	if err := gdb.Find(&items).Error; err != nil {
+		t.Fatalf("list change request items: %v", err)
+	}
+	for i := range items {
+		if strings.Contains(items[i].Before+items[i].After, oldCiphertext) {
+			t.Errorf("item %d still uses the old key", items[i].ID)
+		}
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "runtime-*.json"))
+	if err != nil || len(files) != 1 {
+		t.Fatalf("snapshot files = %v, %v; want one", files, err)
+	}
+	data, err := os.ReadFile(files[0])
+	if err != nil {
+		t.Fatalf("read snapshot: %v", err)
+	}
+	if strings.Contains(string(data), oldCiphertext) || !strings.Contains(string(data), pkgcommon.SecretCiphertextPrefix) {
+		t.Fatalf("snapshot not re-encrypted: %s", data)
+	}
+
+	// 移除旧密钥后，轮换过的变更仍可批准
+	keyring, err = pkgcommon.NewSecretKeyring(newKey)
+	if err != nil {
+		t.Fatalf("NewSecretKeyring failed: %v", err)
+	}
+	s.SetSecretKeyring(keyring)
+	if _, err := s.ApproveChangeRequest(reviewer, int64(update.Request.ID), ""); err != nil {
+		t.Fatalf("ApproveChangeRequest failed: %v", err)
+	}
+	revealed, err := s.logic.RevealSecret(requester, "prod", "default", token.GetResourceKey())
+	if err != nil || revealed.Content != "plain-update" {
+		t.Fatalf("RevealSecret = %v, %v; want plain-update", revealed, err)
+	}
+}
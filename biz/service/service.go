@@ -10,11 +10,14 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/redis/go-redis/v9"
 	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
 	"github.com/yi-nology/rainbow_bridge/biz/model/common"
+	pkgcommon "github.com/yi-nology/rainbow_bridge/pkg/common"
 	"github.com/yi-nology/rainbow_bridge/pkg/config"
+	"github.com/yi-nology/rainbow_bridge/pkg/util"
 
 	"gorm.io/gorm"
 )
@@ -54,6 +57,7 @@ type Service struct {
 	basePath    string
 	config      *config.Config
 	redisClient *redis.Client
+	signer      *pkgcommon.PayloadSigner
 }
 
 func NewService(db *gorm.DB, redisClient *redis.Client, basePath string, cfg *config.Config) *Service {
@@ -65,31 +69,73 @@ func NewService(db *gorm.DB, redisClient *redis.Client, basePath string, cfg *co
 	}
 }
 
+// SetSecretKeyring enables secret configs, encrypted with the given keyring.
+func (s *Service) SetSecretKeyring(keyring *pkgcommon.SecretKeyring) {
+	s.logic.secretKeyring = keyring
+}
+
+// SetRuntimeSnapshotDir persists the last known good runtime snapshots to
+// dir, so a restarted process can serve them while the database is down.
+func (s *Service) SetRuntimeSnapshotDir(dir string) error {
+	return s.logic.snapshots.setDir(dir)
+}
+
+// SetPayloadSigner enables signatures on runtime payloads.
+func (s *Service) SetPayloadSigner(signer *pkgcommon.PayloadSigner) {
+	s.signer = signer
+}
+
+// maskSecretContent hides encrypted secret content from admin responses.
+// Runtime responses carry decrypted content and pass through unchanged.
+func maskSecretContent(content string) string {
+	if pkgcommon.IsSecretCiphertext(content) {
+		return SecretContentMask
+	}
+	return content
+}
+
 // --------------------- Model conversion helpers ---------------------
 
-func pbConfigToModel(cfg *common.ResourceConfig) *model.Config {
+// pbConfigToModel converts a config payload; it fails on malformed schedule times.
+func pbConfigToModel(cfg *common.ResourceConfig) (*model.Config, error) {
 	if cfg == nil {
-		return &model.Config{}
+		return &model.Config{}, nil
 	}
-	return &model.Config{
-		ResourceKey:    cfg.GetResourceKey(),
-		Alias:          cfg.GetAlias(),
-		Name:           cfg.GetName(),
-		EnvironmentKey: cfg.GetEnvironmentKey(),
-		PipelineKey:    cfg.GetPipelineKey(),
-		Content:        cfg.GetContent(),
-		Type:           cfg.GetType(),
-		Remark:         cfg.GetRemark(),
-		IsPerm:         cfg.GetIsPerm(),
-		Description:    cfg.GetDescription(),
+	effectiveAt, err := util.ParseScheduleTime(cfg.GetEffectiveAt(), cfg.GetScheduleTimezone())
+	if err != nil {
+		return nil, fmt.Errorf("%w: effective_at: %v", ErrConfigScheduleInvalid, err)
 	}
+	expiresAt, err := util.ParseScheduleTime(cfg.GetExpiresAt(), cfg.GetScheduleTimezone())
+	if err != nil {
+		return nil, fmt.Errorf("%w: expires_at: %v", ErrConfigScheduleInvalid, err)
+	}
+	return &model.Config{
+		ResourceKey:      cfg.GetResourceKey(),
+		Alias:            cfg.GetAlias(),
+		Name:             cfg.GetName(),
+		EnvironmentKey:   cfg.GetEnvironmentKey(),
+		PipelineKey:      cfg.GetPipelineKey(),
+		Content:          cfg.GetContent(),
+		Type:             cfg.GetType(),
+		Remark:           cfg.GetRemark(),
+		IsPerm:           cfg.GetIsPerm(),
+		Description:      cfg.GetDescription(),
+		MinVersion:       cfg.GetMinVersion(),
+		MaxVersion:       cfg.GetMaxVersion(),
+		Options:          cfg.GetOptions(),
+		Labels:           util.FormatLabels(cfg.GetLabels()),
+		Translations:     util.FormatTranslations(cfg.GetTranslations()),
+		EffectiveAt:      effectiveAt,
+		ExpiresAt:        expiresAt,
+		ScheduleTimezone: cfg.GetScheduleTimezone(),
+	}, nil
 }
 
 func modelConfigToPB(cfg *model.Config) *common.ResourceConfig {
 	if cfg == nil {
 		return nil
 	}
-	return &common.ResourceConfig{
+	pb := &common.ResourceConfig{
 		ResourceKey:    cfg.ResourceKey,
 		Alias:          cfg.Alias,
 		Name:           cfg.Name,
@@ -100,7 +146,36 @@ func modelConfigToPB(cfg *model.Config) *common.ResourceConfig {
 		Remark:         cfg.Remark,
 		IsPerm:         cfg.IsPerm,
 		Description:    cfg.Description,
+		MinVersion:     cfg.MinVersion,
+		MaxVersion:     cfg.MaxVersion,
+		Origin:         cfg.Origin,
+		OverridesBase:  cfg.OverridesBase,
+		Options:        cfg.Options,
+		Revision:       cfg.Revision,
+		Locale:         cfg.Locale,
+	}
+	if labels, err := util.ParseLabels(cfg.Labels); err == nil && len(labels) > 0 {
+		pb.Labels = labels
+	}
+	if translations, err := util.ParseTranslations(cfg.Translations); err == nil && len(translations) > 0 {
+		pb.Translations = translations
+	}
+	if !cfg.UpdatedAt.IsZero() {
+		pb.UpdatedAt = cfg.UpdatedAt.Format(time.RFC3339)
+	}
+	if cfg.RenamedTo != "" {
+		pb.Deprecated = true
+		pb.RenamedTo = cfg.RenamedTo
+		if cfg.DeprecatedUntil != nil {
+			pb.DeprecatedUntil = cfg.DeprecatedUntil.UTC().Format(time.RFC3339)
+		}
 	}
+	if cfg.IsScheduled() || cfg.ScheduleTimezone != "" {
+		pb.EffectiveAt = util.FormatScheduleTime(cfg.EffectiveAt, cfg.ScheduleTimezone)
+		pb.ExpiresAt = util.FormatScheduleTime(cfg.ExpiresAt, cfg.ScheduleTimezone)
+		pb.ScheduleTimezone = cfg.ScheduleTimezone
+	}
+	return pb
 }
 
 func configSliceToPB(configs []model.Config) []*common.ResourceConfig {
@@ -248,7 +323,11 @@ func (s *Service) expandNestedValue(value any) any {
 }
 
 func (s *Service) decorateConfig(cfg *common.ResourceConfig) *common.ResourceConfig {
-	if cfg == nil || s == nil || s.basePath == "" {
+	if cfg == nil {
+		return cfg
+	}
+	cfg.Content = maskSecretContent(cfg.GetContent())
+	if s == nil || s.basePath == "" {
 		return cfg
 	}
 	cfg.Type = normalizeConfigTypeString(cfg.GetType())
@@ -257,9 +336,6 @@ func (s *Service) decorateConfig(cfg *common.ResourceConfig) *common.ResourceCon
 }
 
 func (s *Service) decorateConfigList(list []*common.ResourceConfig) []*common.ResourceConfig {
-	if s == nil || s.basePath == "" {
-		return list
-	}
 	for _, cfg := range list {
 		s.decorateConfig(cfg)
 	}
@@ -20,6 +20,7 @@ import (
 	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
 	"github.com/yi-nology/rainbow_bridge/biz/model/common"
 	"github.com/yi-nology/rainbow_bridge/biz/model/transfer"
+	"github.com/yi-nology/rainbow_bridge/pkg/util"
 	"gorm.io/gorm"
 )
 
@@ -43,9 +44,41 @@ func (s *Service) ImportConfigs(ctx context.Context, configs []*common.ResourceC
 	}
 	modelConfigs := make([]model.Config, 0, len(configs))
 	for _, cfg := range configs {
-		modelConfigs = append(modelConfigs, *pbConfigToModel(cfg))
+		modelCfg, err := pbConfigToModel(cfg)
+		if err != nil {
+			return err
+		}
+		modelConfigs = append(modelConfigs, *modelCfg)
 	}
-	return s.logic.ImportConfigs(ctx, modelConfigs, overwrite)
+	return s.importConfigs(ctx, modelConfigs, overwrite)
+}
+
+// importConfigs imports configs, or queues them as a change request when an
+// environment the import writes to requires approval.
+func (s *Service) importConfigs(ctx context.Context, configs []model.Config, overwrite bool) error {
+	environmentKeys, err := s.logic.importEnvironmentKeys(ctx, configs, overwrite)
+	if err != nil {
+		return err
+	}
+	required, err := s.logic.approvalEnvironments(ctx, environmentKeys...)
+	if err != nil {
+		return err
+	}
+	if len(required) == 0 {
+		return s.logic.ImportConfigs(ctx, configs, overwrite)
+	}
+
+	// 提交前完成校验与密钥加密，审批时按原样导入
+	configs, err = s.logic.prepareImportedConfigs(ctx, configs)
+	if err != nil {
+		return err
+	}
+	summary := fmt.Sprintf("导入 %d 个配置", len(configs))
+	if overwrite {
+		summary += "（覆盖全部环境）"
+	}
+	payload := &changeRequestPayload{Configs: configs, Overwrite: overwrite}
+	return s.submitChangeRequest(ctx, model.ChangeRequestConfigImport, summary, payload, required)
 }
 
 func (s *Service) writeConfigArchive(ctx context.Context, configs []model.Config) ([]byte, error) {
@@ -92,6 +125,8 @@ func (s *Service) writeConfigArchive(ctx context.Context, configs []model.Config
 						PipelineName:   pl.GetPipelineName(),
 						Description:    pl.GetDescription(),
 						IsActive:       pl.GetIsActive(),
+						DefaultLocale:  pl.GetDefaultLocale(),
+						Locales:        strings.Join(pl.GetLocales(), ","),
 					})
 				}
 			}
@@ -161,6 +196,30 @@ func (s *Service) writeConfigArchive(ctx context.Context, configs []model.Config
 			"remark":          cfg.Remark,
 			"is_perm":         cfg.IsPerm,
 		}
+		if cfg.MinVersion != "" {
+			configMap["min_version"] = cfg.MinVersion
+		}
+		if cfg.MaxVersion != "" {
+			configMap["max_version"] = cfg.MaxVersion
+		}
+		if cfg.Options != "" {
+			configMap["options"] = cfg.Options
+		}
+		if labels, err := util.ParseLabels(cfg.Labels); err == nil && len(labels) > 0 {
+			configMap["labels"] = labels
+		}
+		if translations, err := util.ParseTranslations(cfg.Translations); err == nil && len(translations) > 0 {
+			configMap["translations"] = translations
+		}
+		if cfg.EffectiveAt != nil {
+			configMap["effective_at"] = util.FormatScheduleTime(cfg.EffectiveAt, cfg.ScheduleTimezone)
+		}
+		if cfg.ExpiresAt != nil {
+			configMap["expires_at"] = util.FormatScheduleTime(cfg.ExpiresAt, cfg.ScheduleTimezone)
+		}
+		if cfg.ScheduleTimezone != "" {
+			configMap["schedule_timezone"] = cfg.ScheduleTimezone
+		}
 
 		// For object and keyvalue types, parse the content as JSON
 		normalizedType := strings.ToLower(strings.TrimSpace(cfg.Type))
@@ -172,6 +231,9 @@ func (s *Service) writeConfigArchive(ctx context.Context, configs []model.Config
 			} else {
 				configMap["content"] = cfg.Content
 			}
+		case "secret":
+			// 导出包不携带密钥内容，导入后需重新填写
+			configMap["content"] = SecretContentMask
 		default:
 			configMap["content"] = cfg.Content
 		}
@@ -226,6 +288,7 @@ func (s *Service) writeConfigArchive(ctx context.Context, configs []model.Config
 }
 
 func (s *Service) ImportConfigsArchive(ctx context.Context, data []byte, targetEnv, targetPipeline string, overwrite bool) ([]*common.ResourceConfig, error) {
+	ctx = withFreezeScope(ctx)
 	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
 	if err != nil {
 		return nil, err
@@ -336,9 +399,15 @@ func (s *Service) ImportConfigsArchive(ctx context.Context, data []byte, targetE
 	// Import configs
 	configModels := make([]model.Config, 0, len(configs))
 	for _, cfg := range configs {
-		configModels = append(configModels, *pbConfigToModel(cfg))
+		modelCfg, err := pbConfigToModel(cfg)
+		if err != nil {
+			return nil, err
+		}
+		configModels = append(configModels, *modelCfg)
 	}
-	if err := s.logic.ImportConfigs(ctx, configModels, overwrite); err != nil {
+	// 需要审批时配置变更进入变更申请，资源文件照常恢复
+	var pending *ChangeRequestPendingError
+	if err := s.importConfigs(ctx, configModels, overwrite); err != nil && !errors.As(err, &pending) {
 		return nil, err
 	}
 
@@ -419,6 +488,9 @@ func (s *Service) ImportConfigsArchive(ctx context.Context, data []byte, targetE
 		}
 	}
 
+	if pending != nil {
+		return nil, pending
+	}
 	return s.decorateConfigList(configs), nil
 }
 
@@ -474,24 +546,32 @@ func (s *Service) importPipelines(ctx context.Context, data any) error {
 	}
 
 	for _, pipe := range pipelines {
+		overrides, err := s.logic.checkEnvironmentWritable(ctx, "transfer.import", pipe.EnvironmentKey)
+		if err != nil {
+			return err
+		}
 		// 检查渠道是否已存在
 		existing, err := s.logic.pipelineDAO.GetByKey(ctx, s.logic.db, pipe.EnvironmentKey, pipe.PipelineKey)
 		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 			return err
 		}
 
-		if existing != nil {
-			// 更新已存在的渠道
-			pipe.ID = existing.ID
-			pipe.CreatedAt = existing.CreatedAt
-			if err := s.logic.pipelineDAO.Update(ctx, s.logic.db, &pipe); err != nil {
-				return err
-			}
-		} else {
-			// 创建新渠道
-			if err := s.logic.pipelineDAO.Create(ctx, s.logic.db, &pipe); err != nil {
-				return err
+		err = s.logic.transactWithOverrides(ctx, overrides, func(tx *gorm.DB) error {
+			if existing != nil {
+				// 更新已存在的渠道
+				pipe.ID = existing.ID
+				pipe.CreatedAt = existing.CreatedAt
+				if err := s.logic.pipelineDAO.Update(ctx, tx, &pipe); err != nil {
+					return err
+				}
+			} else {
+				// 创建新渠道
+				return s.logic.pipelineDAO.Create(ctx, tx, &pipe)
 			}
+			return nil
+		})
+		if err != nil {
+			return err
 		}
 	}
 
@@ -612,7 +692,7 @@ func (s *Service) MigrateConfigs(ctx context.Context, req *transfer.MigrateReque
 	}
 
 	// 2. 获取源配置（使用 ListConfigs 而不是 ExportConfigs，因为需要保留 ResourceKey）
-	sourceConfigs, err := s.logic.ListConfigs(ctx, req.SourceEnvironmentKey, req.SourcePipelineKey, "", "", "", false)
+	sourceConfigs, err := s.logic.ListConfigs(ctx, req.SourceEnvironmentKey, req.SourcePipelineKey, "", "", "", false, false)
 	if err != nil {
 		return nil, err
 	}
@@ -632,6 +712,25 @@ func (s *Service) MigrateConfigs(ctx context.Context, req *transfer.MigrateReque
 		sourceConfigs = filtered
 	}
 
+	// 目标环境需要审批时提交变更申请
+	required, err := s.logic.approvalEnvironments(ctx, req.TargetEnvironmentKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(required) > 0 {
+		if configs := s.migrationConfigs(ctx, req, sourceConfigs); len(configs) > 0 {
+			summary := fmt.Sprintf("从 %s/%s 迁移 %d 个配置到 %s/%s", req.SourceEnvironmentKey, req.SourcePipelineKey,
+				len(configs), req.TargetEnvironmentKey, req.TargetPipelineKey)
+			payload := &changeRequestPayload{Configs: configs}
+			return nil, s.submitChangeRequest(ctx, model.ChangeRequestTransferMigrate, summary, payload, required)
+		}
+	}
+
+	ctx = withFreezeScope(ctx)
+	if err := s.logic.precheckEnvironmentWritable(ctx, "transfer.migrate", req.TargetEnvironmentKey); err != nil {
+		return nil, err
+	}
+
 	// 4. 执行迁移
 	summary := &transfer.MigrateSummary{
 		Total: int32(len(sourceConfigs)), // #nosec G115 -- count will not exceed int32
@@ -646,8 +745,8 @@ func (s *Service) MigrateConfigs(ctx context.Context, req *transfer.MigrateReque
 		}
 
 		// 检查目标是否存在同别名配置
-		existing, _ := s.logic.configDAO.GetByAlias(ctx, s.logic.db,
-			req.TargetEnvironmentKey, req.TargetPipelineKey, srcCfg.Alias)
+		existing, _ := s.logic.configDAO.GetVariant(ctx, s.logic.db,
+			req.TargetEnvironmentKey, req.TargetPipelineKey, srcCfg.Alias, srcCfg.MinVersion, srcCfg.MaxVersion)
 
 		if existing != nil && !req.Overwrite {
 			item.Status = "skipped"
@@ -677,7 +776,7 @@ func (s *Service) MigrateConfigs(ctx context.Context, req *transfer.MigrateReque
 		if existing != nil {
 			// 更新现有配置
 			newCfg.ResourceKey = existing.ResourceKey
-			if err := s.logic.UpdateConfig(ctx, &newCfg); err != nil {
+			if err := s.logic.UpdateConfig(ctx, &newCfg, 0); err != nil {
 				item.Status = "failed"
 				item.Message = err.Error()
 				summary.Failed++
@@ -703,6 +802,30 @@ func (s *Service) MigrateConfigs(ctx context.Context, req *transfer.MigrateReque
 	return summary, nil
 }
 
+// migrationConfigs returns the configs a migration writes to the target
+// pipeline. Configs that already exist there are skipped unless overwrite is
+// set; assets are copied when the migration is applied.
+func (s *Service) migrationConfigs(ctx context.Context, req *transfer.MigrateRequest, sourceConfigs []model.Config) []model.Config {
+	configs := make([]model.Config, 0, len(sourceConfigs))
+	for _, srcCfg := range sourceConfigs {
+		existing, _ := s.logic.configDAO.GetVariant(ctx, s.logic.db,
+			req.TargetEnvironmentKey, req.TargetPipelineKey, srcCfg.Alias, srcCfg.MinVersion, srcCfg.MaxVersion)
+		if existing != nil && !req.Overwrite {
+			continue
+		}
+		newCfg := srcCfg
+		newCfg.ID = 0
+		newCfg.EnvironmentKey = req.TargetEnvironmentKey
+		newCfg.PipelineKey = req.TargetPipelineKey
+		newCfg.ResourceKey = uuid.NewString()
+		if existing != nil {
+			newCfg.ResourceKey = existing.ResourceKey
+		}
+		configs = append(configs, newCfg)
+	}
+	return configs
+}
+
 // copyConfigAssets copies assets referenced in the config content.
 func (s *Service) copyConfigAssets(ctx context.Context, cfg *model.Config, targetEnv, targetPipeline string) error {
 	// 提取资源引用
@@ -813,7 +936,7 @@ func (s *Service) GetExportTree(ctx context.Context) (*transfer.ExportTreeData,
 
 		for _, pipe := range pipelines {
 			// Get configs for this pipeline
-			configs, err := s.logic.ListConfigs(ctx, env.EnvironmentKey, pipe.GetPipelineKey(), "", "", "", false)
+			configs, err := s.logic.ListConfigs(ctx, env.EnvironmentKey, pipe.GetPipelineKey(), "", "", "", false, false)
 			if err != nil {
 				continue
 			}
@@ -881,7 +1004,7 @@ func (s *Service) ExportConfigsSelective(ctx context.Context, selections []*tran
 				}
 				for _, cfg := range configs {
 					// Use Alias for deduplication since ResourceKey is cleared by ExportConfigs
-					key := fmt.Sprintf("%s:%s:%s", cfg.EnvironmentKey, cfg.PipelineKey, cfg.Alias)
+					key := fmt.Sprintf("%s:%s:%s:%s:%s", cfg.EnvironmentKey, cfg.PipelineKey, cfg.Alias, cfg.MinVersion, cfg.MaxVersion)
 					if !processedKeys[key] {
 						processedKeys[key] = true
 						allConfigs = append(allConfigs, cfg)
@@ -896,7 +1019,7 @@ func (s *Service) ExportConfigsSelective(ctx context.Context, selections []*tran
 			}
 			for _, cfg := range configs {
 				// Use Alias for deduplication since ResourceKey is cleared by ExportConfigs
-				key := fmt.Sprintf("%s:%s:%s", cfg.EnvironmentKey, cfg.PipelineKey, cfg.Alias)
+				key := fmt.Sprintf("%s:%s:%s:%s:%s", cfg.EnvironmentKey, cfg.PipelineKey, cfg.Alias, cfg.MinVersion, cfg.MaxVersion)
 				if !processedKeys[key] {
 					processedKeys[key] = true
 					allConfigs = append(allConfigs, cfg)
@@ -904,7 +1027,7 @@ func (s *Service) ExportConfigsSelective(ctx context.Context, selections []*tran
 			}
 		} else {
 			// Export specific configs
-			configs, err := s.logic.ListConfigs(ctx, sel.EnvironmentKey, sel.PipelineKey, "", "", "", false)
+			configs, err := s.logic.ListConfigs(ctx, sel.EnvironmentKey, sel.PipelineKey, "", "", "", false, false)
 			if err != nil {
 				continue
 			}
@@ -915,7 +1038,7 @@ func (s *Service) ExportConfigsSelective(ctx context.Context, selections []*tran
 			for _, cfg := range configs {
 				if resourceKeySet[cfg.ResourceKey] {
 					// Use Alias for deduplication
-					key := fmt.Sprintf("%s:%s:%s", cfg.EnvironmentKey, cfg.PipelineKey, cfg.Alias)
+					key := fmt.Sprintf("%s:%s:%s:%s:%s", cfg.EnvironmentKey, cfg.PipelineKey, cfg.Alias, cfg.MinVersion, cfg.MaxVersion)
 					if !processedKeys[key] {
 						processedKeys[key] = true
 						allConfigs = append(allConfigs, cfg)
@@ -995,6 +1118,8 @@ func (s *Service) writeConfigTarGz(ctx context.Context, configs []model.Config)
 						PipelineName:   pl.GetPipelineName(),
 						Description:    pl.GetDescription(),
 						IsActive:       pl.GetIsActive(),
+						DefaultLocale:  pl.GetDefaultLocale(),
+						Locales:        strings.Join(pl.GetLocales(), ","),
 					})
 				}
 			}
@@ -1064,6 +1189,30 @@ func (s *Service) writeConfigTarGz(ctx context.Context, configs []model.Config)
 			"remark":          cfg.Remark,
 			"is_perm":         cfg.IsPerm,
 		}
+		if cfg.MinVersion != "" {
+			configMap["min_version"] = cfg.MinVersion
+		}
+		if cfg.MaxVersion != "" {
+			configMap["max_version"] = cfg.MaxVersion
+		}
+		if cfg.Options != "" {
+			configMap["options"] = cfg.Options
+		}
+		if labels, err := util.ParseLabels(cfg.Labels); err == nil && len(labels) > 0 {
+			configMap["labels"] = labels
+		}
+		if translations, err := util.ParseTranslations(cfg.Translations); err == nil && len(translations) > 0 {
+			configMap["translations"] = translations
+		}
+		if cfg.EffectiveAt != nil {
+			configMap["effective_at"] = util.FormatScheduleTime(cfg.EffectiveAt, cfg.ScheduleTimezone)
+		}
+		if cfg.ExpiresAt != nil {
+			configMap["expires_at"] = util.FormatScheduleTime(cfg.ExpiresAt, cfg.ScheduleTimezone)
+		}
+		if cfg.ScheduleTimezone != "" {
+			configMap["schedule_timezone"] = cfg.ScheduleTimezone
+		}
 
 		// For object and keyvalue types, parse the content as JSON
 		normalizedType := strings.ToLower(strings.TrimSpace(cfg.Type))
@@ -1075,6 +1224,9 @@ func (s *Service) writeConfigTarGz(ctx context.Context, configs []model.Config)
 			} else {
 				configMap["content"] = cfg.Content
 			}
+		case "secret":
+			// 导出包不携带密钥内容，导入后需重新填写
+			configMap["content"] = SecretContentMask
 		default:
 			configMap["content"] = cfg.Content
 		}
@@ -1231,7 +1383,7 @@ func (s *Service) ImportConfigsPreview(ctx context.Context, data []byte, filenam
 		var configs []*common.ResourceConfig
 		if err := json.Unmarshal(configBytes, &configs); err == nil {
 			for _, cfg := range configs {
-				status := s.checkConfigStatus(ctx, cfg.GetEnvironmentKey(), cfg.GetPipelineKey(), cfg.GetAlias())
+				status := s.checkConfigStatus(ctx, cfg.GetEnvironmentKey(), cfg.GetPipelineKey(), cfg.GetAlias(), cfg.GetMinVersion(), cfg.GetMaxVersion())
 				previewCfg := &transfer.ImportPreviewConfig{
 					ResourceKey: cfg.GetResourceKey(),
 					Name:        cfg.GetName(),
@@ -1378,8 +1530,8 @@ func (s *Service) checkPipelineStatus(ctx context.Context, envKey, pipeKey strin
 }
 
 // checkConfigStatus checks if a config exists and returns its status.
-func (s *Service) checkConfigStatus(ctx context.Context, envKey, pipeKey, alias string) string {
-	existing, err := s.logic.configDAO.GetByAlias(ctx, s.logic.db, envKey, pipeKey, alias)
+func (s *Service) checkConfigStatus(ctx context.Context, envKey, pipeKey, alias, minVersion, maxVersion string) string {
+	existing, err := s.logic.configDAO.GetVariant(ctx, s.logic.db, envKey, pipeKey, alias, minVersion, maxVersion)
 	if err != nil || existing == nil {
 		return "new"
 	}
@@ -1390,6 +1542,7 @@ func (s *Service) checkConfigStatus(ctx context.Context, envKey, pipeKey, alias
 
 // ImportConfigsSelective imports selected configurations from an archive.
 func (s *Service) ImportConfigsSelective(ctx context.Context, data []byte, filename string, selections []*transfer.ExportSelection, overwrite bool) ([]*common.ResourceConfig, error) {
+	ctx = withFreezeScope(ctx)
 	// Detect format from filename
 	format := "zip"
 	if strings.HasSuffix(strings.ToLower(filename), ".tar.gz") || strings.HasSuffix(strings.ToLower(filename), ".tgz") {
@@ -1550,10 +1703,16 @@ func (s *Service) importConfigsFromZip(ctx context.Context, data []byte, shouldI
 	// Import configs
 	configModels := make([]model.Config, 0, len(filteredConfigs))
 	for _, cfg := range filteredConfigs {
-		configModels = append(configModels, *pbConfigToModel(cfg))
+		modelCfg, err := pbConfigToModel(cfg)
+		if err != nil {
+			return nil, err
+		}
+		configModels = append(configModels, *modelCfg)
 	}
 
-	if err := s.logic.ImportConfigs(ctx, configModels, overwrite); err != nil {
+	// 需要审批时配置变更进入变更申请，资源文件照常恢复
+	var pending *ChangeRequestPendingError
+	if err := s.importConfigs(ctx, configModels, overwrite); err != nil && !errors.As(err, &pending) {
 		return nil, err
 	}
 
@@ -1638,6 +1797,9 @@ func (s *Service) importConfigsFromZip(ctx context.Context, data []byte, shouldI
 		}
 	}
 
+	if pending != nil {
+		return nil, pending
+	}
 	return s.decorateConfigList(filteredConfigs), nil
 }
 
@@ -1743,10 +1905,16 @@ func (s *Service) importConfigsFromTarGz(ctx context.Context, data []byte, shoul
 	// Import configs
 	configModels := make([]model.Config, 0, len(filteredConfigs))
 	for _, cfg := range filteredConfigs {
-		configModels = append(configModels, *pbConfigToModel(cfg))
+		modelCfg, err := pbConfigToModel(cfg)
+		if err != nil {
+			return nil, err
+		}
+		configModels = append(configModels, *modelCfg)
 	}
 
-	if err := s.logic.ImportConfigs(ctx, configModels, overwrite); err != nil {
+	// 需要审批时配置变更进入变更申请，资源文件照常恢复
+	var pending *ChangeRequestPendingError
+	if err := s.importConfigs(ctx, configModels, overwrite); err != nil && !errors.As(err, &pending) {
 		return nil, err
 	}
 
@@ -1821,5 +1989,8 @@ func (s *Service) importConfigsFromTarGz(ctx context.Context, data []byte, shoul
 		}
 	}
 
+	if pending != nil {
+		return nil, pending
+	}
 	return s.decorateConfigList(filteredConfigs), nil
 }
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/yi-nology/rainbow_bridge/biz/dal/db"
+	"github.com/yi-nology/rainbow_bridge/biz/dal/model"
+	"github.com/yi-nology/rainbow_bridge/biz/model/common"
+	envpb "github.com/yi-nology/rainbow_bridge/biz/model/environment"
+	"github.com/yi-nology/rainbow_bridge/biz/model/transfer"
+	pkgcommon "github.com/yi-nology/rainbow_bridge/pkg/common"
+	"github.com/yi-nology/rainbow_bridge/pkg/config"
+)
+
+// TestImportExportedSecrets checks that an exported archive, whose secrets are
+// masked, imports back without losing any config.
+func TestImportExportedSecrets(t *testing.T) {
+	gdb := db.SetupTestDB(t)
+	defer db.CleanupTestDB(t, gdb)
+	s := NewService(gdb, nil, "", &config.Config{})
+	keyring, err := pkgcommon.NewSecretKeyring(bytes.Repeat([]byte{4}, 32))
+	if err != nil {
+		t.Fatalf("NewSecretKeyring failed: %v", err)
+	}
+	s.SetSecretKeyring(keyring)
+
+	user := pkgcommon.ContextWithUserID(context.Background(), 1)
+	for _, key := range []string{"prod", "stage"} {
+		if err := s.AddEnvironment(user, &envpb.Environment{EnvironmentKey: key, EnvironmentName: key, IsActive: true}); err != nil {
+			t.Fatalf("AddEnvironment %s failed: %v", key, err)
+		}
+	}
+	for _, cfg := range []*common.ResourceConfig{
+		{Name: "Greeting", Alias: "greeting", Type: "text", Content: "hello"},
+		{Name: "Token", Alias: "token", Type: "secret", Content: "plain-token"},
+	} {
+		cfg.EnvironmentKey, cfg.PipelineKey = "prod", "default"
+		if _, err := s.AddConfig(user, cfg); err != nil {
+			t.Fatalf("AddConfig %s failed: %v", cfg.Alias, err)
+		}
+	}
+	stored := func(environmentKey string) map[string]model.Config {
+		t.Helper()
+		list, err := s.logic.ListConfigs(user, environmentKey, "default", "", "", "", false, false)
+		if err != nil {
+			t.Fatalf("ListConfigs failed: %v", err)
+		}
+		configs := make(map[string]model.Config, len(list))
+		for _, cfg := range list {
+			configs[cfg.Alias] = cfg
+		}
+		return configs
+	}
+
+	data, _, err := s.ExportConfigsSelective(user, []*transfer.ExportSelection{{EnvironmentKey: "prod", PipelineKey: "default"}}, "zip")
+	if err != nil {
+		t.Fatalf("ExportConfigsSelective failed: %v", err)
+	}
+	if _, err := s.ImportConfigsArchive(user, data, "", "", true); err != nil {
+		t.Fatalf("overwrite import failed: %v", err)
+	}
+	configs := stored("prod")
+	if len(configs) != 2 {
+		t.Fatalf("configs after the overwrite import = %v, want both", configs)
+	}
+	revealed, err := s.logic.RevealSecret(user, "prod", "default", configs["token"].ResourceKey)
+	if err != nil || revealed.Content != "plain-token" {
+		t.Fatalf("RevealSecret = %v, %v; want the secret kept", revealed, err)
+	}
+
+	// 任一配置无效时覆盖导入不清空现有配置
+	if err := s.ImportConfigs(user, []*common.ResourceConfig{
+		{EnvironmentKey: "prod", PipelineKey: "default", Name: "Logo", Alias: "logo", Type: "image"},
+	}, true); err == nil {
+		t.Fatal("expected an import with an empty image to fail")
+	}
+	if configs := stored("prod"); len(configs) != 2 {
+		t.Fatalf("configs after a failed overwrite import = %v, want both kept", configs)
+	}
+
+	// 目标环境没有可沿用的密钥，掩码密钥被跳过
+	if _, err := s.ImportConfigsArchive(user, data, "stage", "default", false); err != nil {
+		t.Fatalf("import into stage failed: %v", err)
+	}
+	if configs := stored("stage"); len(configs) != 1 || configs["greeting"].Content != "hello" {
+		t.Fatalf("stage configs = %v, want only the greeting", configs)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/yi-nology/rainbow_bridge/biz/middleware"
 	bizrouter "github.com/yi-nology/rainbow_bridge/biz/router"
 	"github.com/yi-nology/rainbow_bridge/biz/service"
+	"github.com/yi-nology/rainbow_bridge/pkg/common"
 	appconfig "github.com/yi-nology/rainbow_bridge/pkg/config"
 	"github.com/yi-nology/rainbow_bridge/pkg/database"
 	"github.com/yi-nology/rainbow_bridge/pkg/lock"
@@ -61,7 +62,7 @@ func Initialize(configPath string, buildConfig BuildConfig) (*Application, error
 	}
 
 	// Auto migrate database tables
-	if err := db.AutoMigrate(&model.Config{}, &model.Asset{}, &model.Environment{}, &model.Pipeline{}); err != nil {
+	if err := db.AutoMigrate(&model.Config{}, &model.Asset{}, &model.Environment{}, &model.Pipeline{}, &model.ConfigRevision{}, &model.ConfigRelease{}, &model.ConfigRollout{}, &model.ConfigSchema{}, &model.ConfigLabel{}, &model.EnvironmentFreeze{}, &model.EnvironmentFreezeOverride{}, &model.ConfigChangeRequest{}, &model.ConfigChangeRequestItem{}, &model.ConfigChangeRequestComment{}, &model.ConfigAliasRedirect{}, &model.RuntimeChange{}, &model.RuntimeChangeLog{}, &model.ChangeSequence{}, &model.ChangeEvent{}, &model.PipelineAPIKey{}); err != nil {
 		return nil, err
 	}
 
@@ -100,6 +101,42 @@ func Initialize(configPath string, buildConfig BuildConfig) (*Application, error
 	// Create service instance
 	svc := service.NewService(db, redisClient, basePath, cfg)
 
+	// Load the keys of secret configs (optional)
+	if cfg.Secret.Configured() {
+		current, previous, err := cfg.Secret.LoadKeys()
+		if err != nil {
+			return nil, err
+		}
+		keyring, err := common.NewSecretKeyring(current, previous...)
+		if err != nil {
+			return nil, err
+		}
+		svc.SetSecretKeyring(keyring)
+		log.Printf("Secret config encryption enabled (key %s)", common.SecretKeyID(current))
+	}
+
+	// Load the key runtime payloads are signed with (optional)
+	if cfg.Signing.Configured() {
+		seed, previous, err := cfg.Signing.LoadKeys()
+		if err != nil {
+			return nil, err
+		}
+		signer, err := common.NewPayloadSigner(seed, previous...)
+		if err != nil {
+			return nil, err
+		}
+		svc.SetPayloadSigner(signer)
+		log.Printf("Runtime payload signing enabled (key %s)", signer.KeyID())
+	}
+
+	// Persist the last known good runtime snapshots (optional)
+	if cfg.Snapshot.Dir != "" {
+		if err := svc.SetRuntimeSnapshotDir(cfg.Snapshot.Dir); err != nil {
+			return nil, err
+		}
+		log.Printf("Runtime config snapshots persisted to %s", cfg.Snapshot.Dir)
+	}
+
 	// Set version information
 	versionhandler.AppVersion = buildConfig.Version
 	versionhandler.AppGitCommit = buildConfig.GitCommit
@@ -109,6 +146,10 @@ func Initialize(configPath string, buildConfig BuildConfig) (*Application, error
 	// Initialize handlers
 	bizrouter.InitHandlers(svc)
 
+	// Authenticate runtime clients with pipeline API keys
+	middleware.SetTrustUserIDHeader(cfg.Auth.TrustUserIDHeader)
+	middleware.SetRuntimeAuthorizer(svc)
+
 	// Migrate to full asset paths
 	if err := svc.MigrateToFullAssetPaths(context.Background()); err != nil {
 		log.Printf("Warning: failed to migrate asset paths: %v", err)
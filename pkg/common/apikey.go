@@ -0,0 +1,126 @@
+package common
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// API key scopes.
+const (
+	// APIKeyScopeRuntimeRead allows reading runtime configs and watching their changes.
+	APIKeyScopeRuntimeRead = "runtime-read"
+	// APIKeyScopeStaticExport allows downloading the static package of a pipeline.
+	APIKeyScopeStaticExport = "static-export"
+	// APIKeyScopePermConfigs allows reading configs marked is_perm.
+	APIKeyScopePermConfigs = "perm-configs"
+)
+
+// APIKeyScopes lists the known API key scopes.
+var APIKeyScopes = []string{APIKeyScopeRuntimeRead, APIKeyScopeStaticExport, APIKeyScopePermConfigs}
+
+// APIKeyTokenPrefix starts every API key. The full format is
+// "rbk_<key id>_<secret>"; the key id is public and locates the stored key,
+// only a bcrypt hash of the secret is stored.
+const APIKeyTokenPrefix = "rbk_"
+
+// ErrAPIKeyMalformed is returned for tokens not in the API key format.
+var ErrAPIKeyMalformed = errors.New("malformed api key")
+
+// GenerateAPIKeyID returns a new random public key id.
+func GenerateAPIKeyID() (string, error) {
+	id := make([]byte, 8)
+	if _, err := io.ReadFull(rand.Reader, id); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(id), nil
+}
+
+// GenerateAPIKeySecret returns a new random key secret.
+func GenerateAPIKeySecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, secret); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+// FormatAPIKey builds the token handed out to clients.
+func FormatAPIKey(keyID, secret string) string {
+	return APIKeyTokenPrefix + keyID + "_" + secret
+}
+
+// ParseAPIKey splits a token into its key id and secret.
+func ParseAPIKey(token string) (string, string, error) {
+	if !strings.HasPrefix(token, APIKeyTokenPrefix) {
+		return "", "", ErrAPIKeyMalformed
+	}
+	keyID, secret, ok := strings.Cut(strings.TrimPrefix(token, APIKeyTokenPrefix), "_")
+	if !ok || keyID == "" || secret == "" {
+		return "", "", ErrAPIKeyMalformed
+	}
+	return keyID, secret, nil
+}
+
+// NormalizeAPIKeyScopes validates scopes and returns them without duplicates
+// in the order of APIKeyScopes.
+func NormalizeAPIKeyScopes(scopes []string) ([]string, error) {
+	requested := make(map[string]bool, len(scopes))
+	for _, scope := range scopes {
+		scope = strings.ToLower(strings.TrimSpace(scope))
+		if scope == "" {
+			continue
+		}
+		if !slices.Contains(APIKeyScopes, scope) {
+			return nil, fmt.Errorf("unknown scope %q", scope)
+		}
+		requested[scope] = true
+	}
+	normalized := make([]string, 0, len(requested))
+	for _, scope := range APIKeyScopes {
+		if requested[scope] {
+			normalized = append(normalized, scope)
+		}
+	}
+	return normalized, nil
+}
+
+// APIKeyIdentity describes the API key a request was authenticated with.
+type APIKeyIdentity struct {
+	ID             uint
+	KeyID          string
+	EnvironmentKey string
+	PipelineKey    string
+	Scopes         []string
+}
+
+// HasScope reports whether the key carries scope.
+func (k *APIKeyIdentity) HasScope(scope string) bool {
+	return k != nil && slices.Contains(k.Scopes, scope)
+}
+
+const apiKeyKey contextKey = "api_key"
+
+// ContextWithAPIKey stores the API key of the request into context.
+func ContextWithAPIKey(ctx context.Context, key *APIKeyIdentity) context.Context {
+	return context.WithValue(ctx, apiKeyKey, key)
+}
+
+// GetAPIKey retrieves the API key of the request from context.
+func GetAPIKey(ctx context.Context) (*APIKeyIdentity, bool) {
+	key, ok := ctx.Value(apiKeyKey).(*APIKeyIdentity)
+	return key, ok && key != nil
+}
+
+// Errors of API key authentication.
+var (
+	ErrAPIKeyRequired     = errors.New("api key required")
+	ErrAPIKeyUnauthorized = errors.New("invalid or expired api key")
+	ErrAPIKeyForbidden    = errors.New("api key not allowed for this pipeline or operation")
+)
@@ -0,0 +1,54 @@
+package common
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestAPIKeyFormat(t *testing.T) {
+	keyID, err := GenerateAPIKeyID()
+	if err != nil {
+		t.Fatalf("GenerateAPIKeyID returned error: %v", err)
+	}
+	secret, err := GenerateAPIKeySecret()
+	if err != nil {
+		t.Fatalf("GenerateAPIKeySecret returned error: %v", err)
+	}
+	gotID, gotSecret, err := ParseAPIKey(FormatAPIKey(keyID, secret))
+	if err != nil || gotID != keyID || gotSecret != secret {
+		t.Fatalf("ParseAPIKey = %q, %q, %v", gotID, gotSecret, err)
+	}
+
+	// 密钥可能包含下划线，只按第一个分隔
+	if id, secret, err := ParseAPIKey("rbk_abc_de_f"); err != nil || id != "abc" || secret != "de_f" {
+		t.Fatalf("ParseAPIKey = %q, %q, %v", id, secret, err)
+	}
+	for _, token := range []string{"", "abc_def", "rbk_", "rbk_abc", "rbk__def", "rbk_abc_"} {
+		if _, _, err := ParseAPIKey(token); !errors.Is(err, ErrAPIKeyMalformed) {
+			t.Fatalf("expected ErrAPIKeyMalformed for %q, got %v", token, err)
+		}
+	}
+}
+
+func TestNormalizeAPIKeyScopes(t *testing.T) {
+	got, err := NormalizeAPIKeyScopes([]string{" Perm-Configs ", "runtime-read", "", "runtime-read"})
+	if err != nil {
+		t.Fatalf("NormalizeAPIKeyScopes returned error: %v", err)
+	}
+	if want := []string{APIKeyScopeRuntimeRead, APIKeyScopePermConfigs}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("NormalizeAPIKeyScopes = %v, want %v", got, want)
+	}
+	if _, err := NormalizeAPIKeyScopes([]string{"admin"}); err == nil {
+		t.Fatal("expected error for unknown scope")
+	}
+
+	key := &APIKeyIdentity{Scopes: got}
+	if !key.HasScope(APIKeyScopePermConfigs) || key.HasScope(APIKeyScopeStaticExport) {
+		t.Fatalf("unexpected scopes %v", key.Scopes)
+	}
+	var none *APIKeyIdentity
+	if none.HasScope(APIKeyScopeRuntimeRead) {
+		t.Fatal("nil key must not carry scopes")
+	}
+}
@@ -80,6 +80,8 @@ const (
 	usernameKey      contextKey = "username"
 	userRoleKey      contextKey = "user_role"
 	clientVersionKey contextKey = "client_version"
+	headersKey       contextKey = "request_headers"
+	localesKey       contextKey = "locales"
 )
 
 // ContextWithUserID stores user ID into context.
@@ -126,6 +128,38 @@ func GetClientVersion(ctx context.Context) string {
 	return ""
 }
 
+// ContextWithLocales stores the locales preferred by the client, most preferred first.
+func ContextWithLocales(ctx context.Context, locales []string) context.Context {
+	return context.WithValue(ctx, localesKey, locales)
+}
+
+// GetLocales retrieves the preferred locales from context.
+func GetLocales(ctx context.Context) []string {
+	if locales, ok := ctx.Value(localesKey).([]string); ok {
+		return locales
+	}
+	return nil
+}
+
+// ContextWithRequestHeaders stores the request headers into context. Header names
+// are matched case-insensitively by GetRequestHeader.
+func ContextWithRequestHeaders(ctx context.Context, headers map[string]string) context.Context {
+	normalized := make(map[string]string, len(headers))
+	for name, value := range headers {
+		normalized[strings.ToLower(name)] = value
+	}
+	return context.WithValue(ctx, headersKey, normalized)
+}
+
+// GetRequestHeader retrieves a request header value from context.
+func GetRequestHeader(ctx context.Context, name string) string {
+	headers, ok := ctx.Value(headersKey).(map[string]string)
+	if !ok {
+		return ""
+	}
+	return headers[strings.ToLower(name)]
+}
+
 // ContextWithUsername stores username into context.
 func ContextWithUsername(ctx context.Context, username string) context.Context {
 	return context.WithValue(ctx, usernameKey, username)
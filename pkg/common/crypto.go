@@ -4,9 +4,13 @@ import (
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"io"
+	"strings"
 
 	"golang.org/x/crypto/bcrypt"
 )
@@ -98,3 +102,110 @@ func GenerateRandomKey(length int) (string, error) {
 	}
 	return base64.StdEncoding.EncodeToString(key), nil
 }
+
+// SecretCiphertextPrefix marks config contents encrypted by a SecretKeyring.
+// The full format is "enc:v1:<key id>:<base64 nonce+ciphertext>".
+const SecretCiphertextPrefix = "enc:v1:"
+
+// SecretKeySize is the size of the AES-256 keys used for secret configs.
+const SecretKeySize = 32
+
+// ErrSecretKeyUnknown is returned when a ciphertext was produced by a key the keyring does not hold.
+var ErrSecretKeyUnknown = errors.New("secret encrypted with an unknown key")
+
+// SecretKeyring encrypts secret config contents with its current key and
+// decrypts contents produced by the current or any previous key. Every
+// ciphertext carries the id of its key so old rows can be re-encrypted after
+// a key rotation.
+type SecretKeyring struct {
+	currentID string
+	keys      map[string]string
+}
+
+// NewSecretKeyring builds a keyring from the current key and retired keys that
+// are still accepted for decryption. Every key must be 32 bytes.
+func NewSecretKeyring(current []byte, previous ...[]byte) (*SecretKeyring, error) {
+	if len(current) != SecretKeySize {
+		return nil, fmt.Errorf("secret key must be %d bytes, got %d", SecretKeySize, len(current))
+	}
+	keyring := &SecretKeyring{
+		currentID: SecretKeyID(current),
+		keys:      map[string]string{SecretKeyID(current): string(current)},
+	}
+	for _, key := range previous {
+		if len(key) != SecretKeySize {
+			return nil, fmt.Errorf("previous secret key must be %d bytes, got %d", SecretKeySize, len(key))
+		}
+		if _, ok := keyring.keys[SecretKeyID(key)]; !ok {
+			keyring.keys[SecretKeyID(key)] = string(key)
+		}
+	}
+	return keyring, nil
+}
+
+// SecretKeyID returns the short identifier of a key embedded in ciphertexts.
+func SecretKeyID(key []byte) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:4])
+}
+
+// IsSecretCiphertext reports whether value looks like a SecretKeyring ciphertext.
+func IsSecretCiphertext(value string) bool {
+	return strings.HasPrefix(value, SecretCiphertextPrefix)
+}
+
+// Encrypt encrypts plaintext with the current key.
+func (k *SecretKeyring) Encrypt(plaintext string) (string, error) {
+	ciphertext, err := EncryptAES(plaintext, k.keys[k.currentID])
+	if err != nil {
+		return "", err
+	}
+	return SecretCiphertextPrefix + k.currentID + ":" + ciphertext, nil
+}
+
+// Decrypt decrypts a ciphertext produced by any key of the keyring.
+func (k *SecretKeyring) Decrypt(value string) (string, error) {
+	keyID, ciphertext, err := splitSecretCiphertext(value)
+	if err != nil {
+		return "", err
+	}
+	key, ok := k.keys[keyID]
+	if !ok {
+		return "", ErrSecretKeyUnknown
+	}
+	return DecryptAES(ciphertext, key)
+}
+
+// NeedsRotation reports whether a ciphertext was produced by a key other than the current one.
+func (k *SecretKeyring) NeedsRotation(value string) bool {
+	keyID, _, err := splitSecretCiphertext(value)
+	return err == nil && keyID != k.currentID
+}
+
+// Rotate re-encrypts a ciphertext with the current key. Ciphertexts already
+// using the current key are returned unchanged.
+func (k *SecretKeyring) Rotate(value string) (string, bool, error) {
+	if !k.NeedsRotation(value) {
+		return value, false, nil
+	}
+	plaintext, err := k.Decrypt(value)
+	if err != nil {
+		return "", false, err
+	}
+	rotated, err := k.Encrypt(plaintext)
+	if err != nil {
+		return "", false, err
+	}
+	return rotated, true, nil
+}
+
+func splitSecretCiphertext(value string) (string, string, error) {
+	if !IsSecretCiphertext(value) {
+		return "", "", errors.New("not a secret ciphertext")
+	}
+	keyID, ciphertext, ok := strings.Cut(strings.TrimPrefix(value, SecretCiphertextPrefix), ":")
+	if !ok || keyID == "" || ciphertext == "" {
+		return "", "", errors.New("malformed secret ciphertext")
+	}
+	return keyID, ciphertext, nil
+}
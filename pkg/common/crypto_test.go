@@ -0,0 +1,59 @@
+package common
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSecretKeyringRotation(t *testing.T) {
+	oldKey := []byte("0123456789abcdef0123456789abcdef")
+	newKey := []byte("fedcba9876543210fedcba9876543210")
+
+	oldRing, err := NewSecretKeyring(oldKey)
+	if err != nil {
+		t.Fatalf("NewSecretKeyring returned error: %v", err)
+	}
+	ciphertext, err := oldRing.Encrypt("s3cr3t")
+	if err != nil {
+		t.Fatalf("Encrypt returned error: %v", err)
+	}
+	if !IsSecretCiphertext(ciphertext) || strings.Contains(ciphertext, "s3cr3t") {
+		t.Fatalf("unexpected ciphertext %q", ciphertext)
+	}
+
+	newRing, err := NewSecretKeyring(newKey, oldKey)
+	if err != nil {
+		t.Fatalf("NewSecretKeyring returned error: %v", err)
+	}
+	if !newRing.NeedsRotation(ciphertext) {
+		t.Fatalf("expected ciphertext of the previous key to need rotation")
+	}
+	rotated, changed, err := newRing.Rotate(ciphertext)
+	if err != nil || !changed {
+		t.Fatalf("Rotate = %q, %v, %v", rotated, changed, err)
+	}
+	if newRing.NeedsRotation(rotated) {
+		t.Fatalf("expected rotated ciphertext to use the current key")
+	}
+
+	onlyNew, err := NewSecretKeyring(newKey)
+	if err != nil {
+		t.Fatalf("NewSecretKeyring returned error: %v", err)
+	}
+	if plaintext, err := onlyNew.Decrypt(rotated); err != nil || plaintext != "s3cr3t" {
+		t.Fatalf("Decrypt = %q, %v", plaintext, err)
+	}
+	if _, err := onlyNew.Decrypt(ciphertext); !errors.Is(err, ErrSecretKeyUnknown) {
+		t.Fatalf("expected ErrSecretKeyUnknown, got %v", err)
+	}
+}
+
+func TestNewSecretKeyringRejectsShortKeys(t *testing.T) {
+	if _, err := NewSecretKeyring([]byte("short")); err == nil {
+		t.Fatalf("expected error for short key")
+	}
+	if _, err := NewSecretKeyring([]byte("0123456789abcdef0123456789abcdef"), []byte("short")); err == nil {
+		t.Fatalf("expected error for short previous key")
+	}
+}
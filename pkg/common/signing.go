@@ -0,0 +1,216 @@
+package common
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// SigningAlgorithm names the signatures made by a PayloadSigner.
+const SigningAlgorithm = "ed25519"
+
+// SigningKey is a public key runtime clients verify payload signatures with.
+type SigningKey struct {
+	KeyID     string
+	PublicKey ed25519.PublicKey
+	Current   bool
+}
+
+// PayloadSigner signs runtime payloads with its current Ed25519 key. The
+// public keys of retired keys are kept so they can still be published while
+// clients holding payloads signed by them switch over.
+type PayloadSigner struct {
+	key  ed25519.PrivateKey
+	keys []SigningKey
+}
+
+// NewPayloadSigner builds a signer from the 32-byte seed of the current key
+// and the 32-byte public keys of retired keys.
+func NewPayloadSigner(seed []byte, previous ...[]byte) (*PayloadSigner, error) {
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("signing key must be a %d-byte ed25519 seed, got %d bytes", ed25519.SeedSize, len(seed))
+	}
+	key := ed25519.NewKeyFromSeed(seed)
+	public := key.Public().(ed25519.PublicKey)
+	signer := &PayloadSigner{
+		key:  key,
+		keys: []SigningKey{{KeyID: SigningKeyID(public), PublicKey: public, Current: true}},
+	}
+	seen := map[string]bool{signer.keys[0].KeyID: true}
+	for _, item := range previous {
+		if len(item) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("previous signing public key must be %d bytes, got %d", ed25519.PublicKeySize, len(item))
+		}
+		id := SigningKeyID(item)
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		signer.keys = append(signer.keys, SigningKey{KeyID: id, PublicKey: ed25519.PublicKey(item)})
+	}
+	return signer, nil
+}
+
+// SigningKeyID returns the identifier of a public key carried by signatures.
+func SigningKeyID(public []byte) string {
+	sum := sha256.Sum256(public)
+	return hex.EncodeToString(sum[:8])
+}
+
+// KeyID returns the identifier of the current key.
+func (s *PayloadSigner) KeyID() string {
+	return s.keys[0].KeyID
+}
+
+// Keys returns the public keys to publish, the current one first.
+func (s *PayloadSigner) Keys() []SigningKey {
+	return append([]SigningKey(nil), s.keys...)
+}
+
+// Sign returns the base64 signature of payload by the current key.
+func (s *PayloadSigner) Sign(payload []byte) string {
+	return base64.StdEncoding.EncodeToString(ed25519.Sign(s.key, payload))
+}
+
+// VerifyPayload checks a base64 signature made by Sign against public.
+func VerifyPayload(public ed25519.PublicKey, payload []byte, signature string) bool {
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil || len(public) != ed25519.PublicKeySize {
+		return false
+	}
+	return ed25519.Verify(public, payload, sig)
+}
+
+// CanonicalJSON re-serialises a JSON document following the JSON
+// Canonicalization Scheme (RFC 8785): no whitespace, object members sorted by
+// the UTF-16 code units of their names, numbers in their shortest ECMAScript
+// form and strings with only the mandatory escapes. Clients verify signatures
+// by canonicalising the payload they received the same way.
+func CanonicalJSON(data []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, errors.New("canonical json: trailing data")
+	}
+	buf := &bytes.Buffer{}
+	if err := writeCanonicalJSON(buf, value); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeCanonicalJSON(buf *bytes.Buffer, value any) error {
+	switch v := value.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		buf.WriteString(strconv.FormatBool(v))
+	case json.Number:
+		f, err := strconv.ParseFloat(v.String(), 64)
+		if err != nil {
+			return fmt.Errorf("canonical json: number %s: %w", v, err)
+		}
+		buf.WriteString(formatCanonicalNumber(f))
+	case string:
+		writeCanonicalString(buf, v)
+	case []any:
+		buf.WriteByte('[')
+		for i, item := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeCanonicalJSON(buf, item); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	case map[string]any:
+		names := make([]string, 0, len(v))
+		for name := range v {
+			names = append(names, name)
+		}
+		sort.Slice(names, func(i, j int) bool { return lessUTF16(names[i], names[j]) })
+		buf.WriteByte('{')
+		for i, name := range names {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			writeCanonicalString(buf, name)
+			buf.WriteByte(':')
+			if err := writeCanonicalJSON(buf, v[name]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	default:
+		return fmt.Errorf("canonical json: unsupported value %T", value)
+	}
+	return nil
+}
+
+// formatCanonicalNumber formats f the way ECMAScript's Number.prototype.toString does.
+func formatCanonicalNumber(f float64) string {
+	if f == 0 {
+		return "0"
+	}
+	if abs := math.Abs(f); abs < 1e21 && abs >= 1e-6 {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	// Go pads the exponent to two digits, ECMAScript does not
+	mantissa, exponent, _ := strings.Cut(strconv.FormatFloat(f, 'e', -1, 64), "e")
+	return mantissa + "e" + exponent[:1] + strings.TrimLeft(exponent[1:], "0")
+}
+
+func writeCanonicalString(buf *bytes.Buffer, s string) {
+	buf.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(buf, `\u%04x`, r)
+			} else {
+				buf.WriteRune(r)
+			}
+		}
+	}
+	buf.WriteByte('"')
+}
+
+// lessUTF16 orders strings by their UTF-16 code units.
+func lessUTF16(a, b string) bool {
+	ua, ub := utf16.Encode([]rune(a)), utf16.Encode([]rune(b))
+	for i := 0; i < len(ua) && i < len(ub); i++ {
+		if ua[i] != ub[i] {
+			return ua[i] < ub[i]
+		}
+	}
+	return len(ua) < len(ub)
+}
@@ -0,0 +1,80 @@
+package common
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"strings"
+	"testing"
+)
+
+func TestCanonicalJSON(t *testing.T) {
+	cases := map[string]string{
+		// RFC 8785 3.2.2.3 和 3.2.3 的示例
+		`{"numbers": [333333333.33333329, 1E30, 4.50, 2e-3, 0.000000000000000000000000001, -0, 10]}`:   `{"numbers":[333333333.3333333,1e+30,4.5,0.002,1e-27,0,10]}`,
+		`{"string": "\u20ac$\u000F\u000aA'\u0042\u0022\u005c\\\"\/", "literals": [null, true, false]}`: `{"literals":[null,true,false],"string":"€$\u000f\nA'B\"\\\\\"/"}`,
+		`{"\u20ac": 1, "\r": 2, "\ufb33": 3, "1": 4, "\ud83d\ude00": 5, "\u0080": 6, "\u00f6": 7}`:     "{\"\\r\":2,\"1\":4,\"\u0080\":6,\"ö\":7,\"€\":1,\"😀\":5,\"דּ\":3}",
+		`{"html": "<a href=\"x\">&</a>", "nested": {"b": [], "a": {}}}`:                                `{"html":"<a href=\"x\">&</a>","nested":{"a":{},"b":[]}}`,
+	}
+	for input, want := range cases {
+		got, err := CanonicalJSON([]byte(input))
+		if err != nil {
+			t.Fatalf("CanonicalJSON(%s) returned error: %v", input, err)
+		}
+		if string(got) != want {
+			t.Errorf("CanonicalJSON(%s) = %s, want %s", input, got, want)
+		}
+	}
+	for _, input := range []string{`{"a":1}{}`, `{"a":`, `[1e400]`} {
+		if _, err := CanonicalJSON([]byte(input)); err == nil {
+			t.Errorf("expected CanonicalJSON(%s) to fail", input)
+		}
+	}
+}
+
+func TestPayloadSignerRotation(t *testing.T) {
+	oldSeed := bytes.Repeat([]byte{1}, ed25519.SeedSize)
+	newSeed := bytes.Repeat([]byte{2}, ed25519.SeedSize)
+	payload := []byte(`{"configs":[]}`)
+
+	oldSigner, err := NewPayloadSigner(oldSeed)
+	if err != nil {
+		t.Fatalf("NewPayloadSigner returned error: %v", err)
+	}
+	oldSignature := oldSigner.Sign(payload)
+	oldKey := oldSigner.Keys()[0]
+
+	signer, err := NewPayloadSigner(newSeed, oldKey.PublicKey, oldKey.PublicKey)
+	if err != nil {
+		t.Fatalf("NewPayloadSigner returned error: %v", err)
+	}
+	keys := signer.Keys()
+	if len(keys) != 2 || !keys[0].Current || keys[0].KeyID != signer.KeyID() || keys[1].KeyID != oldKey.KeyID || keys[1].Current {
+		t.Fatalf("Keys = %+v, want the current key then the previous one", keys)
+	}
+	if keys[0].KeyID == oldKey.KeyID || len(keys[0].KeyID) != 16 {
+		t.Fatalf("unexpected key ids %q and %q", keys[0].KeyID, oldKey.KeyID)
+	}
+
+	signature := signer.Sign(payload)
+	if !VerifyPayload(keys[0].PublicKey, payload, signature) {
+		t.Fatal("expected the signature to verify with the current key")
+	}
+	if !VerifyPayload(keys[1].PublicKey, payload, oldSignature) {
+		t.Fatal("expected a signature by the previous key to verify with its published key")
+	}
+	if VerifyPayload(keys[1].PublicKey, payload, signature) || VerifyPayload(keys[0].PublicKey, []byte(`{"configs":[1]}`), signature) {
+		t.Fatal("expected verification with another key or payload to fail")
+	}
+	if VerifyPayload(keys[0].PublicKey, payload, "not base64!") {
+		t.Fatal("expected a malformed signature to fail")
+	}
+}
+
+func TestNewPayloadSignerRejectsMalformedKeys(t *testing.T) {
+	if _, err := NewPayloadSigner([]byte("short")); err == nil || !strings.Contains(err.Error(), "seed") {
+		t.Fatalf("expected a short seed to be rejected, got %v", err)
+	}
+	if _, err := NewPayloadSigner(make([]byte, ed25519.SeedSize), []byte("short")); err == nil {
+		t.Fatal("expected a short previous public key to be rejected")
+	}
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"encoding/base64"
 	"fmt"
 	"log"
 	"os"
@@ -21,6 +22,91 @@ type Config struct {
 	Redis    RedisConfig    `yaml:"redis"`
 	Storage  StorageConfig  `yaml:"storage"`
 	Log      LogConfig      `yaml:"log"`
+	Secret   SecretConfig   `yaml:"secret"`
+	Signing  SigningConfig  `yaml:"signing"`
+	Snapshot SnapshotConfig `yaml:"snapshot"`
+	Auth     AuthConfig     `yaml:"auth"`
+}
+
+// AuthConfig defines how callers are identified.
+type AuthConfig struct {
+	// TrustUserIDHeader accepts the user ID in the X-User-Id header of requests
+	// without a token. Only enable it behind a gateway that sets the header.
+	TrustUserIDHeader bool `yaml:"trust_user_id_header"`
+	// RequireRuntimeAPIKey rejects runtime requests without an API key, also
+	// for pipelines without keys. Pipelines with active keys always require one.
+	RequireRuntimeAPIKey bool `yaml:"require_runtime_api_key"`
+	// AdminRoles may turn off the approval requirement of environments.
+	AdminRoles []string `yaml:"admin_roles"`
+}
+
+// SecretConfig defines the keys used to encrypt secret configs at rest.
+// Keys are base64-encoded 32-byte AES keys.
+type SecretConfig struct {
+	Key          string   `yaml:"key"`           // current key
+	KeyFile      string   `yaml:"key_file"`      // file holding the current key, used when key is empty
+	PreviousKeys []string `yaml:"previous_keys"` // retired keys still accepted for decryption
+	RevealRoles  []string `yaml:"reveal_roles"`  // roles allowed to reveal secrets and rotate keys
+}
+
+// Configured reports whether a current key is set.
+func (s SecretConfig) Configured() bool {
+	return strings.TrimSpace(s.Key) != "" || strings.TrimSpace(s.KeyFile) != ""
+}
+
+// LoadKeys decodes the current key (reading key_file when key is empty) and the previous keys.
+func (s SecretConfig) LoadKeys() ([]byte, [][]byte, error) {
+	return loadBase64Keys("secret key", s.Key, s.KeyFile, s.PreviousKeys)
+}
+
+// SigningConfig defines the Ed25519 key runtime payloads are signed with.
+// The key is a base64-encoded 32-byte seed, previous public keys are
+// base64-encoded 32-byte Ed25519 public keys.
+type SigningConfig struct {
+	Key                string   `yaml:"key"`                  // seed of the current key
+	KeyFile            string   `yaml:"key_file"`             // file holding the seed, used when key is empty
+	PreviousPublicKeys []string `yaml:"previous_public_keys"` // retired keys still published to clients
+}
+
+// Configured reports whether a signing key is set.
+func (s SigningConfig) Configured() bool {
+	return strings.TrimSpace(s.Key) != "" || strings.TrimSpace(s.KeyFile) != ""
+}
+
+// LoadKeys decodes the seed (reading key_file when key is empty) and the previous public keys.
+func (s SigningConfig) LoadKeys() ([]byte, [][]byte, error) {
+	return loadBase64Keys("signing key", s.Key, s.KeyFile, s.PreviousPublicKeys)
+}
+
+func loadBase64Keys(kind, key, keyFile string, previousKeys []string) ([]byte, [][]byte, error) {
+	encoded := strings.TrimSpace(key)
+	if encoded == "" && strings.TrimSpace(keyFile) != "" {
+		data, err := os.ReadFile(strings.TrimSpace(keyFile))
+		if err != nil {
+			return nil, nil, fmt.Errorf("read %s file: %w", kind, err)
+		}
+		encoded = strings.TrimSpace(string(data))
+	}
+	current, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, nil, fmt.Errorf("decode %s: %w", kind, err)
+	}
+	previous := make([][]byte, 0, len(previousKeys))
+	for i, item := range previousKeys {
+		decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(item))
+		if err != nil {
+			return nil, nil, fmt.Errorf("decode previous %s %d: %w", kind, i, err)
+		}
+		previous = append(previous, decoded)
+	}
+	return current, previous, nil
+}
+
+// SnapshotConfig defines where the last known good runtime configs served
+// while the database is unavailable are persisted. They are kept in memory
+// only when Dir is empty.
+type SnapshotConfig struct {
+	Dir string `yaml:"dir"`
 }
 
 // LogConfig defines logging configuration.
@@ -245,6 +331,12 @@ func defaultConfig() *Config {
 			Compress:   true,
 			JSON:       false,
 		},
+		Auth: AuthConfig{
+			AdminRoles: []string{"admin"},
+		},
+		Secret: SecretConfig{
+			RevealRoles: []string{"admin"},
+		},
 	}
 }
 
@@ -294,6 +386,12 @@ func applyDefaults(cfg *Config) {
 	if cfg.Log.MaxAge <= 0 {
 		cfg.Log.MaxAge = 30
 	}
+	if len(cfg.Auth.AdminRoles) == 0 {
+		cfg.Auth.AdminRoles = []string{"admin"}
+	}
+	if len(cfg.Secret.RevealRoles) == 0 {
+		cfg.Secret.RevealRoles = []string{"admin"}
+	}
 }
 
 // findConfigFile searches for a config file in the current directory first,
@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -61,3 +62,56 @@ func assertDefaultConfig(t *testing.T, cfg *Config) {
 		t.Fatalf("expected default sqlite path data/resource.db, got %s", cfg.Database.SQLite.Path)
 	}
 }
+
+func TestSecretConfigLoadKeys(t *testing.T) {
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "secret.key")
+	current := "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+	if err := os.WriteFile(keyFile, []byte(current+"\n"), 0o600); err != nil {
+		t.Fatalf("write key file: %v", err)
+	}
+
+	cfg := SecretConfig{KeyFile: keyFile, PreviousKeys: []string{"ZmVkY2JhOTg3NjU0MzIxMGZlZGNiYTk4NzY1NDMyMTA="}}
+	if !cfg.Configured() {
+		t.Fatalf("expected key file to configure secrets")
+	}
+	key, previous, err := cfg.LoadKeys()
+	if err != nil {
+		t.Fatalf("LoadKeys returned error: %v", err)
+	}
+	if string(key) != "0123456789abcdef0123456789abcdef" {
+		t.Fatalf("unexpected current key %q", key)
+	}
+	if len(previous) != 1 || string(previous[0]) != "fedcba9876543210fedcba9876543210" {
+		t.Fatalf("unexpected previous keys %q", previous)
+	}
+
+	if _, _, err := (SecretConfig{Key: "not base64!"}).LoadKeys(); err == nil {
+		t.Fatalf("expected error for invalid key")
+	}
+}
+
+func TestSigningConfigLoadKeys(t *testing.T) {
+	if (SigningConfig{PreviousPublicKeys: []string{"AAAA"}}).Configured() {
+		t.Fatalf("expected previous keys alone not to configure signing")
+	}
+	cfg := SigningConfig{
+		Key:                "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=",
+		PreviousPublicKeys: []string{" ZmVkY2JhOTg3NjU0MzIxMGZlZGNiYTk4NzY1NDMyMTA= "},
+	}
+	seed, previous, err := cfg.LoadKeys()
+	if err != nil {
+		t.Fatalf("LoadKeys returned error: %v", err)
+	}
+	if string(seed) != "0123456789abcdef0123456789abcdef" {
+		t.Fatalf("unexpected seed %q", seed)
+	}
+	if len(previous) != 1 || string(previous[0]) != "fedcba9876543210fedcba9876543210" {
+		t.Fatalf("unexpected previous keys %q", previous)
+	}
+
+	_, _, err = (SigningConfig{KeyFile: filepath.Join(t.TempDir(), "missing.key")}).LoadKeys()
+	if err == nil || !strings.Contains(err.Error(), "read signing key file") {
+		t.Fatalf("expected error for missing key file, got %v", err)
+	}
+}
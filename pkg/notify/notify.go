@@ -0,0 +1,94 @@
+package notify
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Notifier wakes up the goroutines waiting for changes of a topic. With Redis,
+// changes are published over a channel so that waiters on every replica wake
+// up; without Redis only the waiters of this process do.
+type Notifier struct {
+	client  *redis.Client
+	channel string
+
+	mu      sync.Mutex
+	waiters map[string]map[chan struct{}]struct{}
+}
+
+// New creates a Notifier. A nil client keeps notifications in-process;
+// otherwise the Notifier subscribes to channel for the lifetime of the process.
+func New(client *redis.Client, channel string) *Notifier {
+	n := &Notifier{
+		client:  client,
+		channel: channel,
+		waiters: make(map[string]map[chan struct{}]struct{}),
+	}
+	if client != nil {
+		go n.listen()
+	}
+	return n
+}
+
+// Subscribe returns a channel that receives a value after any of topics
+// changed, and a function releasing the subscription. Changes arriving before
+// the previous one was received are coalesced.
+func (n *Notifier) Subscribe(topics ...string) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+	n.mu.Lock()
+	for _, topic := range topics {
+		if n.waiters[topic] == nil {
+			n.waiters[topic] = make(map[chan struct{}]struct{})
+		}
+		n.waiters[topic][ch] = struct{}{}
+	}
+	n.mu.Unlock()
+
+	return ch, func() {
+		n.mu.Lock()
+		defer n.mu.Unlock()
+		for _, topic := range topics {
+			delete(n.waiters[topic], ch)
+			if len(n.waiters[topic]) == 0 {
+				delete(n.waiters, topic)
+			}
+		}
+	}
+}
+
+// Publish announces a change of topic. When Redis cannot be reached, the
+// waiters of this process are still woken up.
+func (n *Notifier) Publish(ctx context.Context, topic string) error {
+	if n.client == nil {
+		n.wake(topic)
+		return nil
+	}
+	if err := n.client.Publish(ctx, n.channel, topic).Err(); err != nil {
+		n.wake(topic)
+		return err
+	}
+	return nil
+}
+
+func (n *Notifier) listen() {
+	// 断线后由客户端自动重新订阅
+	pubsub := n.client.Subscribe(context.Background(), n.channel)
+	for msg := range pubsub.Channel() {
+		n.wake(msg.Payload)
+	}
+	log.Printf("Notification channel %s closed", n.channel)
+}
+
+func (n *Notifier) wake(topic string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for ch := range n.waiters[topic] {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
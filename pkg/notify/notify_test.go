@@ -0,0 +1,46 @@
+package notify
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNotifierInProcess(t *testing.T) {
+	n := New(nil, "")
+	ctx := context.Background()
+
+	wake, cancel := n.Subscribe("prod:main", "prod:_base")
+	if err := n.Publish(ctx, "prod:other"); err != nil {
+		t.Fatalf("Publish failed: %v", err)
+	}
+	select {
+	case <-wake:
+		t.Fatal("woken by an unrelated topic")
+	default:
+	}
+
+	// 未及时接收的多次变更合并为一次唤醒
+	_ = n.Publish(ctx, "prod:_base")
+	_ = n.Publish(ctx, "prod:main")
+	select {
+	case <-wake:
+	default:
+		t.Fatal("expected a wake-up")
+	}
+	select {
+	case <-wake:
+		t.Fatal("expected coalesced wake-ups")
+	default:
+	}
+
+	cancel()
+	_ = n.Publish(ctx, "prod:main")
+	select {
+	case <-wake:
+		t.Fatal("woken after cancel")
+	default:
+	}
+	if len(n.waiters) != 0 {
+		t.Fatalf("waiters left after cancel: %v", n.waiters)
+	}
+}
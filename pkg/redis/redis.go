@@ -10,6 +10,10 @@ import (
 	"github.com/yi-nology/rainbow_bridge/pkg/config"
 )
 
+// RuntimeChangeChannel is the pub/sub channel changes of runtime configs are
+// announced on.
+const RuntimeChangeChannel = "rainbow_bridge:runtime:changes"
+
 // NewClient creates a Redis client based on the provided configuration.
 // Returns nil, nil if Redis is not enabled.
 func NewClient(cfg config.RedisConfig) (*redis.Client, error) {
@@ -53,6 +57,24 @@ func Set(ctx context.Context, client *redis.Client, key string, value interface{
 	return client.Set(ctx, key, data, expiration).Err()
 }
 
+// ExpirationUntil caps ttl so that an entry cached at now expires no later than
+// boundary, e.g. the next time a scheduled config is activated or expires. A zero
+// boundary leaves ttl unchanged.
+func ExpirationUntil(ttl time.Duration, now, boundary time.Time) time.Duration {
+	if boundary.IsZero() {
+		return ttl
+	}
+	remaining := boundary.Sub(now)
+	if remaining < time.Millisecond {
+		// 0 would mean "never expire"
+		return time.Millisecond
+	}
+	if remaining < ttl {
+		return remaining
+	}
+	return ttl
+}
+
 // Get gets a value from Redis and unmarshals it into the provided pointer
 func Get(ctx context.Context, client *redis.Client, key string, dest interface{}) (bool, error) {
 	if client == nil {
@@ -125,7 +147,32 @@ func GenerateConfigListKey(environmentKey, pipelineKey string) string {
 	return fmt.Sprintf("rainbow_bridge:config:list:%s:%s", environmentKey, pipelineKey)
 }
 
-// GenerateConfigMapKey generates a Redis key for config map data
-func GenerateConfigMapKey(environmentKey, pipelineKey string) string {
-	return fmt.Sprintf("rainbow_bridge:config:map:%s:%s", environmentKey, pipelineKey)
+// GenerateRuntimeSourceKey generates a Redis key for what the configs served to
+// runtime clients of an environment/pipeline are rendered from, per state of its
+// change counters
+func GenerateRuntimeSourceKey(environmentKey, pipelineKey string, counter, baseCounter int64) string {
+	return fmt.Sprintf("rainbow_bridge:config:runtime_source:%s:%s:%d:%d", environmentKey, pipelineKey, counter, baseCounter)
+}
+
+// GenerateRuntimeScheduleKey generates a Redis key for what changes the runtime
+// configs of an environment/pipeline between recorded changes, per state of its
+// change counters
+func GenerateRuntimeScheduleKey(environmentKey, pipelineKey string, counter, baseCounter int64) string {
+	return fmt.Sprintf("rainbow_bridge:config:runtime_schedule:%s:%s:%d:%d", environmentKey, pipelineKey, counter, baseCounter)
+}
+
+// GenerateAliasRedirectKey generates a Redis key for the alias redirects of an environment/pipeline
+func GenerateAliasRedirectKey(environmentKey, pipelineKey string) string {
+	return fmt.Sprintf("rainbow_bridge:config:redirect:%s:%s", environmentKey, pipelineKey)
+}
+
+// GenerateConfigMapKey generates a Redis key for config map data rendered for
+// callers of a visibility scope
+func GenerateConfigMapKey(environmentKey, pipelineKey, scope string) string {
+	return fmt.Sprintf("rainbow_bridge:config:map:%s:%s:%s", environmentKey, pipelineKey, scope)
+}
+
+// GenerateLocalizedConfigMapKey generates a Redis key for config map data served in the preferred locales
+func GenerateLocalizedConfigMapKey(environmentKey, pipelineKey, scope, locales string) string {
+	return fmt.Sprintf("rainbow_bridge:config:map:%s:%s:%s:locale:%s", environmentKey, pipelineKey, scope, locales)
 }
@@ -0,0 +1,101 @@
+package util
+
+import (
+	"strings"
+)
+
+// maxLineDiffCells bounds the LCS table of LineDiff; larger inputs are shown
+// as a full replacement.
+const maxLineDiffCells = 4 << 20
+
+// LineDiff compares two texts line by line. Every line of the result starts
+// with "-" (only in before), "+" (only in after) or " " (in both). Equal texts
+// yield an empty string.
+func LineDiff(before, after string) string {
+	if before == after {
+		return ""
+	}
+	a, b := splitLines(before), splitLines(after)
+
+	// 公共前后缀不参与 LCS 计算
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var out strings.Builder
+	for _, line := range a[:prefix] {
+		writeDiffLine(&out, ' ', line)
+	}
+	midA, midB := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	if len(midA)*len(midB) > maxLineDiffCells {
+		for _, line := range midA {
+			writeDiffLine(&out, '-', line)
+		}
+		for _, line := range midB {
+			writeDiffLine(&out, '+', line)
+		}
+	} else {
+		diffLinesLCS(&out, midA, midB)
+	}
+	for _, line := range a[len(a)-suffix:] {
+		writeDiffLine(&out, ' ', line)
+	}
+	return strings.TrimSuffix(out.String(), "\n")
+}
+
+func diffLinesLCS(out *strings.Builder, a, b []string) {
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			writeDiffLine(out, ' ', a[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			writeDiffLine(out, '-', a[i])
+			i++
+		default:
+			writeDiffLine(out, '+', b[j])
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		writeDiffLine(out, '-', a[i])
+	}
+	for ; j < len(b); j++ {
+		writeDiffLine(out, '+', b[j])
+	}
+}
+
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+func writeDiffLine(out *strings.Builder, op byte, line string) {
+	out.WriteByte(op)
+	out.WriteString(line)
+	out.WriteByte('\n')
+}
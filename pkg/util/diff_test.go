@@ -0,0 +1,35 @@
+package util
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLineDiff(t *testing.T) {
+	cases := []struct {
+		name, before, after, want string
+	}{
+		{"equal", "a\nb", "a\nb", ""},
+		{"create", "", "a\nb", "+a\n+b"},
+		{"delete", "a\n", "", "-a"},
+		{"change middle", "{\n  \"x\": 1,\n  \"y\": 2\n}", "{\n  \"x\": 1,\n  \"y\": 3\n}", " {\n   \"x\": 1,\n-  \"y\": 2\n+  \"y\": 3\n }"},
+		{"insert and remove", "a\nb\nc\nd", "a\nc\nd\ne", " a\n-b\n c\n d\n+e"},
+		{"single line", "old", "new", "-old\n+new"},
+	}
+	for _, tc := range cases {
+		if got := LineDiff(tc.before, tc.after); got != tc.want {
+			t.Errorf("%s: LineDiff =\n%s\nwant\n%s", tc.name, got, tc.want)
+		}
+	}
+
+	// 超出 LCS 上限时整体替换
+	var before, after strings.Builder
+	for i := 0; i < 3000; i++ {
+		before.WriteString("a\n")
+		after.WriteString("b\n")
+	}
+	got := LineDiff(before.String(), after.String())
+	if strings.Count(got, "-a") != 3000 || strings.Count(got, "+b") != 3000 {
+		t.Errorf("unexpected diff of large inputs: %d lines", strings.Count(got, "\n")+1)
+	}
+}
@@ -0,0 +1,84 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// schemaResourceURL is the location the compiled schema document is registered under.
+const schemaResourceURL = "mem://rainbow_bridge/config-schema.json"
+
+var schemaMessagePrinter = message.NewPrinter(language.English)
+
+// SchemaViolation is a single JSON Schema failure. Path is the JSON pointer of
+// the offending value inside the config content ("/" for the root).
+type SchemaViolation struct {
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// CompileJSONSchema compiles a JSON Schema document. Documents without
+// "$schema" are treated as draft 2020-12. Remote and file references are not
+// resolved, so a schema can only reference itself.
+func CompileJSONSchema(raw string) (*jsonschema.Schema, error) {
+	doc, err := jsonschema.UnmarshalJSON(strings.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema JSON: %w", err)
+	}
+	compiler := jsonschema.NewCompiler()
+	compiler.DefaultDraft(jsonschema.Draft2020)
+	compiler.UseLoader(jsonschema.SchemeURLLoader{})
+	if err := compiler.AddResource(schemaResourceURL, doc); err != nil {
+		return nil, err
+	}
+	return compiler.Compile(schemaResourceURL)
+}
+
+// ValidateJSONSchema validates JSON content against a compiled schema and
+// returns the violations ordered by path. An empty result means the content is valid.
+func ValidateJSONSchema(schema *jsonschema.Schema, content string) ([]SchemaViolation, error) {
+	inst, err := jsonschema.UnmarshalJSON(strings.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON content: %w", err)
+	}
+	err = schema.Validate(inst)
+	if err == nil {
+		return nil, nil
+	}
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		return nil, err
+	}
+
+	var violations []SchemaViolation
+	collectSchemaViolations(validationErr, &violations)
+	sort.SliceStable(violations, func(i, j int) bool { return violations[i].Path < violations[j].Path })
+	return violations, nil
+}
+
+// collectSchemaViolations flattens the error tree into its leaves, which carry
+// the concrete keyword failures.
+func collectSchemaViolations(err *jsonschema.ValidationError, out *[]SchemaViolation) {
+	if len(err.Causes) > 0 {
+		for _, cause := range err.Causes {
+			collectSchemaViolations(cause, out)
+		}
+		return
+	}
+	path := "/" + strings.Join(escapeJSONPointer(err.InstanceLocation), "/")
+	*out = append(*out, SchemaViolation{Path: path, Message: err.ErrorKind.LocalizedString(schemaMessagePrinter)})
+}
+
+func escapeJSONPointer(tokens []string) []string {
+	escaped := make([]string, len(tokens))
+	for i, tok := range tokens {
+		escaped[i] = strings.ReplaceAll(strings.ReplaceAll(tok, "~", "~0"), "/", "~1")
+	}
+	return escaped
+}
@@ -0,0 +1,63 @@
+package util
+
+import "testing"
+
+const testConfigSchema = `{
+	"type": "object",
+	"properties": {
+		"title": {"type": "string"},
+		"banner": {
+			"type": "object",
+			"properties": {"width": {"type": "integer", "minimum": 1}},
+			"required": ["width"],
+			"additionalProperties": false
+		}
+	},
+	"required": ["title"]
+}`
+
+func TestValidateJSONSchema(t *testing.T) {
+	schema, err := CompileJSONSchema(testConfigSchema)
+	if err != nil {
+		t.Fatalf("CompileJSONSchema failed: %v", err)
+	}
+
+	violations, err := ValidateJSONSchema(schema, `{"title":"hi","banner":{"width":10}}`)
+	if err != nil {
+		t.Fatalf("ValidateJSONSchema failed: %v", err)
+	}
+	if len(violations) != 0 {
+		t.Fatalf("Expected valid content, got %+v", violations)
+	}
+
+	violations, err = ValidateJSONSchema(schema, `{"banner":{"widht":10}}`)
+	if err != nil {
+		t.Fatalf("ValidateJSONSchema failed: %v", err)
+	}
+	paths := make(map[string]bool, len(violations))
+	for _, v := range violations {
+		paths[v.Path] = true
+		if v.Message == "" {
+			t.Errorf("Expected a message for %s", v.Path)
+		}
+	}
+	if !paths["/"] || !paths["/banner"] {
+		t.Errorf("Expected violations at / and /banner, got %+v", violations)
+	}
+
+	violations, err = ValidateJSONSchema(schema, `{"title":1,"banner":{"width":0}}`)
+	if err != nil {
+		t.Fatalf("ValidateJSONSchema failed: %v", err)
+	}
+	if len(violations) != 2 || violations[0].Path != "/banner/width" || violations[1].Path != "/title" {
+		t.Errorf("Expected violations at /banner/width and /title, got %+v", violations)
+	}
+}
+
+func TestCompileJSONSchemaRejectsInvalid(t *testing.T) {
+	for _, raw := range []string{`{`, `{"type": 1}`, `{"$ref": "file:///etc/passwd"}`, `{"$ref": "https://example.com/schema.json"}`} {
+		if _, err := CompileJSONSchema(raw); err == nil {
+			t.Errorf("Expected error for schema %s", raw)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Label limits. Keys follow the Kubernetes style ("team", "app.kubernetes.io/name")
+// and values are short identifiers so both can be indexed on every database.
+const (
+	MaxLabelsPerConfig = 32
+	MaxLabelKeyLength  = 63
+	MaxLabelValueLen   = 128
+)
+
+var (
+	labelKeyRegexp   = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9._/-]*[A-Za-z0-9])?$`)
+	labelValueRegexp = regexp.MustCompile(`^([A-Za-z0-9]([A-Za-z0-9._-]*[A-Za-z0-9])?)?$`)
+)
+
+// Label selector operators.
+const (
+	LabelOpEquals    = "="
+	LabelOpNotEquals = "!="
+	LabelOpExists    = "exists"
+	LabelOpNotExists = "!exists"
+)
+
+// LabelRequirement is one term of a label selector.
+type LabelRequirement struct {
+	Key      string
+	Operator string
+	// Value is empty for the exists operators.
+	Value string
+}
+
+// ParseLabels decodes the labels stored on a config. An empty string yields no labels.
+func ParseLabels(raw string) (map[string]string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return map[string]string{}, nil
+	}
+	labels := map[string]string{}
+	if err := json.Unmarshal([]byte(raw), &labels); err != nil {
+		return nil, fmt.Errorf("invalid labels: %w", err)
+	}
+	if err := ValidateLabels(labels); err != nil {
+		return nil, err
+	}
+	return labels, nil
+}
+
+// FormatLabels encodes labels the way they are stored: a JSON object with sorted
+// keys, or an empty string when there are none.
+func FormatLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	data, _ := json.Marshal(labels)
+	return string(data)
+}
+
+// ValidateLabels checks the number of labels and the syntax of their keys and values.
+func ValidateLabels(labels map[string]string) error {
+	if len(labels) > MaxLabelsPerConfig {
+		return fmt.Errorf("at most %d labels are allowed", MaxLabelsPerConfig)
+	}
+	for key, value := range labels {
+		if err := validateLabelKey(key); err != nil {
+			return err
+		}
+		if err := validateLabelValue(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ParseLabelSelector parses a comma separated label selector. Supported terms
+// are "key=value" (or "key==value"), "key!=value", "key" (label present) and
+// "!key" (label absent), e.g. "team=growth,tier!=canary,!deprecated".
+func ParseLabelSelector(selector string) ([]LabelRequirement, error) {
+	selector = strings.TrimSpace(selector)
+	if selector == "" {
+		return nil, nil
+	}
+	terms := strings.Split(selector, ",")
+	requirements := make([]LabelRequirement, 0, len(terms))
+	for _, term := range terms {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			return nil, fmt.Errorf("invalid label selector %q: empty term", selector)
+		}
+		var req LabelRequirement
+		switch {
+		case strings.Contains(term, "!="):
+			key, value, _ := strings.Cut(term, "!=")
+			req = LabelRequirement{Key: strings.TrimSpace(key), Operator: LabelOpNotEquals, Value: strings.TrimSpace(value)}
+		case strings.Contains(term, "="):
+			key, value, _ := strings.Cut(term, "=")
+			value = strings.TrimPrefix(value, "=")
+			req = LabelRequirement{Key: strings.TrimSpace(key), Operator: LabelOpEquals, Value: strings.TrimSpace(value)}
+		case strings.HasPrefix(term, "!"):
+			req = LabelRequirement{Key: strings.TrimSpace(strings.TrimPrefix(term, "!")), Operator: LabelOpNotExists}
+		default:
+			req = LabelRequirement{Key: term, Operator: LabelOpExists}
+		}
+		if err := validateLabelKey(req.Key); err != nil {
+			return nil, fmt.Errorf("invalid label selector %q: %w", term, err)
+		}
+		if err := validateLabelValue(req.Key, req.Value); err != nil {
+			return nil, fmt.Errorf("invalid label selector %q: %w", term, err)
+		}
+		requirements = append(requirements, req)
+	}
+	return requirements, nil
+}
+
+func validateLabelKey(key string) error {
+	if len(key) == 0 || len(key) > MaxLabelKeyLength || !labelKeyRegexp.MatchString(key) {
+		return fmt.Errorf("invalid label key %q", key)
+	}
+	return nil
+}
+
+func validateLabelValue(key, value string) error {
+	if len(value) > MaxLabelValueLen || !labelValueRegexp.MatchString(value) {
+		return fmt.Errorf("invalid value %q for label %q", value, key)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package util
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseLabels(t *testing.T) {
+	labels, err := ParseLabels(`{"team":"growth","app.kubernetes.io/name":"home","empty":""}`)
+	if err != nil {
+		t.Fatalf("ParseLabels returned error: %v", err)
+	}
+	if labels["team"] != "growth" || labels["app.kubernetes.io/name"] != "home" || len(labels) != 3 {
+		t.Fatalf("unexpected labels: %v", labels)
+	}
+	if got := FormatLabels(labels); got != `{"app.kubernetes.io/name":"home","empty":"","team":"growth"}` {
+		t.Fatalf("FormatLabels = %s", got)
+	}
+	if got := FormatLabels(nil); got != "" {
+		t.Fatalf("FormatLabels(nil) = %q, want empty", got)
+	}
+
+	for _, raw := range []string{`[1]`, `{"":"x"}`, `{"-team":"x"}`, `{"team":"a b"}`, `{"team":1}`} {
+		if _, err := ParseLabels(raw); err == nil {
+			t.Fatalf("expected error for %s", raw)
+		}
+	}
+}
+
+func TestParseLabelSelector(t *testing.T) {
+	got, err := ParseLabelSelector(" team=growth, tier!=canary,env==prod,critical,!deprecated ")
+	if err != nil {
+		t.Fatalf("ParseLabelSelector returned error: %v", err)
+	}
+	want := []LabelRequirement{
+		{Key: "team", Operator: LabelOpEquals, Value: "growth"},
+		{Key: "tier", Operator: LabelOpNotEquals, Value: "canary"},
+		{Key: "env", Operator: LabelOpEquals, Value: "prod"},
+		{Key: "critical", Operator: LabelOpExists},
+		{Key: "deprecated", Operator: LabelOpNotExists},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ParseLabelSelector = %+v, want %+v", got, want)
+	}
+
+	for _, selector := range []string{"team=growth,", "=x", "!", "team=a b", "team in (a,b)"} {
+		if _, err := ParseLabelSelector(selector); err == nil {
+			t.Fatalf("expected error for %q", selector)
+		}
+	}
+}
@@ -0,0 +1,241 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Translation limits.
+const (
+	MaxTranslationsPerConfig = 32
+	MaxLocalesPerPipeline    = 32
+)
+
+// NormalizeLocale canonicalises a BCP 47 language tag: the language in lower
+// case, a script in title case, a region in upper case and variants in lower
+// case, e.g. "zh_hant_tw" becomes "zh-Hant-TW". Extensions are not supported.
+func NormalizeLocale(tag string) (string, error) {
+	tag = strings.TrimSpace(tag)
+	if tag == "" {
+		return "", fmt.Errorf("locale is required")
+	}
+	parts := strings.Split(strings.ReplaceAll(tag, "_", "-"), "-")
+	if !isAlpha(parts[0]) || len(parts[0]) < 2 || len(parts[0]) > 3 {
+		return "", fmt.Errorf("invalid locale %q", tag)
+	}
+	parts[0] = strings.ToLower(parts[0])
+	next := 1
+	if next < len(parts) && len(parts[next]) == 4 && isAlpha(parts[next]) {
+		parts[next] = strings.ToUpper(parts[next][:1]) + strings.ToLower(parts[next][1:])
+		next++
+	}
+	if next < len(parts) && (len(parts[next]) == 2 && isAlpha(parts[next]) || len(parts[next]) == 3 && isDigits(parts[next])) {
+		parts[next] = strings.ToUpper(parts[next])
+		next++
+	}
+	for ; next < len(parts); next++ {
+		variant := parts[next]
+		if !isAlphanumeric(variant) || !(len(variant) >= 5 && len(variant) <= 8 || len(variant) == 4 && isDigits(variant[:1])) {
+			return "", fmt.Errorf("invalid locale %q", tag)
+		}
+		parts[next] = strings.ToLower(variant)
+	}
+	return strings.Join(parts, "-"), nil
+}
+
+// ParseLocaleList parses a comma separated list of locales. The result is
+// normalised and free of duplicates; an empty string yields no locales.
+func ParseLocaleList(raw string) ([]string, error) {
+	var locales []string
+	for _, part := range strings.Split(raw, ",") {
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+		locale, err := NormalizeLocale(part)
+		if err != nil {
+			return nil, err
+		}
+		locales = appendLocale(locales, locale)
+	}
+	if len(locales) > MaxLocalesPerPipeline {
+		return nil, fmt.Errorf("at most %d locales are allowed", MaxLocalesPerPipeline)
+	}
+	return locales, nil
+}
+
+// ParseAcceptLanguage returns the locales of an Accept-Language header (or of a
+// list in the same syntax) ordered by descending quality; entries of equal
+// quality keep their order. Invalid entries, "*" and entries with q=0 are left out.
+func ParseAcceptLanguage(header string) []string {
+	type weighted struct {
+		locale  string
+		quality float64
+	}
+	var entries []weighted
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		locale, err := NormalizeLocale(fields[0])
+		if err != nil {
+			continue
+		}
+		quality := 1.0
+		for _, param := range fields[1:] {
+			name, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if !ok || !strings.EqualFold(strings.TrimSpace(name), "q") {
+				continue
+			}
+			q, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil || q < 0 || q > 1 {
+				q = 0
+			}
+			quality = q
+		}
+		if quality > 0 {
+			entries = append(entries, weighted{locale: locale, quality: quality})
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].quality > entries[j].quality })
+	var locales []string
+	for _, entry := range entries {
+		locales = appendLocale(locales, entry.locale)
+	}
+	return locales
+}
+
+// LocaleFallbacks returns locale followed by the tags obtained by dropping its
+// subtags from the end, e.g. zh-Hant-TW, zh-Hant, zh.
+func LocaleFallbacks(locale string) []string {
+	chain := []string{locale}
+	for {
+		idx := strings.LastIndex(locale, "-")
+		if idx < 0 {
+			return chain
+		}
+		locale = locale[:idx]
+		chain = append(chain, locale)
+	}
+}
+
+// MatchLocale returns the available locale serving the preferred locales, which
+// are tried in order. A preferred locale matches an available locale equal to
+// it or to one of its fallbacks; when no preferred locale matches that way, the
+// first available locale of the same language as a preferred locale is used.
+// The result is empty when nothing matches.
+func MatchLocale(preferred, available []string) string {
+	if len(preferred) == 0 || len(available) == 0 {
+		return ""
+	}
+	byKey := make(map[string]string, len(available))
+	for _, locale := range available {
+		byKey[strings.ToLower(locale)] = locale
+	}
+	for _, locale := range preferred {
+		for _, candidate := range LocaleFallbacks(locale) {
+			if match, ok := byKey[strings.ToLower(candidate)]; ok {
+				return match
+			}
+		}
+	}
+	for _, locale := range preferred {
+		language := strings.ToLower(LocaleLanguage(locale))
+		for _, candidate := range available {
+			if strings.ToLower(LocaleLanguage(candidate)) == language {
+				return candidate
+			}
+		}
+	}
+	return ""
+}
+
+// LocaleLanguage returns the language subtag of a locale.
+func LocaleLanguage(locale string) string {
+	language, _, _ := strings.Cut(locale, "-")
+	return language
+}
+
+// NormalizeTranslations validates the translations of a config and returns
+// them keyed by normalised locale with trimmed values.
+func NormalizeTranslations(translations map[string]string) (map[string]string, error) {
+	if len(translations) > MaxTranslationsPerConfig {
+		return nil, fmt.Errorf("at most %d translations are allowed", MaxTranslationsPerConfig)
+	}
+	normalized := make(map[string]string, len(translations))
+	for tag, value := range translations {
+		locale, err := NormalizeLocale(tag)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := normalized[locale]; ok {
+			return nil, fmt.Errorf("duplicate translation for locale %s", locale)
+		}
+		value = strings.TrimSpace(value)
+		if value == "" {
+			return nil, fmt.Errorf("translation for locale %s must not be empty", locale)
+		}
+		normalized[locale] = value
+	}
+	return normalized, nil
+}
+
+// ParseTranslations decodes the translations stored on a config. An empty
+// string yields no translations.
+func ParseTranslations(raw string) (map[string]string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return map[string]string{}, nil
+	}
+	translations := map[string]string{}
+	if err := json.Unmarshal([]byte(raw), &translations); err != nil {
+		return nil, fmt.Errorf("invalid translations: %w", err)
+	}
+	return NormalizeTranslations(translations)
+}
+
+// FormatTranslations encodes translations the way they are stored: a JSON
+// object with sorted keys, or an empty string when there are none.
+func FormatTranslations(translations map[string]string) string {
+	if len(translations) == 0 {
+		return ""
+	}
+	data, _ := json.Marshal(translations)
+	return string(data)
+}
+
+func appendLocale(locales []string, locale string) []string {
+	for _, existing := range locales {
+		if existing == locale {
+			return locales
+		}
+	}
+	return append(locales, locale)
+}
+
+func isAlpha(s string) bool {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	return s != ""
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+func isAlphanumeric(s string) bool {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,105 @@
+package util
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeLocale(t *testing.T) {
+	cases := map[string]string{
+		"zh-CN":          "zh-CN",
+		"en_us":          "en-US",
+		"ZH-hant-tw":     "zh-Hant-TW",
+		"ja":             "ja",
+		"es-419":         "es-419",
+		"de-DE-1996":     "de-DE-1996",
+		" sl-rozaj ":     "sl-rozaj",
+		"sr-latn-RS":     "sr-Latn-RS",
+		"yue-Hant-HK":    "yue-Hant-HK",
+		"en-GB-oxendict": "en-GB-oxendict",
+	}
+	for tag, want := range cases {
+		got, err := NormalizeLocale(tag)
+		if err != nil {
+			t.Fatalf("NormalizeLocale(%q) returned error: %v", tag, err)
+		}
+		if got != want {
+			t.Fatalf("NormalizeLocale(%q) = %q, want %q", tag, got, want)
+		}
+	}
+
+	for _, tag := range []string{"", "*", "e", "english", "zh-", "zh-CN-x-private", "en-US-ab", "1a"} {
+		if _, err := NormalizeLocale(tag); err == nil {
+			t.Fatalf("expected error for %q", tag)
+		}
+	}
+}
+
+func TestParseLocaleList(t *testing.T) {
+	got, err := ParseLocaleList(" en-us, ja-JP,,en-US ")
+	if err != nil {
+		t.Fatalf("ParseLocaleList returned error: %v", err)
+	}
+	if want := []string{"en-US", "ja-JP"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("ParseLocaleList = %v, want %v", got, want)
+	}
+	if got, err := ParseLocaleList(""); err != nil || len(got) != 0 {
+		t.Fatalf("ParseLocaleList(\"\") = %v, %v", got, err)
+	}
+	if _, err := ParseLocaleList("en-US,bad locale"); err == nil {
+		t.Fatal("expected error for invalid locale")
+	}
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	got := ParseAcceptLanguage("fr-CH, fr;q=0.9, en;q=0.8, de;q=0.7, *;q=0.5, it;q=0, ja-jp")
+	want := []string{"fr-CH", "ja-JP", "fr", "en", "de"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ParseAcceptLanguage = %v, want %v", got, want)
+	}
+	if got := ParseAcceptLanguage(""); len(got) != 0 {
+		t.Fatalf("ParseAcceptLanguage(\"\") = %v, want none", got)
+	}
+}
+
+func TestMatchLocale(t *testing.T) {
+	available := []string{"en-US", "ja-JP", "zh-Hant", "zh-CN"}
+	cases := []struct {
+		preferred []string
+		want      string
+	}{
+		{[]string{"ja-JP"}, "ja-JP"},
+		{[]string{"zh-Hant-TW"}, "zh-Hant"},
+		{[]string{"zh-HK"}, "zh-Hant"},
+		{[]string{"fr-FR", "en-GB"}, "en-US"},
+		{[]string{"en-gb", "ja-JP"}, "ja-JP"},
+		{[]string{"ja", "en-US"}, "en-US"},
+		{[]string{"ja"}, "ja-JP"},
+		{[]string{"fr-FR"}, ""},
+		{nil, ""},
+	}
+	for _, tc := range cases {
+		if got := MatchLocale(tc.preferred, available); got != tc.want {
+			t.Fatalf("MatchLocale(%v) = %q, want %q", tc.preferred, got, tc.want)
+		}
+	}
+}
+
+func TestParseTranslations(t *testing.T) {
+	translations, err := ParseTranslations(`{"en_us":" Hello ","ja-jp":"こんにちは"}`)
+	if err != nil {
+		t.Fatalf("ParseTranslations returned error: %v", err)
+	}
+	if got := FormatTranslations(translations); got != `{"en-US":"Hello","ja-JP":"こんにちは"}` {
+		t.Fatalf("FormatTranslations = %s", got)
+	}
+	if got := FormatTranslations(nil); got != "" {
+		t.Fatalf("FormatTranslations(nil) = %q, want empty", got)
+	}
+
+	for _, raw := range []string{`[1]`, `{"english":"x"}`, `{"en-US":" "}`, `{"en-US":"a","en_us":"b"}`} {
+		if _, err := ParseTranslations(raw); err == nil {
+			t.Fatalf("expected error for %s", raw)
+		}
+	}
+}
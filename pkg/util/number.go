@@ -0,0 +1,154 @@
+package util
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// MaxNumberPrecision bounds the number of fractional digits a number or decimal config may declare.
+const MaxNumberPrecision = 18
+
+// numberLiteralRegexp accepts JSON number literals. The exponent is limited to two
+// digits so canonicalisation never expands a value into an unbounded digit string.
+var numberLiteralRegexp = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]{1,2})?$`)
+
+// NumberOptions constrains the value of a number or decimal config.
+// Precision is the maximum number of fractional digits; 0 allows integers only.
+type NumberOptions struct {
+	Min       *json.Number `json:"min,omitempty"`
+	Max       *json.Number `json:"max,omitempty"`
+	Precision *int         `json:"precision,omitempty"`
+}
+
+// ParseNumberOptions decodes the options of a number or decimal config. An empty
+// string yields empty options; unknown fields are rejected.
+func ParseNumberOptions(raw string) (*NumberOptions, error) {
+	opts := &NumberOptions{}
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return opts, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader([]byte(raw)))
+	decoder.DisallowUnknownFields()
+	decoder.UseNumber()
+	if err := decoder.Decode(opts); err != nil {
+		return nil, fmt.Errorf("invalid number options: %w", err)
+	}
+	if opts.Precision != nil && (*opts.Precision < 0 || *opts.Precision > MaxNumberPrecision) {
+		return nil, fmt.Errorf("precision must be between 0 and %d", MaxNumberPrecision)
+	}
+	var lower, upper *big.Rat
+	if opts.Min != nil {
+		value, err := parseNumberLiteral(opts.Min.String())
+		if err != nil {
+			return nil, fmt.Errorf("invalid min: %w", err)
+		}
+		lower = value
+	}
+	if opts.Max != nil {
+		value, err := parseNumberLiteral(opts.Max.String())
+		if err != nil {
+			return nil, fmt.Errorf("invalid max: %w", err)
+		}
+		upper = value
+	}
+	if lower != nil && upper != nil && lower.Cmp(upper) > 0 {
+		return nil, errors.New("min must not be greater than max")
+	}
+	return opts, nil
+}
+
+// CanonicalNumber validates value against opts and returns its canonical form: a
+// plain decimal literal without exponent or redundant zeros. When padPrecision is
+// set and opts declares a precision, the fraction is padded to exactly that many
+// digits (used by decimal configs, e.g. "1.5" with precision 2 becomes "1.50").
+func CanonicalNumber(value string, opts *NumberOptions, padPrecision bool) (string, error) {
+	number, err := parseNumberLiteral(value)
+	if err != nil {
+		return "", err
+	}
+	if opts == nil {
+		opts = &NumberOptions{}
+	}
+	if opts.Min != nil {
+		lower, _ := parseNumberLiteral(opts.Min.String())
+		if lower != nil && number.Cmp(lower) < 0 {
+			return "", fmt.Errorf("value must be greater than or equal to %s", opts.Min.String())
+		}
+	}
+	if opts.Max != nil {
+		upper, _ := parseNumberLiteral(opts.Max.String())
+		if upper != nil && number.Cmp(upper) > 0 {
+			return "", fmt.Errorf("value must be less than or equal to %s", opts.Max.String())
+		}
+	}
+
+	digits := fractionDigits(number)
+	if opts.Precision != nil {
+		if digits > *opts.Precision {
+			if *opts.Precision == 0 {
+				return "", errors.New("value must be an integer")
+			}
+			return "", fmt.Errorf("value must have at most %d fractional digits", *opts.Precision)
+		}
+		if padPrecision {
+			digits = *opts.Precision
+		}
+	}
+	return number.FloatString(digits), nil
+}
+
+// CanonicalBoolean accepts the usual spellings of a boolean and returns "true" or "false".
+func CanonicalBoolean(value string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "true", "1", "yes", "on":
+		return "true", nil
+	case "false", "0", "no", "off":
+		return "false", nil
+	default:
+		return "", fmt.Errorf("invalid boolean %q", value)
+	}
+}
+
+func parseNumberLiteral(value string) (*big.Rat, error) {
+	value = strings.TrimSpace(value)
+	if !numberLiteralRegexp.MatchString(value) {
+		return nil, fmt.Errorf("invalid number %q", value)
+	}
+	number, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return nil, fmt.Errorf("invalid number %q", value)
+	}
+	return number, nil
+}
+
+// fractionDigits returns the number of fractional digits needed to write number
+// exactly. Parsed literals always have a denominator of the form 2^a*5^b.
+func fractionDigits(number *big.Rat) int {
+	denom := new(big.Int).Set(number.Denom())
+	two, five := big.NewInt(2), big.NewInt(5)
+	var twos, fives int
+	mod := new(big.Int)
+	for denom.Cmp(big.NewInt(1)) > 0 {
+		if mod.Mod(denom, two).Sign() == 0 {
+			denom.Quo(denom, two)
+			twos++
+			continue
+		}
+		if mod.Mod(denom, five).Sign() == 0 {
+			denom.Quo(denom, five)
+			fives++
+			continue
+		}
+		break
+	}
+	if twos > fives {
+		return twos
+	}
+	return fives
+}
@@ -0,0 +1,81 @@
+package util
+
+import "testing"
+
+func TestCanonicalNumber(t *testing.T) {
+	cases := []struct {
+		value, options string
+		pad            bool
+		want           string
+	}{
+		{"42", "", false, "42"},
+		{"-0", "", false, "0"},
+		{"1.500", "", false, "1.5"},
+		{"1e3", "", false, "1000"},
+		{"2.5E-2", "", false, "0.025"},
+		{"0.1", `{"precision":2}`, false, "0.1"},
+		{"0.1", `{"precision":2}`, true, "0.10"},
+		{"10", `{"min":1,"max":10}`, false, "10"},
+		{"3", `{"precision":0}`, true, "3"},
+	}
+	for _, tc := range cases {
+		opts, err := ParseNumberOptions(tc.options)
+		if err != nil {
+			t.Fatalf("ParseNumberOptions(%q) returned error: %v", tc.options, err)
+		}
+		got, err := CanonicalNumber(tc.value, opts, tc.pad)
+		if err != nil {
+			t.Fatalf("CanonicalNumber(%q, %s) returned error: %v", tc.value, tc.options, err)
+		}
+		if got != tc.want {
+			t.Fatalf("CanonicalNumber(%q, %s) = %q, want %q", tc.value, tc.options, got, tc.want)
+		}
+	}
+}
+
+func TestCanonicalNumberRejectsInvalid(t *testing.T) {
+	cases := []struct {
+		value, options string
+	}{
+		{"", ""},
+		{"abc", ""},
+		{"1/3", ""},
+		{"+1", ""},
+		{"01", ""},
+		{"1e400", ""},
+		{"NaN", ""},
+		{"0", `{"min":1}`},
+		{"11", `{"max":10}`},
+		{"1.5", `{"precision":0}`},
+		{"1.234", `{"precision":2}`},
+	}
+	for _, tc := range cases {
+		opts, err := ParseNumberOptions(tc.options)
+		if err != nil {
+			t.Fatalf("ParseNumberOptions(%q) returned error: %v", tc.options, err)
+		}
+		if _, err := CanonicalNumber(tc.value, opts, false); err == nil {
+			t.Fatalf("expected error for %q with options %s", tc.value, tc.options)
+		}
+	}
+}
+
+func TestParseNumberOptionsRejectsInvalid(t *testing.T) {
+	for _, raw := range []string{`{"min":2,"max":1}`, `{"precision":-1}`, `{"precision":19}`, `{"step":1}`, `[1]`, `{"min":"x"}`} {
+		if _, err := ParseNumberOptions(raw); err == nil {
+			t.Fatalf("expected error for %s", raw)
+		}
+	}
+}
+
+func TestCanonicalBoolean(t *testing.T) {
+	for raw, want := range map[string]string{"true": "true", "TRUE": "true", "1": "true", "on": "true", "false": "false", "No": "false", "0": "false"} {
+		got, err := CanonicalBoolean(raw)
+		if err != nil || got != want {
+			t.Fatalf("CanonicalBoolean(%q) = %q, %v; want %q", raw, got, err, want)
+		}
+	}
+	if _, err := CanonicalBoolean("maybe"); err == nil {
+		t.Fatalf("expected error for invalid boolean")
+	}
+}
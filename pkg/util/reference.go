@@ -0,0 +1,106 @@
+package util
+
+import (
+	"regexp"
+	"strings"
+)
+
+// configReferenceRegexp matches the two reference forms a config may embed:
+// ${alias} and {{ref:alias.path.to.field}}. Aliases are limited to letters,
+// digits, "_" and "-" so the first "." of a {{ref:}} always starts the path.
+var configReferenceRegexp = regexp.MustCompile(`\$\{\s*([A-Za-z0-9_-]+)\s*\}|\{\{\s*ref:([A-Za-z0-9_-]+)((?:\.[^.\s{}]+)*)\s*\}\}`)
+
+// ConfigReference is one reference to another config found in a content.
+type ConfigReference struct {
+	// Token is the reference exactly as written, e.g. "{{ref:theme.primary}}".
+	Token string
+	Alias string
+	// Path walks into the JSON content of the referenced config; empty for the whole value.
+	Path []string
+}
+
+// String returns the referenced alias and path in dotted form.
+func (r ConfigReference) String() string {
+	if len(r.Path) == 0 {
+		return r.Alias
+	}
+	return r.Alias + "." + strings.Join(r.Path, ".")
+}
+
+// FindConfigReferences returns the references of content in order of appearance.
+func FindConfigReferences(content string) []ConfigReference {
+	if !strings.Contains(content, "${") && !strings.Contains(content, "{{") {
+		return nil
+	}
+	matches := configReferenceRegexp.FindAllStringSubmatch(content, -1)
+	refs := make([]ConfigReference, 0, len(matches))
+	for _, m := range matches {
+		refs = append(refs, parseConfigReference(m))
+	}
+	return refs
+}
+
+// ParseSingleConfigReference reports whether value consists of exactly one reference.
+func ParseSingleConfigReference(value string) (ConfigReference, bool) {
+	trimmed := strings.TrimSpace(value)
+	loc := configReferenceRegexp.FindStringSubmatchIndex(trimmed)
+	if loc == nil || loc[0] != 0 || loc[1] != len(trimmed) {
+		return ConfigReference{}, false
+	}
+	return parseConfigReference(configReferenceRegexp.FindStringSubmatch(trimmed)), true
+}
+
+// InterpolateConfigReferences replaces every reference of content with the text
+// returned by lookup. References lookup cannot resolve are left untouched.
+func InterpolateConfigReferences(content string, lookup func(ConfigReference) (string, bool)) string {
+	if !strings.Contains(content, "${") && !strings.Contains(content, "{{") {
+		return content
+	}
+	return configReferenceRegexp.ReplaceAllStringFunc(content, func(token string) string {
+		ref := parseConfigReference(configReferenceRegexp.FindStringSubmatch(token))
+		if value, ok := lookup(ref); ok {
+			return value
+		}
+		return token
+	})
+}
+
+// RenameConfigReferences rewrites the references of content to alias from so
+// that they point to alias to. Form, spacing and path of every reference are kept.
+func RenameConfigReferences(content, from, to string) string {
+	if !strings.Contains(content, from) {
+		return content
+	}
+	matches := configReferenceRegexp.FindAllStringSubmatchIndex(content, -1)
+	var out strings.Builder
+	last := 0
+	for _, m := range matches {
+		// m[2:4] is the alias of ${alias}, m[4:6] the alias of {{ref:alias}}
+		start, end := m[2], m[3]
+		if start < 0 {
+			start, end = m[4], m[5]
+		}
+		if content[start:end] != from {
+			continue
+		}
+		out.WriteString(content[last:start])
+		out.WriteString(to)
+		last = end
+	}
+	if last == 0 {
+		return content
+	}
+	out.WriteString(content[last:])
+	return out.String()
+}
+
+func parseConfigReference(m []string) ConfigReference {
+	if m[1] != "" {
+		return ConfigReference{Token: m[0], Alias: m[1]}
+	}
+	ref := ConfigReference{Token: m[0], Alias: m[2]}
+	if m[3] != "" {
+		ref.Path = strings.Split(strings.TrimPrefix(m[3], "."), ".")
+	}
+	return ref
+}
@@ -0,0 +1,58 @@
+package util
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFindConfigReferences(t *testing.T) {
+	refs := FindConfigReferences("${cdn_host}/a.png {{ref:theme.colors.primary}} {{ ref:banner }} ${ spaced } $cdn {ref:x}")
+	got := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		got = append(got, ref.String())
+	}
+	want := []string{"cdn_host", "theme.colors.primary", "banner", "spaced"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("FindConfigReferences = %v, want %v", got, want)
+	}
+	if refs[1].Token != "{{ref:theme.colors.primary}}" || !reflect.DeepEqual(refs[1].Path, []string{"colors", "primary"}) {
+		t.Fatalf("unexpected reference %+v", refs[1])
+	}
+	if refs := FindConfigReferences("plain text"); refs != nil {
+		t.Fatalf("expected no references, got %v", refs)
+	}
+}
+
+func TestParseSingleConfigReference(t *testing.T) {
+	if ref, ok := ParseSingleConfigReference(" {{ref:theme.primary}} "); !ok || ref.String() != "theme.primary" {
+		t.Fatalf("expected single reference, got %+v, %v", ref, ok)
+	}
+	for _, value := range []string{"${a} ${b}", "x ${a}", "${a}/path", ""} {
+		if _, ok := ParseSingleConfigReference(value); ok {
+			t.Fatalf("expected %q not to be a single reference", value)
+		}
+	}
+}
+
+func TestInterpolateConfigReferences(t *testing.T) {
+	values := map[string]string{"cdn_host": "https://cdn.example.com", "theme.primary": "#1677FF"}
+	got := InterpolateConfigReferences("${cdn_host}/logo.png {{ref:theme.primary}} ${missing}", func(ref ConfigReference) (string, bool) {
+		value, ok := values[ref.String()]
+		return value, ok
+	})
+	want := "https://cdn.example.com/logo.png #1677FF ${missing}"
+	if got != want {
+		t.Fatalf("InterpolateConfigReferences = %q, want %q", got, want)
+	}
+}
+
+func TestRenameConfigReferences(t *testing.T) {
+	got := RenameConfigReferences(`${cdn} ${ cdn } {{ref:cdn.host}} {{ ref:cdn }} ${cdn_host} {{ref:theme.cdn}} cdn $cdn`, "cdn", "asset_cdn")
+	want := `${asset_cdn} ${ asset_cdn } {{ref:asset_cdn.host}} {{ ref:asset_cdn }} ${cdn_host} {{ref:theme.cdn}} cdn $cdn`
+	if got != want {
+		t.Fatalf("RenameConfigReferences = %q, want %q", got, want)
+	}
+	if content := "${other}"; RenameConfigReferences(content, "cdn", "x") != content {
+		t.Fatal("expected content without the alias to be unchanged")
+	}
+}
@@ -0,0 +1,110 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	// The release images ship without a zoneinfo database.
+	_ "time/tzdata"
+)
+
+// scheduleLocalLayouts are the date-time forms accepted without a UTC offset;
+// they are interpreted in the schedule timezone.
+var scheduleLocalLayouts = []string{
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// LoadScheduleLocation resolves an IANA timezone name such as "Asia/Shanghai".
+// An empty name is UTC.
+func LoadScheduleLocation(timezone string) (*time.Location, error) {
+	timezone = strings.TrimSpace(timezone)
+	if timezone == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("unknown timezone %q", timezone)
+	}
+	return loc, nil
+}
+
+// ParseScheduleTime parses an activation or expiry time. RFC 3339 values carry
+// their own offset; local date-times ("2026-11-11 00:00") are interpreted in
+// timezone. An empty value yields nil. The result is in UTC.
+func ParseScheduleTime(value, timezone string) (*time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		utc := parsed.UTC()
+		return &utc, nil
+	}
+	loc, err := LoadScheduleLocation(timezone)
+	if err != nil {
+		return nil, err
+	}
+	for _, layout := range scheduleLocalLayouts {
+		if parsed, err := time.ParseInLocation(layout, value, loc); err == nil {
+			utc := parsed.UTC()
+			return &utc, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid time %q: use RFC 3339 or \"YYYY-MM-DD HH:MM[:SS]\"", value)
+}
+
+// FormatScheduleTime renders t as RFC 3339 in timezone, falling back to UTC
+// for unknown names. A nil time yields an empty string.
+func FormatScheduleTime(t *time.Time, timezone string) string {
+	if t == nil {
+		return ""
+	}
+	loc, err := LoadScheduleLocation(timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	return t.In(loc).Format(time.RFC3339)
+}
+
+// MinutesPerWeek is the length of the cycle of weekly times.
+const MinutesPerWeek = 7 * 24 * 60
+
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday, "sunday": time.Sunday,
+	"mon": time.Monday, "monday": time.Monday,
+	"tue": time.Tuesday, "tuesday": time.Tuesday,
+	"wed": time.Wednesday, "wednesday": time.Wednesday,
+	"thu": time.Thursday, "thursday": time.Thursday,
+	"fri": time.Friday, "friday": time.Friday,
+	"sat": time.Saturday, "saturday": time.Saturday,
+}
+
+// ParseWeeklyTime parses a point of the week such as "fri 18:00" or
+// "Monday 08:30" and returns its offset in minutes from Sunday 00:00.
+func ParseWeeklyTime(value string) (int, error) {
+	fields := strings.Fields(strings.ToLower(value))
+	if len(fields) != 2 {
+		return 0, fmt.Errorf("invalid weekly time %q: use \"<weekday> HH:MM\", e.g. \"fri 18:00\"", value)
+	}
+	weekday, ok := weekdayNames[fields[0]]
+	if !ok {
+		return 0, fmt.Errorf("invalid weekday %q", fields[0])
+	}
+	clock, err := time.Parse("15:04", fields[1])
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q", fields[1])
+	}
+	return int(weekday)*24*60 + clock.Hour()*60 + clock.Minute(), nil
+}
+
+// FormatWeeklyTime renders an offset returned by ParseWeeklyTime as "fri 18:00".
+func FormatWeeklyTime(minutes int) string {
+	minutes = ((minutes % MinutesPerWeek) + MinutesPerWeek) % MinutesPerWeek
+	weekday := time.Weekday(minutes / (24 * 60))
+	return fmt.Sprintf("%s %02d:%02d", strings.ToLower(weekday.String()[:3]), minutes/60%24, minutes%60)
+}
@@ -0,0 +1,76 @@
+package util
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseScheduleTime(t *testing.T) {
+	cases := []struct {
+		value, timezone string
+		want            string
+	}{
+		{"2026-11-11T00:00:00+08:00", "", "2026-11-10T16:00:00Z"},
+		{"2026-11-11T00:00:00Z", "Asia/Shanghai", "2026-11-11T00:00:00Z"},
+		{"2026-11-11 00:00", "Asia/Shanghai", "2026-11-10T16:00:00Z"},
+		{"2026-11-11T00:00:30", "", "2026-11-11T00:00:30Z"},
+		{"2026-07-01", "America/New_York", "2026-07-01T04:00:00Z"},
+	}
+	for _, tc := range cases {
+		got, err := ParseScheduleTime(tc.value, tc.timezone)
+		if err != nil {
+			t.Fatalf("ParseScheduleTime(%q, %q) returned error: %v", tc.value, tc.timezone, err)
+		}
+		if got.Location() != time.UTC || got.Format(time.RFC3339) != tc.want {
+			t.Fatalf("ParseScheduleTime(%q, %q) = %v, want %s", tc.value, tc.timezone, got, tc.want)
+		}
+	}
+
+	if got, err := ParseScheduleTime(" ", "Asia/Shanghai"); got != nil || err != nil {
+		t.Fatalf("expected nil time for empty value, got %v, %v", got, err)
+	}
+	for _, tc := range [][2]string{{"tomorrow", ""}, {"2026-11-11 00:00", "Mars/Olympus"}, {"2026-13-01", ""}} {
+		if _, err := ParseScheduleTime(tc[0], tc[1]); err == nil {
+			t.Fatalf("expected error for %q in %q", tc[0], tc[1])
+		}
+	}
+}
+
+func TestFormatScheduleTime(t *testing.T) {
+	at := time.Date(2026, 11, 10, 16, 0, 0, 0, time.UTC)
+	if got := FormatScheduleTime(&at, "Asia/Shanghai"); got != "2026-11-11T00:00:00+08:00" {
+		t.Fatalf("FormatScheduleTime = %s", got)
+	}
+	if got := FormatScheduleTime(&at, ""); got != "2026-11-10T16:00:00Z" {
+		t.Fatalf("FormatScheduleTime = %s", got)
+	}
+	if got := FormatScheduleTime(nil, ""); got != "" {
+		t.Fatalf("FormatScheduleTime(nil) = %q", got)
+	}
+}
+
+func TestParseWeeklyTime(t *testing.T) {
+	cases := map[string]int{
+		"sun 00:00":     0,
+		"fri 18:00":     5*24*60 + 18*60,
+		"Monday 08:30":  24*60 + 8*60 + 30,
+		" sat   23:59 ": 6*24*60 + 23*60 + 59,
+	}
+	for value, want := range cases {
+		got, err := ParseWeeklyTime(value)
+		if err != nil {
+			t.Fatalf("ParseWeeklyTime(%q) returned error: %v", value, err)
+		}
+		if got != want {
+			t.Fatalf("ParseWeeklyTime(%q) = %d, want %d", value, got, want)
+		}
+	}
+	for _, value := range []string{"", "fri", "friday 25:00", "someday 10:00", "fri 18:00 utc"} {
+		if _, err := ParseWeeklyTime(value); err == nil {
+			t.Fatalf("expected error for %q", value)
+		}
+	}
+	if got := FormatWeeklyTime(5*24*60 + 18*60); got != "fri 18:00" {
+		t.Fatalf("FormatWeeklyTime = %s", got)
+	}
+}
@@ -0,0 +1,161 @@
+package util
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Version is a parsed semantic version (major.minor.patch[-prerelease]).
+// Build metadata is accepted but ignored for ordering.
+type Version struct {
+	Major      int
+	Minor      int
+	Patch      int
+	Prerelease []string
+}
+
+// ParseVersion parses a semantic version. A leading "v" and missing minor or
+// patch components are tolerated, so "v5", "5.2" and "5.2.0" are all valid.
+func ParseVersion(raw string) (Version, error) {
+	var v Version
+	s := strings.TrimSpace(raw)
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "v"), "V")
+	if idx := strings.IndexByte(s, '+'); idx >= 0 {
+		s = s[:idx]
+	}
+	if idx := strings.IndexByte(s, '-'); idx >= 0 {
+		pre := s[idx+1:]
+		s = s[:idx]
+		if pre == "" {
+			return v, fmt.Errorf("invalid version %q", raw)
+		}
+		v.Prerelease = strings.Split(pre, ".")
+	}
+	if s == "" {
+		return v, fmt.Errorf("invalid version %q", raw)
+	}
+
+	parts := strings.Split(s, ".")
+	if len(parts) > 3 {
+		return v, fmt.Errorf("invalid version %q", raw)
+	}
+	nums := [3]int{}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return v, fmt.Errorf("invalid version %q", raw)
+		}
+		nums[i] = n
+	}
+	v.Major, v.Minor, v.Patch = nums[0], nums[1], nums[2]
+	return v, nil
+}
+
+// Compare returns -1, 0 or 1 following semantic version precedence.
+func (v Version) Compare(other Version) int {
+	for _, pair := range [][2]int{{v.Major, other.Major}, {v.Minor, other.Minor}, {v.Patch, other.Patch}} {
+		if pair[0] != pair[1] {
+			if pair[0] < pair[1] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return comparePrerelease(v.Prerelease, other.Prerelease)
+}
+
+// CompareVersions parses and compares two versions.
+func CompareVersions(a, b string) (int, error) {
+	va, err := ParseVersion(a)
+	if err != nil {
+		return 0, err
+	}
+	vb, err := ParseVersion(b)
+	if err != nil {
+		return 0, err
+	}
+	return va.Compare(vb), nil
+}
+
+// VersionInRange reports whether version lies in [minVersion, maxVersion).
+// An empty bound is unbounded. Unparseable input never matches.
+func VersionInRange(version, minVersion, maxVersion string) bool {
+	v, err := ParseVersion(version)
+	if err != nil {
+		return false
+	}
+	if minVersion != "" {
+		lower, err := ParseVersion(minVersion)
+		if err != nil || v.Compare(lower) < 0 {
+			return false
+		}
+	}
+	if maxVersion != "" {
+		upper, err := ParseVersion(maxVersion)
+		if err != nil || v.Compare(upper) >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// VersionRangesOverlap reports whether [minA, maxA) and [minB, maxB) share at
+// least one version. Empty bounds are unbounded.
+func VersionRangesOverlap(minA, maxA, minB, maxB string) bool {
+	// a starts before b ends and b starts before a ends
+	return boundLess(minA, maxB) && boundLess(minB, maxA)
+}
+
+// boundLess reports lower < upper where an empty lower is -inf and an empty upper is +inf.
+func boundLess(lower, upper string) bool {
+	if lower == "" || upper == "" {
+		return true
+	}
+	cmp, err := CompareVersions(lower, upper)
+	if err != nil {
+		return true
+	}
+	return cmp < 0
+}
+
+func comparePrerelease(a, b []string) int {
+	// 正式版本优先级高于预发布版本
+	switch {
+	case len(a) == 0 && len(b) == 0:
+		return 0
+	case len(a) == 0:
+		return 1
+	case len(b) == 0:
+		return -1
+	}
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] == b[i] {
+			continue
+		}
+		ai, aErr := strconv.Atoi(a[i])
+		bi, bErr := strconv.Atoi(b[i])
+		switch {
+		case aErr == nil && bErr == nil:
+			if ai < bi {
+				return -1
+			}
+			return 1
+		case aErr == nil:
+			return -1
+		case bErr == nil:
+			return 1
+		case a[i] < b[i]:
+			return -1
+		default:
+			return 1
+		}
+	}
+	switch {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,62 @@
+package util
+
+import "testing"
+
+func TestCompareVersions(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"5.2.0", "5.2.0", 0},
+		{"v5.2", "5.2.0", 0},
+		{"5.10.0", "5.9.3", 1},
+		{"5.2.0-beta.1", "5.2.0", -1},
+		{"5.2.0-beta.2", "5.2.0-beta.10", -1},
+		{"5.2.0-alpha", "5.2.0-beta", -1},
+		{"5.2.0+build.7", "5.2.0", 0},
+	}
+	for _, tc := range cases {
+		got, err := CompareVersions(tc.a, tc.b)
+		if err != nil {
+			t.Fatalf("CompareVersions(%q, %q) returned error: %v", tc.a, tc.b, err)
+		}
+		if got != tc.want {
+			t.Fatalf("CompareVersions(%q, %q) = %d, want %d", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestParseVersionRejectsInvalid(t *testing.T) {
+	for _, raw := range []string{"", "abc", "1.2.3.4", "1.-2", "1.2.3-"} {
+		if _, err := ParseVersion(raw); err == nil {
+			t.Fatalf("expected error for %q", raw)
+		}
+	}
+}
+
+func TestVersionInRange(t *testing.T) {
+	if !VersionInRange("5.2.0", "5.2.0", "") {
+		t.Fatalf("expected lower bound to be inclusive")
+	}
+	if VersionInRange("6.0.0", "5.2.0", "6.0.0") {
+		t.Fatalf("expected upper bound to be exclusive")
+	}
+	if VersionInRange("5.1.9", "5.2.0", "") {
+		t.Fatalf("expected 5.1.9 to be below range")
+	}
+	if VersionInRange("", "", "") {
+		t.Fatalf("expected empty version not to match")
+	}
+}
+
+func TestVersionRangesOverlap(t *testing.T) {
+	if VersionRangesOverlap("5.0.0", "5.2.0", "5.2.0", "") {
+		t.Fatalf("expected adjacent ranges not to overlap")
+	}
+	if !VersionRangesOverlap("5.0.0", "5.3.0", "5.2.0", "") {
+		t.Fatalf("expected ranges to overlap")
+	}
+	if !VersionRangesOverlap("", "", "1.0.0", "2.0.0") {
+		t.Fatalf("expected unbounded range to overlap")
+	}
+}